@@ -6,7 +6,10 @@ require (
 	github.com/andybalholm/brotli v1.1.1
 	github.com/btcsuite/btcd/btcec/v2 v2.3.6
 	github.com/hiero-ledger/hiero-sdk-go/v2 v2.77.1
+	github.com/tetratelabs/wazero v1.12.0
 	github.com/zhouhui8915/go-socket.io-client v0.0.0-20200925034401-83ee73793ba4
+	golang.org/x/crypto v0.49.0
+	golang.org/x/net v0.52.0
 	google.golang.org/grpc v1.79.3
 	google.golang.org/protobuf v1.36.11
 )
@@ -25,9 +28,7 @@ require (
 	github.com/smartystreets/goconvey v1.8.1 // indirect
 	github.com/youmark/pkcs8 v0.0.0-20240726163527-a2c0da244d78 // indirect
 	github.com/zhouhui8915/engine.io-go v0.0.0-20150910083302-02ea08f0971f // indirect
-	golang.org/x/crypto v0.49.0 // indirect
-	golang.org/x/net v0.52.0 // indirect
-	golang.org/x/sys v0.42.0 // indirect
+	golang.org/x/sys v0.44.0 // indirect
 	golang.org/x/text v0.35.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260316180232-0b37fe3546d5 // indirect
 )
@@ -15,10 +15,12 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
 	operatorKey       hedera.PrivateKey
+	executionMode     shared.ExecutionMode
 }
 
 // NewClient creates a new Client.
@@ -27,11 +29,16 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	executionMode, err := shared.NormalizeExecutionMode(config.ExecutionMode)
+	if err != nil {
+		return nil, err
+	}
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -48,10 +55,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
+		executionMode:     executionMode,
 	}, nil
 }
 
@@ -60,32 +69,65 @@ func (c *Client) MirrorClient() *mirror.Client {
 	return c.mirrorClient
 }
 
-// CreateInboundTopic creates the requested resource.
+// CreateInboundTopic creates the requested resource. It cannot return an
+// envelope, so in envelope mode use BuildInboundTopicEnvelope instead.
 func (c *Client) CreateInboundTopic(ctx context.Context, options CreateTopicOptions) (string, hedera.TransactionReceipt, error) {
-	return c.createTopic(ctx, CreateTopicTxParams{
+	return c.createTopic(ctx, c.inboundTopicParams(options), options.TransactionMemo)
+}
+
+// BuildInboundTopicEnvelope freezes the transaction of CreateInboundTopic
+// into an envelope instead of submitting it.
+func (c *Client) BuildInboundTopicEnvelope(options CreateTopicOptions) (*shared.TxEnvelope, error) {
+	return c.buildTopicEnvelope(c.inboundTopicParams(options), options.TransactionMemo)
+}
+
+// CreateOutboundTopic creates the requested resource. It cannot return an
+// envelope, so in envelope mode use BuildOutboundTopicEnvelope instead.
+func (c *Client) CreateOutboundTopic(ctx context.Context, options CreateTopicOptions) (string, hedera.TransactionReceipt, error) {
+	return c.createTopic(ctx, c.outboundTopicParams(options), options.TransactionMemo)
+}
+
+// BuildOutboundTopicEnvelope freezes the transaction of CreateOutboundTopic
+// into an envelope instead of submitting it.
+func (c *Client) BuildOutboundTopicEnvelope(options CreateTopicOptions) (*shared.TxEnvelope, error) {
+	return c.buildTopicEnvelope(c.outboundTopicParams(options), options.TransactionMemo)
+}
+
+// CreateConnectionTopic creates the requested resource. It cannot return an
+// envelope, so in envelope mode use BuildConnectionTopicEnvelope instead.
+func (c *Client) CreateConnectionTopic(ctx context.Context, options CreateTopicOptions) (string, hedera.TransactionReceipt, error) {
+	return c.createTopic(ctx, c.connectionTopicParams(options), options.TransactionMemo)
+}
+
+// BuildConnectionTopicEnvelope freezes the transaction of
+// CreateConnectionTopic into an envelope instead of submitting it.
+func (c *Client) BuildConnectionTopicEnvelope(options CreateTopicOptions) (*shared.TxEnvelope, error) {
+	return c.buildTopicEnvelope(c.connectionTopicParams(options), options.TransactionMemo)
+}
+
+func (c *Client) inboundTopicParams(options CreateTopicOptions) CreateTopicTxParams {
+	return CreateTopicTxParams{
 		TopicType:    TopicTypeInbound,
 		TTL:          options.TTL,
 		AccountID:    options.AccountID,
 		AdminKey:     c.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin),
 		SubmitKey:    c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 		MemoOverride: options.MemoOverride,
-	}, options.TransactionMemo)
+	}
 }
 
-// CreateOutboundTopic creates the requested resource.
-func (c *Client) CreateOutboundTopic(ctx context.Context, options CreateTopicOptions) (string, hedera.TransactionReceipt, error) {
-	return c.createTopic(ctx, CreateTopicTxParams{
+func (c *Client) outboundTopicParams(options CreateTopicOptions) CreateTopicTxParams {
+	return CreateTopicTxParams{
 		TopicType:    TopicTypeOutbound,
 		TTL:          options.TTL,
 		AdminKey:     c.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin),
 		SubmitKey:    c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 		MemoOverride: options.MemoOverride,
-	}, options.TransactionMemo)
+	}
 }
 
-// CreateConnectionTopic creates the requested resource.
-func (c *Client) CreateConnectionTopic(ctx context.Context, options CreateTopicOptions) (string, hedera.TransactionReceipt, error) {
-	return c.createTopic(ctx, CreateTopicTxParams{
+func (c *Client) connectionTopicParams(options CreateTopicOptions) CreateTopicTxParams {
+	return CreateTopicTxParams{
 		TopicType:      TopicTypeConnection,
 		TTL:            options.TTL,
 		InboundTopicID: options.InboundTopicID,
@@ -93,19 +135,31 @@ func (c *Client) CreateConnectionTopic(ctx context.Context, options CreateTopicO
 		AdminKey:       c.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin),
 		SubmitKey:      c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 		MemoOverride:   options.MemoOverride,
-	}, options.TransactionMemo)
+	}
 }
 
 // CreateRegistryTopic creates the requested resource.
 func (c *Client) CreateRegistryTopic(ctx context.Context, options CreateTopicOptions) (CreateRegistryTopicResult, error) {
-	topicID, receipt, err := c.createTopic(ctx, CreateTopicTxParams{
+	params := CreateTopicTxParams{
 		TopicType:       TopicTypeRegistry,
 		TTL:             options.TTL,
 		MetadataTopicID: options.MetadataTopicID,
 		AdminKey:        c.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin),
 		SubmitKey:       c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 		MemoOverride:    options.MemoOverride,
-	}, options.TransactionMemo)
+	}
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		envelope, err := c.buildTopicEnvelope(params, options.TransactionMemo)
+		if err != nil {
+			return CreateRegistryTopicResult{}, err
+		}
+		transactionID, _ := envelope.TransactionID()
+		return CreateRegistryTopicResult{
+			TransactionID: transactionID.String(),
+			Envelope:      envelope,
+		}, nil
+	}
+	topicID, receipt, err := c.createTopic(ctx, params, options.TransactionMemo)
 	if err != nil {
 		return CreateRegistryTopicResult{
 			Success: false,
@@ -119,32 +173,51 @@ func (c *Client) CreateRegistryTopic(ctx context.Context, options CreateTopicOpt
 	}, nil
 }
 
+// createTopic creates a topic. Its results cannot carry an envelope, so it
+// is not available in envelope mode; the Build*TopicEnvelope methods cover
+// that case.
 func (c *Client) createTopic(
 	ctx context.Context,
 	params CreateTopicTxParams,
 	transactionMemo string,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
-	transaction, err := BuildCreateTopicTx(params)
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return "", hedera.TransactionReceipt{}, fmt.Errorf(
+			"topic creation cannot return an envelope; use the Build*TopicEnvelope methods in envelope mode",
+		)
+	}
+	transaction, err := buildTopicTx(params, transactionMemo)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, err
 	}
-	if strings.TrimSpace(transactionMemo) != "" {
-		transaction.SetTransactionMemo(strings.TrimSpace(transactionMemo))
-	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+	topicID, err := executed.TopicID()
 	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get topic create receipt: %w", err)
+		return "", hedera.TransactionReceipt{}, err
 	}
-	if receipt.TopicID == nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("topic create receipt missing topic ID")
+	return topicID, executed.Receipt, nil
+}
+
+func (c *Client) buildTopicEnvelope(params CreateTopicTxParams, transactionMemo string) (*shared.TxEnvelope, error) {
+	transaction, err := buildTopicTx(params, transactionMemo)
+	if err != nil {
+		return nil, err
 	}
-	return receipt.TopicID.String(), receipt, nil
+	return shared.FreezeTxEnvelope(transaction, shared.FreezeOptions{Client: c.hederaClient})
+}
+
+func buildTopicTx(params CreateTopicTxParams, transactionMemo string) (*hedera.TopicCreateTransaction, error) {
+	transaction, err := BuildCreateTopicTx(params)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(transactionMemo) != "" {
+		transaction.SetTransactionMemo(strings.TrimSpace(transactionMemo))
+	}
+	return transaction, nil
 }
 
 // SubmitMessage submits the requested message payload.
@@ -154,23 +227,29 @@ func (c *Client) SubmitMessage(
 	message Message,
 	transactionMemo string,
 ) (SubmitResult, error) {
-	_ = ctx
 	transaction, err := BuildSubmitMessageTx(topicID, message, transactionMemo)
 	if err != nil {
 		return SubmitResult{}, err
 	}
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return SubmitResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		envelope, envelopeErr := shared.FreezeTxEnvelope(transaction, shared.FreezeOptions{Client: c.hederaClient})
+		if envelopeErr != nil {
+			return SubmitResult{}, envelopeErr
+		}
+		transactionID, _ := envelope.TransactionID()
+		return SubmitResult{
+			TransactionID: transactionID.String(),
+			Envelope:      envelope,
+		}, nil
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
-		return SubmitResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
+		return SubmitResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
 	return SubmitResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
-		SequenceNumber: int64(receipt.TopicSequenceNumber),
+		TransactionID:  executed.TransactionID,
+		SequenceNumber: executed.SequenceNumber(),
 	}, nil
 }
 
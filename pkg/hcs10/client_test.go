@@ -1,6 +1,13 @@
 package hcs10
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 func TestNewClientMissingOperatorID(t *testing.T) {
 	_, err := NewClient(ClientConfig{
@@ -11,3 +18,49 @@ func TestNewClientMissingOperatorID(t *testing.T) {
 		t.Fatalf("expected error for missing operator account ID")
 	}
 }
+
+func TestSubmitMessageEnvelopeMode(t *testing.T) {
+	key, _ := hedera.PrivateKeyGenerateEd25519()
+	signer := shared.NewOfflineSigner(key.PublicKey())
+	hederaClient := hedera.ClientForNetwork(map[string]hedera.AccountID{"127.0.0.1:50211": {Account: 3}})
+	shared.SetOperatorSigner(hederaClient, hedera.AccountID{Account: 1234}, signer)
+	client, err := NewClient(ClientConfig{
+		OperatorSigner: signer,
+		Network:        "testnet",
+		HederaClient:   hederaClient,
+		ExecutionMode:  shared.ExecutionModeEnvelope,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	result, err := client.SendConnectionRequest(context.Background(), "0.0.5005", "0.0.1234", "hello")
+	if err != nil {
+		t.Fatalf("SendConnectionRequest failed: %v", err)
+	}
+	if result.Success || result.Envelope == nil {
+		t.Fatalf("expected an unsubmitted envelope, got %+v", result)
+	}
+	transactionID, err := result.Envelope.TransactionID()
+	if err != nil {
+		t.Fatalf("TransactionID failed: %v", err)
+	}
+	if transactionID.String() != result.TransactionID || transactionID.AccountID.String() != "0.0.1234" {
+		t.Fatalf("unexpected transaction ID %s", result.TransactionID)
+	}
+
+	registry, err := client.CreateRegistryTopic(context.Background(), CreateTopicOptions{})
+	if err != nil {
+		t.Fatalf("CreateRegistryTopic failed: %v", err)
+	}
+	if registry.Envelope == nil || registry.TopicID != "" {
+		t.Fatalf("expected registry topic envelope, got %+v", registry)
+	}
+	if _, _, err := client.CreateInboundTopic(context.Background(), CreateTopicOptions{AccountID: "0.0.1234"}); err == nil {
+		t.Fatalf("expected inbound topic creation to be rejected in envelope mode")
+	}
+	inbound, err := client.BuildInboundTopicEnvelope(CreateTopicOptions{AccountID: "0.0.1234"})
+	if err != nil || inbound == nil || !inbound.IsFrozen() {
+		t.Fatalf("expected a frozen inbound topic envelope, got %v (%v)", inbound, err)
+	}
+}
@@ -1,6 +1,10 @@
 package hcs10
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type TopicType int
 
@@ -46,10 +50,19 @@ type Message struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// ExecutionMode set to shared.ExecutionModeEnvelope makes transaction
+	// methods return frozen envelopes in their results instead of submitting
+	// them. The inbound, outbound and connection topic helpers, whose results
+	// cannot carry an envelope, return an error instead; use their
+	// Build*TopicEnvelope counterparts.
+	ExecutionMode shared.ExecutionMode
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateTopicOptions struct {
@@ -67,10 +80,11 @@ type CreateTopicOptions struct {
 }
 
 type CreateRegistryTopicResult struct {
-	Success       bool   `json:"success"`
-	TopicID       string `json:"topic_id,omitempty"`
-	TransactionID string `json:"transaction_id,omitempty"`
-	Error         string `json:"error,omitempty"`
+	Success       bool               `json:"success"`
+	TopicID       string             `json:"topic_id,omitempty"`
+	TransactionID string             `json:"transaction_id,omitempty"`
+	Error         string             `json:"error,omitempty"`
+	Envelope      *shared.TxEnvelope `json:"-"`
 }
 
 type TopicRecord struct {
@@ -85,11 +99,14 @@ type MessageRecord struct {
 	Payer              string  `json:"payer"`
 }
 
+// SubmitResult reports a submitted message. In envelope mode Success is false
+// and Envelope holds the frozen, unsubmitted transaction.
 type SubmitResult struct {
-	Success        bool   `json:"success"`
-	TransactionID  string `json:"transaction_id,omitempty"`
-	Error          string `json:"error,omitempty"`
-	SequenceNumber int64  `json:"sequence_number,omitempty"`
+	Success        bool               `json:"success"`
+	TransactionID  string             `json:"transaction_id,omitempty"`
+	Error          string             `json:"error,omitempty"`
+	SequenceNumber int64              `json:"sequence_number,omitempty"`
+	Envelope       *shared.TxEnvelope `json:"-"`
 }
 
 type CreateTopicTxParams struct {
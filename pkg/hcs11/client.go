@@ -14,15 +14,16 @@ import (
 )
 
 type Client struct {
-	hederaClient       *hedera.Client
-	mirrorClient       *mirror.Client
-	operatorAccountID  string
-	operatorPrivateKey string
-	network            string
-	keyType            string
-	inscriberBaseURL   string
-	inscriberAuthURL   string
-	inscriberAPIURL    string
+	hederaClient      *hedera.Client
+	executor          *shared.Executor
+	mirrorClient      *mirror.Client
+	operatorAccountID string
+	operatorSigner    shared.Signer
+	network           string
+	keyType           string
+	inscriberBaseURL  string
+	inscriberAuthURL  string
+	inscriberAPIURL   string
 }
 
 // NewClient creates a new Client.
@@ -42,22 +43,33 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	operatorAccountID := strings.TrimSpace(config.Auth.OperatorID)
 	operatorPrivateKey := strings.TrimSpace(config.Auth.PrivateKey)
-	if operatorAccountID == "" && operatorPrivateKey != "" {
+	operatorSigner := config.Auth.Signer
+	if operatorPrivateKey != "" && operatorSigner != nil {
+		return nil, fmt.Errorf("operator private key and signer cannot both be set")
+	}
+	if operatorPrivateKey != "" {
+		localSigner, keyErr := shared.NewLocalSignerFromString(operatorPrivateKey)
+		if keyErr != nil {
+			return nil, keyErr
+		}
+		operatorSigner = localSigner
+	}
+	if operatorAccountID == "" && operatorSigner != nil {
 		return nil, fmt.Errorf("operator account ID is required when private key is provided")
 	}
-	if operatorAccountID != "" && operatorPrivateKey == "" {
+	if operatorAccountID != "" && operatorSigner == nil {
 		return nil, fmt.Errorf("operator private key is required when operator account ID is provided")
 	}
-	if injectedClient == nil && operatorAccountID != "" && operatorPrivateKey != "" {
+	if injectedClient == nil && operatorAccountID != "" {
 		accountID, parseErr := hedera.AccountIDFromString(operatorAccountID)
 		if parseErr != nil {
 			return nil, fmt.Errorf("invalid operator account ID: %w", parseErr)
 		}
-		privateKey, keyErr := shared.ParsePrivateKey(operatorPrivateKey)
-		if keyErr != nil {
-			return nil, keyErr
+		if localSigner, ok := operatorSigner.(*shared.LocalSigner); ok {
+			hederaClient.SetOperator(accountID, localSigner.PrivateKey())
+		} else {
+			shared.SetOperatorSigner(hederaClient, accountID, operatorSigner)
 		}
-		hederaClient.SetOperator(accountID, privateKey)
 	}
 
 	if injectedClient != nil {
@@ -75,14 +87,9 @@ func NewClient(config ClientConfig) (*Client, error) {
 		}
 		operatorAccountID = injectedOperatorAccountID
 
-		if operatorPrivateKey != "" {
-			parsedKey, keyErr := shared.ParsePrivateKey(operatorPrivateKey)
-			if keyErr != nil {
-				return nil, keyErr
-			}
-			if parsedKey.PublicKey().String() != hederaClient.GetOperatorPublicKey().String() {
-				return nil, fmt.Errorf("provided operator private key does not match the injected Hedera client operator")
-			}
+		if operatorSigner != nil &&
+			operatorSigner.PublicKey().String() != hederaClient.GetOperatorPublicKey().String() {
+			return nil, fmt.Errorf("provided operator private key does not match the injected Hedera client operator")
 		}
 	}
 
@@ -108,15 +115,16 @@ func NewClient(config ClientConfig) (*Client, error) {
 	}
 
 	return &Client{
-		hederaClient:       hederaClient,
-		mirrorClient:       mirrorClient,
-		operatorAccountID:  operatorAccountID,
-		operatorPrivateKey: operatorPrivateKey,
-		network:            network,
-		keyType:            keyType,
-		inscriberBaseURL:   strings.TrimRight(inscriberBaseURL, "/"),
-		inscriberAuthURL:   strings.TrimSpace(config.InscriberAuthURL),
-		inscriberAPIURL:    strings.TrimSpace(config.InscriberAPIURL),
+		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operatorSigner),
+		mirrorClient:      mirrorClient,
+		operatorAccountID: operatorAccountID,
+		operatorSigner:    operatorSigner,
+		network:           network,
+		keyType:           keyType,
+		inscriberBaseURL:  strings.TrimRight(inscriberBaseURL, "/"),
+		inscriberAuthURL:  strings.TrimSpace(config.InscriberAuthURL),
+		inscriberAPIURL:   strings.TrimSpace(config.InscriberAPIURL),
 	}, nil
 }
 
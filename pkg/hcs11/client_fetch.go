@@ -23,7 +23,7 @@ func (c *Client) UpdateAccountMemoWithProfile(
 			Error:   "account ID is required",
 		}, nil
 	}
-	if strings.TrimSpace(c.operatorPrivateKey) == "" {
+	if c.operatorSigner == nil {
 		return TransactionResult{
 			Success: false,
 			Error:   "operator private key is required",
@@ -39,14 +39,11 @@ func (c *Client) UpdateAccountMemoWithProfile(
 		SetAccountID(parsedAccountID).
 		SetAccountMemo(c.SetProfileForAccountMemo(profileTopicID, 1))
 
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return TransactionResult{}, err
-	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return TransactionResult{}, err
 	}
+	receipt := executed.Receipt
 	if receipt.Status.String() != "SUCCESS" {
 		return TransactionResult{
 			Success: false,
@@ -67,9 +67,9 @@ func (c *Client) InscribeImage(
 		ctx,
 		started.TransactionBytes,
 		inscriber.HederaClientConfig{
-			AccountID:  c.operatorAccountID,
-			PrivateKey: c.operatorPrivateKey,
-			Network:    network,
+			AccountID: c.operatorAccountID,
+			Signer:    c.operatorSigner,
+			Network:   network,
 		},
 	)
 	if err != nil {
@@ -170,9 +170,9 @@ func (c *Client) InscribeProfile(
 		ctx,
 		started.TransactionBytes,
 		inscriber.HederaClientConfig{
-			AccountID:  c.operatorAccountID,
-			PrivateKey: c.operatorPrivateKey,
-			Network:    network,
+			AccountID: c.operatorAccountID,
+			Signer:    c.operatorSigner,
+			Network:   network,
 		},
 	)
 	if err != nil {
@@ -248,7 +248,7 @@ func (c *Client) CreateAndInscribeProfile(
 func (c *Client) authenticateInscriber(
 	ctx context.Context,
 ) (inscriber.AuthResult, inscriber.Network, error) {
-	if strings.TrimSpace(c.operatorAccountID) == "" || strings.TrimSpace(c.operatorPrivateKey) == "" {
+	if strings.TrimSpace(c.operatorAccountID) == "" || c.operatorSigner == nil {
 		return inscriber.AuthResult{}, "", fmt.Errorf("operator credentials are required for inscription")
 	}
 
@@ -258,10 +258,10 @@ func (c *Client) authenticateInscriber(
 	}
 
 	authClient := inscriber.NewAuthClient(c.inscriberAuthURL)
-	authResult, err := authClient.Authenticate(
+	authResult, err := authClient.AuthenticateWithSigner(
 		ctx,
 		c.operatorAccountID,
-		c.operatorPrivateKey,
+		c.operatorSigner,
 		network,
 	)
 	if err != nil {
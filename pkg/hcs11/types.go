@@ -1,6 +1,10 @@
 package hcs11
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type ProfileType int
 
@@ -171,9 +175,12 @@ type HCS11Profile struct {
 	Policies        map[string]any    `json:"policies,omitempty"`
 }
 
+// Auth holds the operator credentials. Signer can be set instead of
+// PrivateKey to keep the operator key outside the process.
 type Auth struct {
 	OperatorID string
 	PrivateKey string
+	Signer     shared.Signer
 }
 
 type ClientConfig struct {
@@ -186,6 +193,8 @@ type ClientConfig struct {
 	InscriberAuthURL  string
 	InscriberAPIURL   string
 	HederaClient      *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type ValidationResult struct {
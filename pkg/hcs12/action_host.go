@@ -0,0 +1,626 @@
+package hcs12
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+const (
+	defaultActionMemoryLimitPages = 256
+	maxActionMemoryLimitPages     = 65536
+	defaultActionTimeout          = 5 * time.Second
+
+	actionExportInfo  = "INFO"
+	actionExportPost  = "POST"
+	actionExportGet   = "GET"
+	actionExportAlloc = "alloc"
+
+	wasmBindgenExportMalloc        = "__wbindgen_malloc"
+	wasmBindgenExportStackPointer  = "__wbindgen_add_to_stack_pointer"
+	wasmBindgenExportStart         = "__wbindgen_start"
+	wasmBindgenImportThrow         = "__wbindgen_throw"
+	wasmBindgenImportInitExternref = "__wbindgen_init_externref_table"
+)
+
+// actionABI is the calling convention an action module was built with.
+type actionABI int
+
+const (
+	// actionABIPacked passes strings through alloc and returns ptr<<32|len.
+	actionABIPacked actionABI = iota
+	// actionABIWasmBindgen follows the wasm-bindgen convention for
+	// functions taking and returning Rust strings.
+	actionABIWasmBindgen
+)
+
+// ActionHost runs HCS-12 action modules in an isolated WASM runtime.
+//
+// Modules must export "memory", "alloc(len i32) i32" and the HashLinks entry
+// points. Strings cross the boundary as UTF-8 (ptr, len) pairs and results are
+// returned as an i64 packing ptr<<32|len:
+//
+//	INFO() i64
+//	POST(action, params, network, memo) i64
+//	GET(command, params, network) i64
+//
+// Modules built with wasm-bindgen, which export "__wbindgen_malloc", are also
+// accepted when the entry points take and return Rust strings. Arguments are
+// copied in with __wbindgen_malloc and the result is read either from the
+// (ptr, len) pair the export returns or, for exports without results, from
+// the return pointer reserved with __wbindgen_add_to_stack_pointer and passed
+// as the first argument. Async entry points, which return a JavaScript
+// Promise, cannot run outside a JavaScript host and are not supported.
+//
+// Modules have no filesystem, network, or clock access. Plain modules must
+// not import anything. The only imports a wasm-bindgen module may declare are
+// its __wbindgen_* and __wbg_* glue: __wbindgen_throw fails the call with the
+// thrown message, and every import that needs a JavaScript value fails the
+// call when reached. Each wasm-bindgen module runs in a runtime of its own so
+// that its glue does not clash with that of other modules.
+type ActionHost struct {
+	runtime       wazero.Runtime
+	runtimeConfig wazero.RuntimeConfig
+	cache         wazero.CompilationCache
+	network       string
+	timeout       time.Duration
+	loader        ActionModuleLoaderFunc
+
+	mu              sync.Mutex
+	bindgenRuntimes map[wazero.Runtime]struct{}
+}
+
+// ActionModule is a loaded action whose INFO manifest matched its registration.
+type ActionModule struct {
+	host         *ActionHost
+	registration ActionRegistration
+	runtime      wazero.Runtime
+	compiled     wazero.CompiledModule
+	abi          actionABI
+	info         ActionInfo
+	infoJSON     []byte
+}
+
+// NewActionHost creates a new ActionHost.
+func NewActionHost(ctx context.Context, config ActionHostConfig) (*ActionHost, error) {
+	if config.Loader == nil {
+		return nil, fmt.Errorf("action module loader is required")
+	}
+	network, err := shared.NormalizeNetwork(config.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryLimitPages := config.MemoryLimitPages
+	if memoryLimitPages == 0 {
+		memoryLimitPages = defaultActionMemoryLimitPages
+	}
+	if memoryLimitPages > maxActionMemoryLimitPages {
+		return nil, fmt.Errorf("memory limit must not exceed %d pages", maxActionMemoryLimitPages)
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultActionTimeout
+	}
+
+	cache := wazero.NewCompilationCache()
+	runtimeConfig := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimitPages).
+		WithCloseOnContextDone(true).
+		WithCompilationCache(cache)
+
+	return &ActionHost{
+		runtime:         wazero.NewRuntimeWithConfig(ctx, runtimeConfig),
+		runtimeConfig:   runtimeConfig,
+		cache:           cache,
+		network:         network,
+		timeout:         timeout,
+		loader:          config.Loader,
+		bindgenRuntimes: map[wazero.Runtime]struct{}{},
+	}, nil
+}
+
+// NewActionHost creates an ActionHost that loads modules from HCS-1 topics
+// through the client's mirror node connection.
+func (c *Client) NewActionHost(ctx context.Context, config ActionHostConfig) (*ActionHost, error) {
+	if config.Loader == nil {
+		config.Loader = c.ResolveHCS1File
+	}
+	if strings.TrimSpace(config.Network) == "" {
+		config.Network = c.network
+	}
+	return NewActionHost(ctx, config)
+}
+
+// Close releases the runtime and every module compiled by the host.
+func (h *ActionHost) Close(ctx context.Context) error {
+	h.mu.Lock()
+	runtimes := h.bindgenRuntimes
+	h.bindgenRuntimes = map[wazero.Runtime]struct{}{}
+	h.mu.Unlock()
+
+	for runtime := range runtimes {
+		_ = runtime.Close(ctx)
+	}
+	err := h.runtime.Close(ctx)
+	_ = h.cache.Close(ctx)
+	return err
+}
+
+// Load fetches, compiles, and verifies the action module for a registration.
+func (h *ActionHost) Load(ctx context.Context, registration ActionRegistration) (*ActionModule, error) {
+	topicID := strings.TrimSpace(registration.TID)
+	if !topicIDPattern.MatchString(topicID) {
+		return nil, fmt.Errorf("action registration t_id must be a Hedera topic ID")
+	}
+	expectedInfoHash := strings.ToLower(strings.TrimSpace(registration.Hash))
+	if expectedInfoHash == "" {
+		return nil, fmt.Errorf("action registration hash is required")
+	}
+
+	wasmBytes, err := h.loader(ctx, topicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load action module %s: %w", topicID, err)
+	}
+	if expectedWasmHash := strings.ToLower(strings.TrimSpace(registration.WasmHash)); expectedWasmHash != "" {
+		if actual := sha256Hex(wasmBytes); actual != expectedWasmHash {
+			return nil, fmt.Errorf("action module wasm hash mismatch: expected %s, got %s", expectedWasmHash, actual)
+		}
+	}
+
+	compiled, err := h.runtime.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile action module %s: %w", topicID, err)
+	}
+	abi, err := validateActionExports(compiled)
+	if err != nil {
+		_ = compiled.Close(ctx)
+		return nil, err
+	}
+
+	module := &ActionModule{
+		host:         h,
+		registration: registration,
+		runtime:      h.runtime,
+		compiled:     compiled,
+		abi:          abi,
+	}
+	if abi == actionABIWasmBindgen {
+		_ = compiled.Close(ctx)
+		if err := h.loadWasmBindgenModule(ctx, module, wasmBytes); err != nil {
+			return nil, fmt.Errorf("failed to load action module %s: %w", topicID, err)
+		}
+	}
+
+	infoJSON, err := module.invoke(ctx, actionExportInfo)
+	if err != nil {
+		_ = module.Close(ctx)
+		return nil, err
+	}
+	if actual := sha256Hex(infoJSON); actual != expectedInfoHash {
+		_ = module.Close(ctx)
+		return nil, fmt.Errorf("action INFO hash mismatch: expected %s, got %s", expectedInfoHash, actual)
+	}
+	if err := json.Unmarshal(infoJSON, &module.info); err != nil {
+		_ = module.Close(ctx)
+		return nil, fmt.Errorf("failed to decode action INFO manifest: %w", err)
+	}
+
+	module.infoJSON = infoJSON
+	return module, nil
+}
+
+// loadWasmBindgenModule compiles a wasm-bindgen module into a runtime of its
+// own, next to host functions satisfying its glue imports. The host's
+// compilation cache makes the second compilation cheap.
+func (h *ActionHost) loadWasmBindgenModule(ctx context.Context, module *ActionModule, wasmBytes []byte) error {
+	runtime := wazero.NewRuntimeWithConfig(ctx, h.runtimeConfig)
+	compiled, err := runtime.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return err
+	}
+	if err := instantiateWasmBindgenGlue(ctx, runtime, compiled); err != nil {
+		_ = runtime.Close(ctx)
+		return err
+	}
+
+	h.mu.Lock()
+	h.bindgenRuntimes[runtime] = struct{}{}
+	h.mu.Unlock()
+
+	module.runtime = runtime
+	module.compiled = compiled
+	return nil
+}
+
+// Info returns the decoded INFO manifest.
+func (m *ActionModule) Info() ActionInfo {
+	return m.info
+}
+
+// InfoJSON returns the raw INFO manifest that was hashed during Load.
+func (m *ActionModule) InfoJSON() []byte {
+	return append([]byte(nil), m.infoJSON...)
+}
+
+// Registration returns the registration the module was loaded from.
+func (m *ActionModule) Registration() ActionRegistration {
+	return m.registration
+}
+
+// Close releases the compiled module.
+func (m *ActionModule) Close(ctx context.Context) error {
+	if m.runtime == m.host.runtime {
+		return m.compiled.Close(ctx)
+	}
+	m.host.mu.Lock()
+	delete(m.host.bindgenRuntimes, m.runtime)
+	m.host.mu.Unlock()
+	return m.runtime.Close(ctx)
+}
+
+// Post invokes the module's POST entry point for a declared action.
+func (m *ActionModule) Post(
+	ctx context.Context,
+	action string,
+	params map[string]any,
+	hashLinkMemo string,
+) (json.RawMessage, error) {
+	if err := m.requireAction(action); err != nil {
+		return nil, err
+	}
+	encodedParams, err := encodeActionParams(params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := m.invoke(
+		ctx,
+		actionExportPost,
+		strings.TrimSpace(action),
+		encodedParams,
+		m.host.network,
+		hashLinkMemo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return decodeActionResult(result)
+}
+
+// Get invokes the module's GET entry point.
+func (m *ActionModule) Get(ctx context.Context, command string, params map[string]any) (json.RawMessage, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	encodedParams, err := encodeActionParams(params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := m.invoke(ctx, actionExportGet, strings.TrimSpace(command), encodedParams, m.host.network)
+	if err != nil {
+		return nil, err
+	}
+	return decodeActionResult(result)
+}
+
+// ActionInfoHash returns the hex SHA-256 of an INFO manifest, as stored in
+// ActionRegistration.Hash.
+func ActionInfoHash(infoJSON []byte) string {
+	return sha256Hex(infoJSON)
+}
+
+func (m *ActionModule) requireAction(action string) error {
+	trimmed := strings.TrimSpace(action)
+	if trimmed == "" {
+		return fmt.Errorf("action is required")
+	}
+	for _, definition := range m.info.Actions {
+		if definition.Name == trimmed {
+			return nil
+		}
+	}
+	return fmt.Errorf("action %q is not declared in the module INFO manifest", trimmed)
+}
+
+func (m *ActionModule) invoke(ctx context.Context, export string, arguments ...string) ([]byte, error) {
+	invokeCtx, cancel := context.WithTimeout(ctx, m.host.timeout)
+	defer cancel()
+
+	instance, err := m.runtime.InstantiateModule(invokeCtx, m.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate action module: %w", err)
+	}
+	defer instance.Close(context.Background())
+
+	function := instance.ExportedFunction(export)
+	retptr := m.abi == actionABIWasmBindgen && len(function.Definition().ResultTypes()) == 0
+	params := make([]uint64, 0, len(arguments)*2+1)
+	if m.abi == actionABIWasmBindgen {
+		if start := instance.ExportedFunction(wasmBindgenExportStart); start != nil {
+			if _, err := start.Call(invokeCtx); err != nil {
+				return nil, wrapActionError(invokeCtx, wasmBindgenExportStart, err)
+			}
+		}
+		if retptr {
+			results, err := instance.ExportedFunction(wasmBindgenExportStackPointer).Call(invokeCtx, api.EncodeI32(-16))
+			if err != nil {
+				return nil, wrapActionError(invokeCtx, export, err)
+			}
+			params = append(params, uint64(uint32(results[0])))
+		}
+	}
+	for _, argument := range arguments {
+		pointer, length, err := m.writeString(invokeCtx, instance, argument)
+		if err != nil {
+			return nil, wrapActionError(invokeCtx, export, err)
+		}
+		params = append(params, uint64(pointer), uint64(length))
+	}
+
+	results, err := function.Call(invokeCtx, params...)
+	if err != nil {
+		return nil, wrapActionError(invokeCtx, export, err)
+	}
+
+	var pointer, length uint32
+	switch {
+	case retptr:
+		var okPointer, okLength bool
+		pointer, okPointer = instance.Memory().ReadUint32Le(uint32(params[0]))
+		length, okLength = instance.Memory().ReadUint32Le(uint32(params[0]) + 4)
+		if !okPointer || !okLength {
+			return nil, fmt.Errorf("action %s returned out-of-bounds return pointer", export)
+		}
+	case m.abi == actionABIWasmBindgen:
+		pointer, length = uint32(results[0]), uint32(results[1])
+	default:
+		if len(results) != 1 {
+			return nil, fmt.Errorf("action %s returned %d values, expected 1", export, len(results))
+		}
+		pointer, length = uint32(results[0]>>32), uint32(results[0])
+	}
+	output, ok := instance.Memory().Read(pointer, length)
+	if !ok {
+		return nil, fmt.Errorf("action %s returned out-of-bounds result", export)
+	}
+	return append([]byte(nil), output...), nil
+}
+
+// writeString copies value into the instance through the module's allocator.
+// wasm-bindgen allocators take the alignment as an optional second argument.
+func (m *ActionModule) writeString(ctx context.Context, instance api.Module, value string) (uint32, uint32, error) {
+	length := uint32(len(value))
+	allocParams := []uint64{uint64(length)}
+	allocator := instance.ExportedFunction(actionExportAlloc)
+	if m.abi == actionABIWasmBindgen {
+		allocator = instance.ExportedFunction(wasmBindgenExportMalloc)
+		if len(allocator.Definition().ParamTypes()) == 2 {
+			allocParams = append(allocParams, 1)
+		}
+	}
+	results, err := allocator.Call(ctx, allocParams...)
+	if err != nil {
+		return 0, 0, err
+	}
+	pointer := uint32(results[0])
+	if !instance.Memory().Write(pointer, []byte(value)) {
+		return 0, 0, fmt.Errorf("alloc returned out-of-bounds pointer %d", pointer)
+	}
+	return pointer, length, nil
+}
+
+func wrapActionError(ctx context.Context, export string, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("action %s exceeded time limit: %w", export, context.DeadlineExceeded)
+	}
+	return fmt.Errorf("action %s failed: %w", export, err)
+}
+
+func validateActionExports(compiled wazero.CompiledModule) (actionABI, error) {
+	if err := validateActionImports(compiled); err != nil {
+		return 0, err
+	}
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		return 0, fmt.Errorf("action module must export memory")
+	}
+
+	expected := map[string]int{
+		actionExportInfo: 0,
+		actionExportPost: 4,
+		actionExportGet:  3,
+	}
+	exports := compiled.ExportedFunctions()
+	if _, ok := exports[wasmBindgenExportMalloc]; ok {
+		return actionABIWasmBindgen, validateWasmBindgenExports(exports, expected)
+	}
+	if len(compiled.ImportedFunctions()) > 0 {
+		moduleName, name, _ := compiled.ImportedFunctions()[0].Import()
+		return 0, fmt.Errorf("action module imports %s.%s but does not export %s", moduleName, name, wasmBindgenExportMalloc)
+	}
+
+	if err := requireActionExport(exports, actionExportAlloc, 1, []api.ValueType{api.ValueTypeI32}); err != nil {
+		return 0, err
+	}
+	for name, stringCount := range expected {
+		if err := requireActionExport(exports, name, stringCount*2, []api.ValueType{api.ValueTypeI64}); err != nil {
+			return 0, err
+		}
+	}
+	return actionABIPacked, nil
+}
+
+// validateWasmBindgenExports checks the allocator and the entry points of a
+// wasm-bindgen module. Each entry point either returns the (ptr, len) pair of
+// its result or, when it returns nothing, takes a return pointer first.
+func validateWasmBindgenExports(exports map[string]api.FunctionDefinition, expected map[string]int) error {
+	mallocParams := len(exports[wasmBindgenExportMalloc].ParamTypes())
+	if mallocParams != 1 && mallocParams != 2 {
+		return fmt.Errorf("action export %s must take 1 or 2 parameters", wasmBindgenExportMalloc)
+	}
+	if err := requireActionExport(exports, wasmBindgenExportMalloc, mallocParams, []api.ValueType{api.ValueTypeI32}); err != nil {
+		return err
+	}
+	if start, ok := exports[wasmBindgenExportStart]; ok && (len(start.ParamTypes()) != 0 || len(start.ResultTypes()) != 0) {
+		return fmt.Errorf("action export %s must take no parameters and return nothing", wasmBindgenExportStart)
+	}
+
+	for name, stringCount := range expected {
+		definition, ok := exports[name]
+		if !ok {
+			return fmt.Errorf("action module must export %s", name)
+		}
+		if len(definition.ResultTypes()) != 0 {
+			if err := requireActionExport(exports, name, stringCount*2, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := requireActionExport(exports, name, stringCount*2+1, nil); err != nil {
+			return err
+		}
+		if err := requireActionExport(exports, wasmBindgenExportStackPointer, 1, []api.ValueType{api.ValueTypeI32}); err != nil {
+			return fmt.Errorf("action export %s returns through a return pointer: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// requireActionExport checks that name is exported with paramCount i32
+// parameters and the given results.
+func requireActionExport(
+	exports map[string]api.FunctionDefinition,
+	name string,
+	paramCount int,
+	results []api.ValueType,
+) error {
+	definition, ok := exports[name]
+	if !ok {
+		return fmt.Errorf("action module must export %s", name)
+	}
+	if len(definition.ParamTypes()) != paramCount {
+		return fmt.Errorf("action export %s must take %d parameters", name, paramCount)
+	}
+	for _, paramType := range definition.ParamTypes() {
+		if paramType != api.ValueTypeI32 {
+			return fmt.Errorf("action export %s parameters must be i32", name)
+		}
+	}
+	actual := definition.ResultTypes()
+	if len(actual) != len(results) {
+		return fmt.Errorf("action export %s must return %s", name, describeValueTypes(results))
+	}
+	for index, resultType := range results {
+		if actual[index] != resultType {
+			return fmt.Errorf("action export %s must return %s", name, describeValueTypes(results))
+		}
+	}
+	return nil
+}
+
+func describeValueTypes(types []api.ValueType) string {
+	if len(types) == 0 {
+		return "nothing"
+	}
+	names := make([]string, 0, len(types))
+	for _, valueType := range types {
+		names = append(names, api.ValueTypeName(valueType))
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
+
+// validateActionImports allows only wasm-bindgen glue imports.
+func validateActionImports(compiled wazero.CompiledModule) error {
+	for _, definition := range compiled.ImportedFunctions() {
+		moduleName, name, _ := definition.Import()
+		if !isWasmBindgenImport(moduleName, name) {
+			return fmt.Errorf("action module must not import host functions, found %s.%s", moduleName, name)
+		}
+	}
+	return nil
+}
+
+func isWasmBindgenImport(moduleName string, name string) bool {
+	return moduleName == "__wbindgen_placeholder__" ||
+		moduleName == "__wbindgen_externref_xform__" ||
+		strings.HasPrefix(name, "__wbindgen_") ||
+		strings.HasPrefix(name, "__wbg_")
+}
+
+// instantiateWasmBindgenGlue instantiates host modules satisfying every glue
+// import of compiled.
+func instantiateWasmBindgenGlue(ctx context.Context, runtime wazero.Runtime, compiled wazero.CompiledModule) error {
+	builders := map[string]wazero.HostModuleBuilder{}
+	for _, definition := range compiled.ImportedFunctions() {
+		moduleName, name, _ := definition.Import()
+		builder, ok := builders[moduleName]
+		if !ok {
+			builder = runtime.NewHostModuleBuilder(moduleName)
+			builders[moduleName] = builder
+		}
+		builder.NewFunctionBuilder().
+			WithGoModuleFunction(wasmBindgenGlue(name), definition.ParamTypes(), definition.ResultTypes()).
+			Export(name)
+	}
+	for moduleName, builder := range builders {
+		if _, err := builder.Instantiate(ctx); err != nil {
+			return fmt.Errorf("failed to provide wasm-bindgen imports from %s: %w", moduleName, err)
+		}
+	}
+	return nil
+}
+
+// wasmBindgenGlue implements a wasm-bindgen import. Only the imports that do
+// not need a JavaScript value have an implementation; the rest fail the call.
+func wasmBindgenGlue(name string) api.GoModuleFunc {
+	switch name {
+	case wasmBindgenImportThrow:
+		return func(_ context.Context, module api.Module, stack []uint64) {
+			if len(stack) < 2 {
+				panic(fmt.Errorf("%s called with %d arguments", name, len(stack)))
+			}
+			message, _ := module.Memory().Read(api.DecodeU32(stack[0]), api.DecodeU32(stack[1]))
+			panic(fmt.Errorf("action threw: %s", message))
+		}
+	case wasmBindgenImportInitExternref:
+		return func(context.Context, api.Module, []uint64) {}
+	default:
+		return func(context.Context, api.Module, []uint64) {
+			panic(fmt.Errorf("wasm-bindgen import %s needs a JavaScript host", name))
+		}
+	}
+}
+
+func encodeActionParams(params map[string]any) (string, error) {
+	if params == nil {
+		params = map[string]any{}
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode action params: %w", err)
+	}
+	return string(encoded), nil
+}
+
+func decodeActionResult(result []byte) (json.RawMessage, error) {
+	if !json.Valid(result) {
+		return nil, fmt.Errorf("action returned invalid JSON")
+	}
+	return json.RawMessage(result), nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,453 @@
+package hcs12
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+const testActionInfo = `{"name":"echo","version":"1.0.0","hashlinks_version":"0.1.0","actions":[{"name":"echo","description":"returns params"}]}`
+
+// echoParamsBody returns (params_ptr << 32) | params_len.
+var echoParamsBody = []byte{
+	0x20, 0x02, 0xad, 0x42, 0x20, 0x86,
+	0x20, 0x03, 0xad, 0x84,
+}
+
+// spinBody loops forever.
+var spinBody = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00}
+
+func TestActionHostLoadAndInvoke(t *testing.T) {
+	ctx := context.Background()
+	wasm := buildTestActionModule(testActionInfo, echoParamsBody)
+	host := newTestActionHost(t, wasm, 0)
+
+	module, err := host.Load(ctx, ActionRegistration{
+		TID:      "0.0.500",
+		Hash:     ActionInfoHash([]byte(testActionInfo)),
+		WasmHash: sha256Hex(wasm),
+	})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if module.Info().Name != "echo" || len(module.Info().Actions) != 1 {
+		t.Fatalf("unexpected info: %+v", module.Info())
+	}
+
+	result, err := module.Post(ctx, "echo", map[string]any{"amount": 5}, "memo")
+	if err != nil {
+		t.Fatalf("post failed: %v", err)
+	}
+	if string(result) != `{"amount":5}` {
+		t.Fatalf("unexpected post result: %s", result)
+	}
+
+	result, err = module.Get(ctx, "status", map[string]any{"id": "a"})
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if string(result) != `{"id":"a"}` {
+		t.Fatalf("unexpected get result: %s", result)
+	}
+
+	if _, err := module.Post(ctx, "transfer", nil, ""); err == nil {
+		t.Fatal("expected undeclared action error")
+	}
+}
+
+func TestActionHostRejectsHashMismatch(t *testing.T) {
+	ctx := context.Background()
+	wasm := buildTestActionModule(testActionInfo, echoParamsBody)
+	host := newTestActionHost(t, wasm, 0)
+
+	if _, err := host.Load(ctx, ActionRegistration{TID: "0.0.500"}); err == nil {
+		t.Fatal("expected missing hash error")
+	}
+	_, err := host.Load(ctx, ActionRegistration{
+		TID:  "0.0.500",
+		Hash: ActionInfoHash([]byte(`{"name":"other"}`)),
+	})
+	if err == nil || !strings.Contains(err.Error(), "INFO hash mismatch") {
+		t.Fatalf("expected INFO hash mismatch, got %v", err)
+	}
+	_, err = host.Load(ctx, ActionRegistration{
+		TID:      "0.0.500",
+		Hash:     ActionInfoHash([]byte(testActionInfo)),
+		WasmHash: sha256Hex([]byte("other")),
+	})
+	if err == nil || !strings.Contains(err.Error(), "wasm hash mismatch") {
+		t.Fatalf("expected wasm hash mismatch, got %v", err)
+	}
+}
+
+func TestActionHostEnforcesTimeout(t *testing.T) {
+	ctx := context.Background()
+	host := newTestActionHost(t, buildTestActionModule(testActionInfo, spinBody), 50*time.Millisecond)
+
+	module, err := host.Load(ctx, ActionRegistration{
+		TID:  "0.0.500",
+		Hash: ActionInfoHash([]byte(testActionInfo)),
+	})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	_, err = module.Post(ctx, "echo", nil, "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestActionHostRejectsInvalidModule(t *testing.T) {
+	host := newTestActionHost(t, []byte("not wasm"), 0)
+	_, err := host.Load(context.Background(), ActionRegistration{
+		TID:  "0.0.500",
+		Hash: ActionInfoHash([]byte(testActionInfo)),
+	})
+	if err == nil {
+		t.Fatal("expected compile error")
+	}
+}
+
+func TestActionHostRejectsHostImports(t *testing.T) {
+	name := func(value string) []byte {
+		return append(uleb(uint64(len(value))), value...)
+	}
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, 0x01, 0x04, 0x01, 0x60, 0x00, 0x00)
+	imports := append([]byte{0x01}, name("env")...)
+	imports = append(imports, name("now")...)
+	imports = append(imports, 0x00, 0x00)
+	module = append(append(module, 0x02), append(uleb(uint64(len(imports))), imports...)...)
+
+	host := newTestActionHost(t, module, 0)
+	_, err := host.Load(context.Background(), ActionRegistration{
+		TID:  "0.0.500",
+		Hash: ActionInfoHash([]byte(testActionInfo)),
+	})
+	if err == nil || !strings.Contains(err.Error(), "env.now") {
+		t.Fatalf("expected host import rejection, got %v", err)
+	}
+}
+
+func TestActionHostRunsWasmBindgenModule(t *testing.T) {
+	ctx := context.Background()
+	// POST(retptr, action, params, network, memo) stores params at retptr.
+	echoBody := []byte{0x20, 0x00, 0x20, 0x03, 0x36, 0x02, 0x00, 0x20, 0x00, 0x20, 0x04, 0x36, 0x02, 0x04}
+	// POST passes the action to __wbindgen_throw.
+	throwBody := []byte{0x20, 0x01, 0x20, 0x02, 0x10, 0x00, 0x00}
+
+	wasm := buildTestWasmBindgenActionModule(testActionInfo, echoBody)
+	host := newTestActionHost(t, wasm, 0)
+	module, err := host.Load(ctx, ActionRegistration{TID: "0.0.500", Hash: ActionInfoHash([]byte(testActionInfo))})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if module.Info().Name != "echo" {
+		t.Fatalf("unexpected info: %+v", module.Info())
+	}
+
+	result, err := module.Post(ctx, "echo", map[string]any{"amount": 5}, "memo")
+	if err != nil {
+		t.Fatalf("post failed: %v", err)
+	}
+	if string(result) != `{"amount":5}` {
+		t.Fatalf("unexpected post result: %s", result)
+	}
+	result, err = module.Get(ctx, "status", map[string]any{"id": "a"})
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if string(result) != `{"id":"a"}` {
+		t.Fatalf("unexpected get result: %s", result)
+	}
+	if err := module.Close(ctx); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	throwing := newTestActionHost(t, buildTestWasmBindgenActionModule(testActionInfo, throwBody), 0)
+	module, err = throwing.Load(ctx, ActionRegistration{TID: "0.0.500", Hash: ActionInfoHash([]byte(testActionInfo))})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if _, err := module.Post(ctx, "echo", nil, ""); err == nil || !strings.Contains(err.Error(), "action threw: echo") {
+		t.Fatalf("expected thrown error, got %v", err)
+	}
+}
+
+func TestClientActionHostLoadsFromHCS1(t *testing.T) {
+	wasm := buildTestActionModule(testActionInfo, echoParamsBody)
+	messages := encodeTestHCS1Messages(t, wasm, 40)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/api/v1/topics/0.0.500/messages" {
+			http.NotFound(writer, request)
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(writer).Encode(map[string]any{"messages": messages})
+	}))
+	defer server.Close()
+
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	client, err := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  "0.0.1",
+		OperatorPrivateKey: privateKey.String(),
+		MirrorBaseURL:      server.URL,
+	})
+	if err != nil {
+		t.Fatalf("unexpected client error: %v", err)
+	}
+
+	ctx := context.Background()
+	host, err := client.NewActionHost(ctx, ActionHostConfig{})
+	if err != nil {
+		t.Fatalf("unexpected host error: %v", err)
+	}
+	defer host.Close(ctx)
+
+	module, err := host.Load(ctx, ActionRegistration{
+		TID:      "0.0.500",
+		Hash:     ActionInfoHash([]byte(testActionInfo)),
+		WasmHash: sha256Hex(wasm),
+	})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if string(module.InfoJSON()) != testActionInfo {
+		t.Fatalf("unexpected INFO: %s", module.InfoJSON())
+	}
+}
+
+func newTestActionHost(t *testing.T, wasm []byte, timeout time.Duration) *ActionHost {
+	t.Helper()
+	ctx := context.Background()
+	host, err := NewActionHost(ctx, ActionHostConfig{
+		Timeout: timeout,
+		Loader: func(context.Context, string) ([]byte, error) {
+			return wasm, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected host error: %v", err)
+	}
+	t.Cleanup(func() { _ = host.Close(ctx) })
+	return host
+}
+
+func encodeTestHCS1Messages(t *testing.T, content []byte, chunkSize int) []map[string]any {
+	t.Helper()
+	var compressed bytes.Buffer
+	writer := brotli.NewWriter(&compressed)
+	_, _ = writer.Write(content)
+	_ = writer.Close()
+
+	encoded := "data:application/wasm;base64," + base64.StdEncoding.EncodeToString(compressed.Bytes())
+	messages := []map[string]any{}
+	for order := 0; order*chunkSize < len(encoded); order++ {
+		end := min((order+1)*chunkSize, len(encoded))
+		chunk, _ := json.Marshal(map[string]any{"o": order, "c": encoded[order*chunkSize : end]})
+		messages = append(messages, map[string]any{
+			"sequence_number": order + 1,
+			"message":         base64.StdEncoding.EncodeToString(chunk),
+		})
+	}
+	return messages
+}
+
+// buildTestActionModule assembles a minimal module implementing the action
+// host ABI. INFO returns the given manifest, GET echoes params, and POST runs
+// the supplied body.
+func buildTestActionModule(info string, postBody []byte) []byte {
+	const infoOffset = 16
+	const heapStart = 1024
+
+	i32 := byte(0x7f)
+	i64 := byte(0x7e)
+	funcType := func(params int, result byte) []byte {
+		encoded := append([]byte{0x60}, uleb(uint64(params))...)
+		for index := 0; index < params; index++ {
+			encoded = append(encoded, i32)
+		}
+		return append(encoded, 0x01, result)
+	}
+	name := func(value string) []byte {
+		return append(uleb(uint64(len(value))), value...)
+	}
+	vector := func(items ...[]byte) []byte {
+		encoded := uleb(uint64(len(items)))
+		for _, item := range items {
+			encoded = append(encoded, item...)
+		}
+		return encoded
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(uint64(len(content)))...), content...)
+	}
+	code := func(body []byte) []byte {
+		entry := append([]byte{0x00}, body...)
+		entry = append(entry, 0x0b)
+		return append(uleb(uint64(len(entry))), entry...)
+	}
+
+	allocBody := []byte{0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00}
+	infoBody := append([]byte{0x41}, sleb(infoOffset)...)
+	infoBody = append(infoBody, 0xad, 0x42, 0x20, 0x86, 0x41)
+	infoBody = append(infoBody, sleb(int64(len(info)))...)
+	infoBody = append(infoBody, 0xad, 0x84)
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01, vector(
+		funcType(1, i32),
+		funcType(0, i64),
+		funcType(8, i64),
+		funcType(6, i64),
+	))...)
+	module = append(module, section(0x03, vector([]byte{0x00}, []byte{0x01}, []byte{0x02}, []byte{0x03}))...)
+	module = append(module, section(0x05, vector([]byte{0x00, 0x01}))...)
+	module = append(module, section(0x06, vector(append(append([]byte{i32, 0x01, 0x41}, sleb(heapStart)...), 0x0b)))...)
+	module = append(module, section(0x07, vector(
+		append(name("memory"), 0x02, 0x00),
+		append(name("alloc"), 0x00, 0x00),
+		append(name("INFO"), 0x00, 0x01),
+		append(name("POST"), 0x00, 0x02),
+		append(name("GET"), 0x00, 0x03),
+	))...)
+	module = append(module, section(0x0a, vector(
+		code(allocBody),
+		code(infoBody),
+		code(postBody),
+		code(echoParamsBody),
+	))...)
+	dataSegment := append(append([]byte{0x00, 0x41}, sleb(infoOffset)...), 0x0b)
+	dataSegment = append(dataSegment, name(info)...)
+	module = append(module, section(0x0b, vector(dataSegment))...)
+	return module
+}
+
+// buildTestWasmBindgenActionModule assembles a module shaped like
+// wasm-bindgen output. It imports __wbindgen_throw, INFO and POST return
+// through a retptr, and GET returns a (ptr, len) pair that echoes params.
+func buildTestWasmBindgenActionModule(info string, postBody []byte) []byte {
+	const infoOffset = 16
+	const heapStart = 1024
+	const stackStart = 65536
+
+	i32 := byte(0x7f)
+	funcType := func(params int, results int) []byte {
+		encoded := append([]byte{0x60}, uleb(uint64(params))...)
+		for index := 0; index < params; index++ {
+			encoded = append(encoded, i32)
+		}
+		encoded = append(encoded, uleb(uint64(results))...)
+		for index := 0; index < results; index++ {
+			encoded = append(encoded, i32)
+		}
+		return encoded
+	}
+	name := func(value string) []byte {
+		return append(uleb(uint64(len(value))), value...)
+	}
+	vector := func(items ...[]byte) []byte {
+		encoded := uleb(uint64(len(items)))
+		for _, item := range items {
+			encoded = append(encoded, item...)
+		}
+		return encoded
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(uint64(len(content)))...), content...)
+	}
+	code := func(body []byte) []byte {
+		entry := append([]byte{0x00}, body...)
+		entry = append(entry, 0x0b)
+		return append(uleb(uint64(len(entry))), entry...)
+	}
+	mutableGlobal := func(value int64) []byte {
+		return append(append([]byte{i32, 0x01, 0x41}, sleb(value)...), 0x0b)
+	}
+
+	mallocBody := []byte{0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00}
+	stackPointerBody := []byte{0x23, 0x01, 0x20, 0x00, 0x6a, 0x24, 0x01, 0x23, 0x01}
+	infoBody := append([]byte{0x20, 0x00, 0x41}, sleb(infoOffset)...)
+	infoBody = append(infoBody, 0x36, 0x02, 0x00, 0x20, 0x00, 0x41)
+	infoBody = append(infoBody, sleb(int64(len(info)))...)
+	infoBody = append(infoBody, 0x36, 0x02, 0x04)
+	getBody := []byte{0x20, 0x02, 0x20, 0x03}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01, vector(
+		funcType(2, 0),
+		funcType(2, 1),
+		funcType(1, 1),
+		funcType(1, 0),
+		funcType(9, 0),
+		funcType(6, 2),
+	))...)
+	module = append(module, section(0x02, vector(
+		append(append(name("__wbindgen_placeholder__"), name("__wbindgen_throw")...), 0x00, 0x00),
+	))...)
+	module = append(module, section(0x03, vector([]byte{0x01}, []byte{0x02}, []byte{0x03}, []byte{0x04}, []byte{0x05}))...)
+	module = append(module, section(0x05, vector([]byte{0x00, 0x01}))...)
+	module = append(module, section(0x06, vector(mutableGlobal(heapStart), mutableGlobal(stackStart)))...)
+	module = append(module, section(0x07, vector(
+		append(name("memory"), 0x02, 0x00),
+		append(name("__wbindgen_malloc"), 0x00, 0x01),
+		append(name("__wbindgen_add_to_stack_pointer"), 0x00, 0x02),
+		append(name("INFO"), 0x00, 0x03),
+		append(name("POST"), 0x00, 0x04),
+		append(name("GET"), 0x00, 0x05),
+	))...)
+	module = append(module, section(0x0a, vector(
+		code(mallocBody),
+		code(stackPointerBody),
+		code(infoBody),
+		code(postBody),
+		code(getBody),
+	))...)
+	dataSegment := append(append([]byte{0x00, 0x41}, sleb(infoOffset)...), 0x0b)
+	dataSegment = append(dataSegment, name(info)...)
+	module = append(module, section(0x0b, vector(dataSegment))...)
+	return module
+}
+
+func uleb(value uint64) []byte {
+	encoded := []byte{}
+	for {
+		current := byte(value & 0x7f)
+		value >>= 7
+		if value != 0 {
+			current |= 0x80
+		}
+		encoded = append(encoded, current)
+		if value == 0 {
+			return encoded
+		}
+	}
+}
+
+func sleb(value int64) []byte {
+	encoded := []byte{}
+	for {
+		current := byte(value & 0x7f)
+		value >>= 7
+		done := (value == 0 && current&0x40 == 0) || (value == -1 && current&0x40 != 0)
+		if !done {
+			current |= 0x80
+		}
+		encoded = append(encoded, current)
+		if done {
+			return encoded
+		}
+	}
+}
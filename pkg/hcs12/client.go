@@ -14,7 +14,9 @@ import (
 )
 
 type Client struct {
+	network           string
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
@@ -27,11 +29,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -47,7 +50,9 @@ func NewClient(config ClientConfig) (*Client, error) {
 	}
 
 	return &Client{
+		network:           network,
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
@@ -62,8 +67,6 @@ func (c *Client) MirrorClient() *mirror.Client {
 
 // CreateRegistryTopic creates the requested resource.
 func (c *Client) CreateRegistryTopic(ctx context.Context, options CreateRegistryTopicOptions) (CreateTopicResult, error) {
-	_ = ctx
-
 	transaction, err := BuildCreateRegistryTopicTx(CreateRegistryTopicTxParams{
 		RegistryType: options.RegistryType,
 		TTL:          options.TTL,
@@ -78,21 +81,18 @@ func (c *Client) CreateRegistryTopic(ctx context.Context, options CreateRegistry
 		transaction.SetTransactionMemo(strings.TrimSpace(options.TransactionMemo))
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return CreateTopicResult{}, fmt.Errorf("failed to execute topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return CreateTopicResult{}, fmt.Errorf("failed to get topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return CreateTopicResult{}, fmt.Errorf("topic create receipt missing topic ID")
 	}
 	return CreateTopicResult{
 		Success:       true,
 		TopicID:       receipt.TopicID.String(),
-		TransactionID: response.TransactionID.String(),
+		TransactionID: executed.TransactionID,
 	}, nil
 }
 
@@ -103,23 +103,18 @@ func (c *Client) SubmitMessage(
 	payload any,
 	transactionMemo string,
 ) (SubmitMessageResult, error) {
-	_ = ctx
-
 	transaction, err := BuildSubmitMessageTx(topicID, payload, transactionMemo)
 	if err != nil {
 		return SubmitMessageResult{}, err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return SubmitMessageResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return SubmitMessageResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	return SubmitMessageResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
+		TransactionID:  executed.TransactionID,
 		SequenceNumber: int64(receipt.TopicSequenceNumber),
 	}, nil
 }
@@ -149,6 +144,12 @@ func (c *Client) RegisterAction(
 	if registration.TID != "" {
 		payload["t_id"] = registration.TID
 	}
+	if registration.Hash != "" {
+		payload["hash"] = registration.Hash
+	}
+	if registration.WasmHash != "" {
+		payload["wasm_hash"] = registration.WasmHash
+	}
 	return c.SubmitMessage(ctx, topicID, payload, transactionMemo)
 }
 
@@ -1,6 +1,10 @@
 // Package hcs12 implements core HCS-12 HashLinks registry operations.
 // It supports action/assembly/hashlinks registry topic creation, message
-// builders, submissions, and mirror-node entry reads.
+// builders, submissions, and mirror-node entry reads. ActionHost loads
+// action modules from HCS-1 topics, verifies them against their
+// registration hashes, and runs them in a memory- and time-limited sandbox.
+// Modules may export the import-free alloc/ptr-len ABI documented on
+// ActionHost or be wasm-bindgen builds with synchronous string entry points.
 //
 // # Specification
 //
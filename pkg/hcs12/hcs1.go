@@ -0,0 +1,16 @@
+package hcs12
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ResolveHCS1File fetches and reassembles the HCS-1 file stored on the topic.
+func (c *Client) ResolveHCS1File(ctx context.Context, topicID string) ([]byte, error) {
+	trimmedTopicID := strings.TrimSpace(topicID)
+	if !topicIDPattern.MatchString(trimmedTopicID) {
+		return nil, fmt.Errorf("invalid HCS-1 topic ID %q", topicID)
+	}
+	return c.mirrorClient.GetHCS1File(ctx, trimmedTopicID)
+}
@@ -1,6 +1,13 @@
 package hcs12
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"context"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type RegistryType string
 
@@ -28,6 +35,8 @@ type ActionRegistration struct {
 	Author      string   `json:"author,omitempty"`
 	Tags        []string `json:"tags,omitempty"`
 	TID         string   `json:"t_id,omitempty"`
+	Hash        string   `json:"hash,omitempty"`
+	WasmHash    string   `json:"wasm_hash,omitempty"`
 }
 
 type AssemblyRegistration struct {
@@ -77,10 +86,13 @@ type HashLinksRegistration struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateRegistryTopicOptions struct {
@@ -128,3 +140,32 @@ type CreateRegistryTopicTxParams struct {
 	SubmitKey    hedera.Key
 	MemoOverride string
 }
+
+// ActionModuleLoaderFunc returns the WASM bytes stored on an action topic.
+type ActionModuleLoaderFunc func(ctx context.Context, topicID string) ([]byte, error)
+
+type ActionHostConfig struct {
+	Network          string
+	MemoryLimitPages uint32
+	Timeout          time.Duration
+	Loader           ActionModuleLoaderFunc
+}
+
+type ActionInfo struct {
+	Name             string             `json:"name"`
+	Version          string             `json:"version"`
+	HashLinksVersion string             `json:"hashlinks_version,omitempty"`
+	Creator          string             `json:"creator,omitempty"`
+	Purpose          string             `json:"purpose,omitempty"`
+	Actions          []ActionDefinition `json:"actions"`
+	Capabilities     []string           `json:"capabilities,omitempty"`
+	Plugins          []string           `json:"plugins,omitempty"`
+}
+
+type ActionDefinition struct {
+	Name                 string           `json:"name"`
+	Description          string           `json:"description,omitempty"`
+	Inputs               []map[string]any `json:"inputs,omitempty"`
+	Outputs              []map[string]any `json:"outputs,omitempty"`
+	RequiredCapabilities []string         `json:"required_capabilities,omitempty"`
+}
@@ -60,7 +60,7 @@ func NewAIDDNSWebResolver(options AIDDNSWebResolverOptions) *AIDDNSWebResolver {
 	}
 
 	return &AIDDNSWebResolver{
-		dnsLookup:        lookup,
+		dnsLookup:        traceDNSLookup(lookup),
 		supportedSchemes: supportedSchemes,
 		metadataVerifier: options.MetadataVerifier,
 		cryptoVerifier:   options.CryptoVerifier,
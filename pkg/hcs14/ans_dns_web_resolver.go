@@ -67,8 +67,8 @@ func NewANSDNSWebResolver(options ANSDNSWebResolverOptions) *ANSDNSWebResolver {
 	}
 
 	return &ANSDNSWebResolver{
-		dnsLookup:        lookup,
-		httpClient:       httpClient,
+		dnsLookup:        traceDNSLookup(lookup),
+		httpClient:       withTraceTransport(httpClient),
 		supportedSchemes: supportedSchemes,
 	}
 }
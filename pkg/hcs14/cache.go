@@ -0,0 +1,426 @@
+package hcs14
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDNSCacheTTL         = 5 * time.Minute
+	defaultDNSCacheNegativeTTL = time.Minute
+	defaultDNSCacheMaxTTL      = time.Hour
+	defaultDNSCacheMaxEntries  = 1024
+	defaultHTTPCacheMaxBody    = 1 << 20
+	defaultHTTPCacheMaxEntries = 512
+)
+
+// DNSTTLLookupFunc looks up TXT records and reports how long the answer may
+// be cached, typically the smallest TTL in the answer section.
+type DNSTTLLookupFunc func(ctx context.Context, hostname string) ([]string, time.Duration, error)
+
+// DNSCache caches TXT lookups for their DNS TTL. Without a configured lookup
+// it uses the system resolver, which does not report TTLs, so answers are
+// kept for DefaultTTL; set TTLLookup, for example to NewDNSServerTXTLookup,
+// to honour each answer's TTL. Failed lookups are not cached. Once
+// MaxEntries answers are held, the least recently used one is evicted.
+type DNSCache struct {
+	lookup      DNSTTLLookupFunc
+	negativeTTL time.Duration
+	maxTTL      time.Duration
+	maxEntries  int
+	now         func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type DNSCacheOptions struct {
+	// TTLLookup takes precedence over Lookup when both are set.
+	TTLLookup DNSTTLLookupFunc
+	// Lookup replaces the system resolver with another lookup that cannot
+	// report a TTL; its answers are kept for DefaultTTL.
+	Lookup      DNSLookupFunc
+	DefaultTTL  time.Duration
+	NegativeTTL time.Duration
+	MaxTTL      time.Duration
+	// MaxEntries bounds the number of cached answers. Defaults to 1024.
+	MaxEntries int
+}
+
+type dnsCacheEntry struct {
+	key     string
+	records []string
+	expires time.Time
+}
+
+// NewDNSCache creates a new DNSCache.
+func NewDNSCache(options DNSCacheOptions) *DNSCache {
+	defaultTTL := options.DefaultTTL
+	if defaultTTL <= 0 {
+		defaultTTL = defaultDNSCacheTTL
+	}
+	negativeTTL := options.NegativeTTL
+	if negativeTTL <= 0 {
+		negativeTTL = defaultDNSCacheNegativeTTL
+	}
+	maxTTL := options.MaxTTL
+	if maxTTL <= 0 {
+		maxTTL = defaultDNSCacheMaxTTL
+	}
+	maxEntries := options.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultDNSCacheMaxEntries
+	}
+
+	lookup := options.TTLLookup
+	if lookup == nil {
+		plain := options.Lookup
+		if plain == nil {
+			plain = nodeDNSTXTLookup
+		}
+		lookup = func(ctx context.Context, hostname string) ([]string, time.Duration, error) {
+			records, err := plain(ctx, hostname)
+			return records, defaultTTL, err
+		}
+	}
+
+	return &DNSCache{
+		lookup:      lookup,
+		negativeTTL: negativeTTL,
+		maxTTL:      maxTTL,
+		maxEntries:  maxEntries,
+		now:         time.Now,
+		entries:     map[string]*list.Element{},
+		order:       list.New(),
+	}
+}
+
+// Lookup returns cached TXT records for hostname, querying on a miss. It
+// satisfies DNSLookupFunc.
+func (cache *DNSCache) Lookup(ctx context.Context, hostname string) ([]string, error) {
+	key := normalizeDomain(hostname)
+	cache.mutex.Lock()
+	entry, ok := cache.get(key)
+	if ok {
+		records := append([]string(nil), entry.records...)
+		cache.mutex.Unlock()
+		markTraceStepCached(ctx)
+		return records, nil
+	}
+	cache.mutex.Unlock()
+
+	records, ttl, err := cache.lookup(ctx, hostname)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 && (ttl <= 0 || ttl > cache.negativeTTL) {
+		ttl = cache.negativeTTL
+	}
+	if ttl > cache.maxTTL {
+		ttl = cache.maxTTL
+	}
+	if ttl > 0 {
+		cache.mutex.Lock()
+		cache.put(&dnsCacheEntry{
+			key:     key,
+			records: append([]string(nil), records...),
+			expires: cache.now().Add(ttl),
+		})
+		cache.mutex.Unlock()
+	}
+	return records, nil
+}
+
+// Purge drops every cached answer.
+func (cache *DNSCache) Purge() {
+	cache.mutex.Lock()
+	cache.entries = map[string]*list.Element{}
+	cache.order.Init()
+	cache.mutex.Unlock()
+}
+
+// Len returns the number of cached answers, including expired ones not yet
+// evicted.
+func (cache *DNSCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.order.Len()
+}
+
+// get returns a fresh entry and marks it most recently used. An expired
+// entry is removed. The caller must hold the mutex.
+func (cache *DNSCache) get(key string) (*dnsCacheEntry, bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*dnsCacheEntry)
+	if !cache.now().Before(entry.expires) {
+		cache.order.Remove(element)
+		delete(cache.entries, key)
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return entry, true
+}
+
+// put stores entry as most recently used and evicts the least recently used
+// entries beyond maxEntries. The caller must hold the mutex.
+func (cache *DNSCache) put(entry *dnsCacheEntry) {
+	if element, ok := cache.entries[entry.key]; ok {
+		element.Value = entry
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.entries[entry.key] = cache.order.PushFront(entry)
+	for cache.order.Len() > cache.maxEntries {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*dnsCacheEntry).key)
+	}
+}
+
+// HTTPCache caches successful GET responses for as long as their
+// Cache-Control max-age or Expires header allows. Responses marked no-store
+// or no-cache, or without freshness information, are not cached. Once
+// MaxEntries responses are held, the least recently used one is evicted.
+type HTTPCache struct {
+	maxBodyBytes int64
+	maxEntries   int
+	now          func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type HTTPCacheOptions struct {
+	// MaxBodyBytes bounds the size of a cacheable response body. Defaults to 1 MiB.
+	MaxBodyBytes int64
+	// MaxEntries bounds the number of cached responses. Defaults to 512.
+	MaxEntries int
+}
+
+type httpCacheEntry struct {
+	key        string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+type httpCacheTransport struct {
+	cache *HTTPCache
+	base  http.RoundTripper
+}
+
+// NewHTTPCache creates a new HTTPCache.
+func NewHTTPCache(options HTTPCacheOptions) *HTTPCache {
+	maxBodyBytes := options.MaxBodyBytes
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = defaultHTTPCacheMaxBody
+	}
+	maxEntries := options.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultHTTPCacheMaxEntries
+	}
+	return &HTTPCache{
+		maxBodyBytes: maxBodyBytes,
+		maxEntries:   maxEntries,
+		now:          time.Now,
+		entries:      map[string]*list.Element{},
+		order:        list.New(),
+	}
+}
+
+// Wrap returns a copy of client that serves requests through the cache. A nil
+// client is replaced by one with a 30 second timeout.
+func (cache *HTTPCache) Wrap(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped := *client
+	wrapped.Transport = &httpCacheTransport{cache: cache, base: base}
+	return &wrapped
+}
+
+// Purge drops every cached response.
+func (cache *HTTPCache) Purge() {
+	cache.mutex.Lock()
+	cache.entries = map[string]*list.Element{}
+	cache.order.Init()
+	cache.mutex.Unlock()
+}
+
+// Len returns the number of cached responses, including expired ones not yet
+// evicted.
+func (cache *HTTPCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.order.Len()
+}
+
+// get returns a fresh entry and marks it most recently used. An expired
+// entry is removed. The caller must hold the mutex.
+func (cache *HTTPCache) get(key string) (*httpCacheEntry, bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*httpCacheEntry)
+	if !cache.now().Before(entry.expires) {
+		cache.order.Remove(element)
+		delete(cache.entries, key)
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return entry, true
+}
+
+// put stores entry as most recently used and evicts the least recently used
+// entries beyond maxEntries. The caller must hold the mutex.
+func (cache *HTTPCache) put(entry *httpCacheEntry) {
+	if element, ok := cache.entries[entry.key]; ok {
+		element.Value = entry
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.entries[entry.key] = cache.order.PushFront(entry)
+	for cache.order.Len() > cache.maxEntries {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*httpCacheEntry).key)
+	}
+}
+
+func (transport *httpCacheTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet || hasCacheDirective(request.Header, "no-cache", "no-store") {
+		return transport.base.RoundTrip(request)
+	}
+
+	cache := transport.cache
+	key := request.URL.String() + "\n" + request.Header.Get("Accept")
+	cache.mutex.Lock()
+	entry, ok := cache.get(key)
+	if ok {
+		cache.mutex.Unlock()
+		markTraceStepCached(request.Context())
+		return &http.Response{
+			Status:        strconv.Itoa(entry.statusCode) + " " + http.StatusText(entry.statusCode),
+			StatusCode:    entry.statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        entry.header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       request,
+		}, nil
+	}
+	cache.mutex.Unlock()
+
+	response, err := transport.base.RoundTrip(request)
+	if err != nil || response.StatusCode != http.StatusOK {
+		return response, err
+	}
+	ttl := httpFreshnessLifetime(response.Header, cache.now())
+	if ttl <= 0 {
+		return response, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, cache.maxBodyBytes+1))
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	if int64(len(body)) > cache.maxBodyBytes {
+		response.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), response.Body), Closer: response.Body}
+		return response, nil
+	}
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	cache.mutex.Lock()
+	cache.put(&httpCacheEntry{
+		key:        key,
+		statusCode: response.StatusCode,
+		header:     response.Header.Clone(),
+		body:       body,
+		expires:    cache.now().Add(ttl),
+	})
+	cache.mutex.Unlock()
+	return response, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// httpFreshnessLifetime applies RFC 9111 freshness rules for a private
+// cache: max-age wins over Expires, and no-store or no-cache disable caching.
+func httpFreshnessLifetime(header http.Header, now time.Time) time.Duration {
+	if hasCacheDirective(header, "no-store", "no-cache") {
+		return 0
+	}
+	for _, directive := range cacheDirectives(header) {
+		name, value, _ := strings.Cut(directive, "=")
+		if name != "max-age" {
+			continue
+		}
+		seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+		if err != nil || seconds <= 0 {
+			return 0
+		}
+		if age, err := strconv.Atoi(header.Get("Age")); err == nil && age > 0 {
+			seconds -= age
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	expires, err := http.ParseTime(header.Get("Expires"))
+	if err != nil {
+		return 0
+	}
+	if date, dateErr := http.ParseTime(header.Get("Date")); dateErr == nil {
+		now = date
+	}
+	return expires.Sub(now)
+}
+
+func hasCacheDirective(header http.Header, names ...string) bool {
+	for _, directive := range cacheDirectives(header) {
+		name, _, _ := strings.Cut(directive, "=")
+		for _, candidate := range names {
+			if name == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func cacheDirectives(header http.Header) []string {
+	directives := make([]string, 0)
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			trimmed := strings.ToLower(strings.TrimSpace(directive))
+			if trimmed != "" {
+				directives = append(directives, trimmed)
+			}
+		}
+	}
+	return directives
+}
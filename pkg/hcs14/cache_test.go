@@ -0,0 +1,240 @@
+package hcs14
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestDNSCacheHonorsTTL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	queries := 0
+	cache := NewDNSCache(DNSCacheOptions{
+		TTLLookup: func(ctx context.Context, hostname string) ([]string, time.Duration, error) {
+			queries++
+			if hostname == "_uaid.missing.example.com" {
+				return []string{}, 0, nil
+			}
+			return []string{"v=1"}, 30 * time.Second, nil
+		},
+		NegativeTTL: 10 * time.Second,
+	})
+	cache.now = func() time.Time { return now }
+
+	for range 2 {
+		if records, err := cache.Lookup(context.Background(), "_uaid.Example.com."); err != nil || records[0] != "v=1" {
+			t.Fatalf("unexpected lookup: %v %v", records, err)
+		}
+	}
+	if queries != 1 {
+		t.Fatalf("expected one query, got %d", queries)
+	}
+	now = now.Add(31 * time.Second)
+	_, _ = cache.Lookup(context.Background(), "_uaid.example.com")
+	if queries != 2 {
+		t.Fatalf("expected expired entry to be refetched, got %d queries", queries)
+	}
+
+	_, _ = cache.Lookup(context.Background(), "_uaid.missing.example.com")
+	now = now.Add(5 * time.Second)
+	_, _ = cache.Lookup(context.Background(), "_uaid.missing.example.com")
+	now = now.Add(6 * time.Second)
+	_, _ = cache.Lookup(context.Background(), "_uaid.missing.example.com")
+	if queries != 4 {
+		t.Fatalf("expected negative answer cached for NegativeTTL, got %d queries", queries)
+	}
+}
+
+func TestHTTPFreshnessLifetime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		header   http.Header
+		expected time.Duration
+	}{
+		{http.Header{"Cache-Control": {"public, max-age=60"}}, time.Minute},
+		{http.Header{"Cache-Control": {"max-age=60"}, "Age": {"20"}}, 40 * time.Second},
+		{http.Header{"Cache-Control": {"no-store, max-age=60"}}, 0},
+		{http.Header{"Cache-Control": {"no-cache"}}, 0},
+		{http.Header{"Expires": {now.Add(time.Hour).Format(http.TimeFormat)}, "Date": {now.Format(http.TimeFormat)}}, time.Hour},
+		{http.Header{}, 0},
+	}
+	for _, testCase := range cases {
+		if actual := httpFreshnessLifetime(testCase.header, now); actual != testCase.expected {
+			t.Fatalf("httpFreshnessLifetime(%v) = %s, want %s", testCase.header, actual, testCase.expected)
+		}
+	}
+}
+
+type countingTransport struct {
+	requests int
+	header   http.Header
+}
+
+func (transport *countingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	transport.requests++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     transport.header.Clone(),
+		Body:       io.NopCloser(strings.NewReader(`{"ok":true}`)),
+		Request:    request,
+	}, nil
+}
+
+func TestHTTPCacheWrap(t *testing.T) {
+	transport := &countingTransport{header: http.Header{"Cache-Control": {"max-age=60"}}}
+	cache := NewHTTPCache(HTTPCacheOptions{})
+	client := cache.Wrap(&http.Client{Transport: transport})
+
+	for range 2 {
+		response, err := client.Get("https://agent.example.com/card.json")
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+		if string(body) != `{"ok":true}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+	}
+	if transport.requests != 1 {
+		t.Fatalf("expected one upstream request, got %d", transport.requests)
+	}
+
+	cache.Purge()
+	transport.header = http.Header{"Cache-Control": {"no-store"}}
+	for range 2 {
+		response, err := client.Get("https://agent.example.com/card.json")
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		response.Body.Close()
+	}
+	if transport.requests != 3 {
+		t.Fatalf("expected no-store responses to bypass the cache, got %d requests", transport.requests)
+	}
+}
+
+func TestHTTPCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	transport := &countingTransport{header: http.Header{"Cache-Control": {"max-age=60"}}}
+	cache := NewHTTPCache(HTTPCacheOptions{MaxEntries: 2})
+	client := cache.Wrap(&http.Client{Transport: transport})
+	get := func(path string) {
+		response, err := client.Get("https://agent.example.com/" + path)
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		response.Body.Close()
+	}
+
+	get("a")
+	get("b")
+	get("a")
+	get("c")
+	if cache.Len() != 2 || transport.requests != 3 {
+		t.Fatalf("unexpected cache state: len=%d requests=%d", cache.Len(), transport.requests)
+	}
+	get("a")
+	if transport.requests != 3 {
+		t.Fatalf("expected recently used entry to survive eviction, got %d requests", transport.requests)
+	}
+	get("b")
+	if transport.requests != 4 {
+		t.Fatalf("expected least recently used entry to be evicted, got %d requests", transport.requests)
+	}
+}
+
+func TestDNSCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	queries := 0
+	cache := NewDNSCache(DNSCacheOptions{
+		Lookup: func(ctx context.Context, hostname string) ([]string, error) {
+			queries++
+			return []string{hostname}, nil
+		},
+		MaxEntries: 2,
+	})
+	lookup := func(hostname string) {
+		if _, err := cache.Lookup(context.Background(), hostname); err != nil {
+			t.Fatalf("lookup failed: %v", err)
+		}
+	}
+
+	lookup("a.example.com")
+	lookup("b.example.com")
+	lookup("a.example.com")
+	lookup("c.example.com")
+	if cache.Len() != 2 || queries != 3 {
+		t.Fatalf("unexpected cache state: len=%d queries=%d", cache.Len(), queries)
+	}
+	lookup("a.example.com")
+	if queries != 3 {
+		t.Fatalf("expected recently used entry to survive eviction, got %d queries", queries)
+	}
+	lookup("b.example.com")
+	if queries != 4 {
+		t.Fatalf("expected least recently used entry to be evicted, got %d queries", queries)
+	}
+}
+
+func TestDNSServerTXTLookup(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("UDP listener unavailable: %v", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		buffer := make([]byte, 512)
+		for {
+			read, addr, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+			var query dnsmessage.Message
+			if err := query.Unpack(buffer[:read]); err != nil {
+				continue
+			}
+			question := query.Questions[0]
+			if strings.HasPrefix(question.Name.String(), "_uaid.spoofed.") {
+				question.Name = dnsmessage.MustNewName("_uaid.example.com.")
+			}
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: query.ID, Response: true},
+				Questions: []dnsmessage.Question{question},
+				Answers: []dnsmessage.Resource{
+					{
+						Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET, TTL: 300},
+						Body:   &dnsmessage.TXTResource{TXT: []string{"target=aid;", "id=abc"}},
+					},
+					{
+						Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET, TTL: 120},
+						Body:   &dnsmessage.TXTResource{TXT: []string{"other"}},
+					},
+				},
+			}
+			packed, _ := response.Pack()
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}()
+
+	lookup := NewDNSServerTXTLookup(conn.LocalAddr().String())
+	records, ttl, err := lookup(context.Background(), "_uaid.example.com")
+	if err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+	if len(records) != 2 || records[0] != "target=aid;id=abc" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+	if ttl != 120*time.Second {
+		t.Fatalf("expected smallest TTL, got %s", ttl)
+	}
+
+	if _, _, err := lookup(context.Background(), "_uaid.spoofed.example.com"); err == nil {
+		t.Fatal("expected an answer for another question to be rejected")
+	}
+}
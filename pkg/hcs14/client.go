@@ -8,6 +8,7 @@ type Client struct {
 	ansDNSResolver  *ANSDNSWebResolver
 	aidDNSResolver  *AIDDNSWebResolver
 	uaidDIDResolver *UAIDDidResolutionResolver
+	dnsLookup       DNSLookupFunc
 }
 
 type ClientOptions struct {
@@ -16,6 +17,21 @@ type ClientOptions struct {
 	UAID      UaidDNSWebResolverOptions
 	AID       AIDDNSWebResolverOptions
 	Registry  *ResolverRegistry
+	DIDHedera DIDHederaResolverOptions
+	DIDWeb    DIDWebResolverOptions
+	// DisableDefaultDIDResolvers skips registering the did:hedera, did:web,
+	// did:key and did:pkh resolvers.
+	DisableDefaultDIDResolvers bool
+	// StrictDIDResolution makes the built-in DID resolvers return lookup
+	// failures, such as unreachable hosts or malformed documents. By default
+	// they report them as a missing document, noted in the resolution trace,
+	// so UAID resolution falls back to the UAID itself.
+	StrictDIDResolution bool
+	// DNSCache, when set, serves every resolver's TXT lookups and takes the
+	// place of DNSLookup; configure the underlying lookup on the cache.
+	DNSCache *DNSCache
+	// HTTPCache, when set, caches agent card and did:web document fetches.
+	HTTPCache *HTTPCache
 }
 
 // NewClient creates a new Client.
@@ -24,6 +40,14 @@ func NewClient(options ClientOptions) *Client {
 	ansOptions := options.HTTP
 	aidOptions := options.AID
 
+	if options.DNSCache != nil {
+		options.DNSLookup = options.DNSCache.Lookup
+	}
+	if options.HTTPCache != nil {
+		ansOptions.HTTPClient = options.HTTPCache.Wrap(ansOptions.HTTPClient)
+		options.DIDWeb.HTTPClient = options.HTTPCache.Wrap(options.DIDWeb.HTTPClient)
+	}
+
 	if options.DNSLookup != nil {
 		uaidOptions.DNSLookup = options.DNSLookup
 		ansOptions.DNSLookup = options.DNSLookup
@@ -34,6 +58,11 @@ func NewClient(options ClientOptions) *Client {
 		uaidOptions.EnableFollowupResolution = true
 	}
 
+	dnsLookup := options.DNSLookup
+	if dnsLookup == nil {
+		dnsLookup = nodeDNSTXTLookup
+	}
+
 	registry := options.Registry
 	if registry == nil {
 		registry = NewResolverRegistry()
@@ -49,12 +78,27 @@ func NewClient(options ClientOptions) *Client {
 	registry.RegisterUAIDProfileResolver(aidResolver)
 	registry.RegisterUAIDProfileResolver(uaidDidResolver)
 
+	if !options.DisableDefaultDIDResolvers {
+		for _, resolver := range []DIDResolver{
+			NewDIDHederaResolver(options.DIDHedera),
+			NewDIDWebResolver(options.DIDWeb),
+			NewDIDKeyResolver(),
+			NewDIDPKHResolver(),
+		} {
+			if !options.StrictDIDResolution {
+				resolver = bestEffortDIDResolver{DIDResolver: resolver}
+			}
+			registry.RegisterDIDResolver(resolver)
+		}
+	}
+
 	return &Client{
 		registry:        registry,
 		uaidDNSResolver: uaidResolver,
 		ansDNSResolver:  ansResolver,
 		aidDNSResolver:  aidResolver,
 		uaidDIDResolver: uaidDidResolver,
+		dnsLookup:       dnsLookup,
 	}
 }
 
@@ -81,6 +125,18 @@ func (client *Client) Resolve(ctx context.Context, uaid string) (*UAIDResolution
 	}, nil
 }
 
+// ResolveWithTrace resolves a UAID like Resolve and also returns every step
+// taken: profiles tried and why they declined, DNS answers, HTTP fetches and
+// DID resolutions, with durations and cache hits.
+func (client *Client) ResolveWithTrace(
+	ctx context.Context,
+	uaid string,
+) (*UAIDResolutionResult, *ResolutionTrace, error) {
+	trace := NewResolutionTrace()
+	result, err := client.Resolve(WithResolutionTrace(ctx, trace), uaid)
+	return result, trace, err
+}
+
 // ResolveProfile resolves the requested identifier data.
 func (client *Client) ResolveProfile(
 	ctx context.Context,
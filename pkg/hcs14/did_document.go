@@ -0,0 +1,240 @@
+package hcs14
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	multicodecEd25519Pub   = 0xed
+	multicodecSecp256k1Pub = 0xe7
+
+	ed25519VerificationKeyType   = "Ed25519VerificationKey2020"
+	secp256k1VerificationKeyType = "EcdsaSecp256k1VerificationKey2019"
+)
+
+type didDocumentJSON struct {
+	ID                 string                  `json:"id"`
+	AlsoKnownAs        []string                `json:"alsoKnownAs"`
+	VerificationMethod []didVerificationMethod `json:"verificationMethod"`
+	Authentication     []json.RawMessage       `json:"authentication"`
+	AssertionMethod    []json.RawMessage       `json:"assertionMethod"`
+	Service            []didService            `json:"service"`
+}
+
+type didVerificationMethod struct {
+	ID                  string `json:"id"`
+	Type                string `json:"type"`
+	Controller          string `json:"controller"`
+	PublicKeyMultibase  string `json:"publicKeyMultibase"`
+	BlockchainAccountID string `json:"blockchainAccountId"`
+}
+
+type didService struct {
+	ID              string          `json:"id"`
+	Type            json.RawMessage `json:"type"`
+	ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+}
+
+// bestEffortDIDResolver turns lookup failures into a missing document so a
+// built-in resolver cannot abort UAID resolution that would otherwise fall
+// back to the UAID itself. Context cancellation is still reported. NewClient
+// wraps the built-in resolvers in it unless ClientOptions.StrictDIDResolution
+// is set.
+type bestEffortDIDResolver struct {
+	DIDResolver
+}
+
+func (resolver bestEffortDIDResolver) Resolve(ctx context.Context, did string) (*DIDDocument, error) {
+	document, err := resolver.DIDResolver.Resolve(ctx, did)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		noteTraceStep(ctx, err.Error())
+		return nil, nil
+	}
+	return document, nil
+}
+
+// parseDIDDocumentJSON decodes a W3C DID document. Relative method and
+// service IDs are expanded against the document ID and embedded
+// verification relationships are added to VerificationMethod.
+func parseDIDDocumentJSON(raw []byte) (*DIDDocument, error) {
+	var decoded didDocumentJSON
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("invalid DID document: %w", err)
+	}
+	id := strings.TrimSpace(decoded.ID)
+	if !strings.HasPrefix(id, "did:") {
+		return nil, fmt.Errorf("DID document id is missing or invalid")
+	}
+
+	document := &DIDDocument{
+		ID:                 id,
+		AlsoKnownAs:        append([]string{}, decoded.AlsoKnownAs...),
+		VerificationMethod: make([]DIDVerificationMethod, 0, len(decoded.VerificationMethod)),
+	}
+	for _, method := range decoded.VerificationMethod {
+		document.VerificationMethod = append(document.VerificationMethod, convertDIDVerificationMethod(id, method))
+	}
+
+	relationships := func(entries []json.RawMessage) ([]string, error) {
+		references := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			var reference string
+			if err := json.Unmarshal(entry, &reference); err == nil {
+				references = append(references, expandDIDURL(id, reference))
+				continue
+			}
+			var embedded didVerificationMethod
+			if err := json.Unmarshal(entry, &embedded); err != nil {
+				return nil, fmt.Errorf("invalid verification relationship: %w", err)
+			}
+			method := convertDIDVerificationMethod(id, embedded)
+			if !hasVerificationMethod(document.VerificationMethod, method.ID) {
+				document.VerificationMethod = append(document.VerificationMethod, method)
+			}
+			references = append(references, method.ID)
+		}
+		return references, nil
+	}
+
+	var err error
+	if document.Authentication, err = relationships(decoded.Authentication); err != nil {
+		return nil, err
+	}
+	if document.AssertionMethod, err = relationships(decoded.AssertionMethod); err != nil {
+		return nil, err
+	}
+
+	for _, service := range decoded.Service {
+		document.Service = append(document.Service, ServiceEndpoint{
+			ID:              expandDIDURL(id, service.ID),
+			Type:            firstJSONString(service.Type),
+			ServiceEndpoint: firstJSONString(service.ServiceEndpoint),
+			Source:          "did-document",
+		})
+	}
+	return document, nil
+}
+
+func convertDIDVerificationMethod(did string, method didVerificationMethod) DIDVerificationMethod {
+	controller := strings.TrimSpace(method.Controller)
+	if controller == "" {
+		controller = did
+	}
+	return DIDVerificationMethod{
+		ID:                  expandDIDURL(did, method.ID),
+		Type:                strings.TrimSpace(method.Type),
+		Controller:          controller,
+		PublicKeyMultibase:  strings.TrimSpace(method.PublicKeyMultibase),
+		BlockchainAccountID: strings.TrimSpace(method.BlockchainAccountID),
+	}
+}
+
+func expandDIDURL(did string, value string) string {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "#") {
+		return did + trimmed
+	}
+	return trimmed
+}
+
+// firstJSONString returns a string value, the first string of an array, or
+// an object's "uri"/"url" member; service types and endpoints allow all three.
+func firstJSONString(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return strings.TrimSpace(value)
+	}
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err == nil {
+		for _, item := range values {
+			if resolved := firstJSONString(item); resolved != "" {
+				return resolved
+			}
+		}
+		return ""
+	}
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err == nil {
+		for _, key := range []string{"uri", "url"} {
+			if resolved, ok := object[key].(string); ok {
+				return strings.TrimSpace(resolved)
+			}
+		}
+	}
+	return ""
+}
+
+func hasVerificationMethod(methods []DIDVerificationMethod, id string) bool {
+	for _, method := range methods {
+		if method.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeMulticodecKey returns the base58btc multibase form of a public key
+// prefixed with its multicodec varint.
+func encodeMulticodecKey(codec uint64, key []byte) string {
+	prefix := make([]byte, 0, 2)
+	for value := codec; ; {
+		current := byte(value & 0x7f)
+		value >>= 7
+		if value != 0 {
+			prefix = append(prefix, current|0x80)
+			continue
+		}
+		prefix = append(prefix, current)
+		break
+	}
+	return "z" + base58Encode(append(prefix, key...))
+}
+
+// decodeMulticodecKey reverses encodeMulticodecKey and validates the key
+// length for the supported codecs.
+func decodeMulticodecKey(multibase string) (uint64, []byte, error) {
+	decoded, err := decodeMultibaseB58btc(multibase)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	codec := uint64(0)
+	shift := uint(0)
+	index := 0
+	for ; index < len(decoded) && index < 9; index++ {
+		codec |= uint64(decoded[index]&0x7f) << shift
+		shift += 7
+		if decoded[index]&0x80 == 0 {
+			index++
+			break
+		}
+	}
+	key := decoded[index:]
+
+	switch codec {
+	case multicodecEd25519Pub:
+		if len(key) != 32 {
+			return 0, nil, fmt.Errorf("ed25519 public key must be 32 bytes, got %d", len(key))
+		}
+	case multicodecSecp256k1Pub:
+		if len(key) != 33 || (key[0] != 0x02 && key[0] != 0x03) {
+			return 0, nil, fmt.Errorf("secp256k1 public key must be 33 compressed bytes")
+		}
+	default:
+		return 0, nil, fmt.Errorf("unsupported multicodec key type 0x%x", codec)
+	}
+	return codec, key, nil
+}
+
+func verificationKeyType(codec uint64) string {
+	if codec == multicodecSecp256k1Pub {
+		return secp256k1VerificationKeyType
+	}
+	return ed25519VerificationKeyType
+}
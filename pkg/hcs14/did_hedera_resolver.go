@@ -0,0 +1,313 @@
+package hcs14
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+var (
+	hederaAccountIDPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	didHederaPattern       = regexp.MustCompile(`^did:hedera:(mainnet|testnet|previewnet|devnet):([^_:;?#]+)(?:_(\d+\.\d+\.\d+))?$`)
+)
+
+// DIDHederaResolver resolves did:hedera identifiers.
+//
+// Account identifiers (did:hedera:testnet:0.0.123) resolve to the account's
+// current key from the mirror node. Key identifiers with a DID topic
+// (did:hedera:testnet:z6Mk..._0.0.456) are rebuilt by replaying the topic's
+// DID events; events not signed by the current DID owner key are ignored.
+type DIDHederaResolver struct {
+	mirrorAPIKey  string
+	mutex         sync.Mutex
+	mirrorClients map[string]*mirror.Client
+}
+
+type DIDHederaResolverOptions struct {
+	// MirrorClients overrides the mirror node client per network name.
+	// Mainnet and testnet clients are created on demand when absent.
+	MirrorClients map[string]*mirror.Client
+	MirrorAPIKey  string
+}
+
+type hederaDIDTopicMessage struct {
+	Message   json.RawMessage `json:"message"`
+	Signature string          `json:"signature"`
+}
+
+type hederaDIDTopicEnvelope struct {
+	Timestamp string `json:"timestamp"`
+	Operation string `json:"operation"`
+	DID       string `json:"did"`
+	Event     string `json:"event"`
+}
+
+type hederaDIDEventMethod struct {
+	didVerificationMethod
+	RelationshipType string `json:"relationshipType"`
+}
+
+type hederaDIDEvent struct {
+	DIDOwner                 *hederaDIDEventMethod `json:"DIDOwner"`
+	VerificationMethod       *hederaDIDEventMethod `json:"VerificationMethod"`
+	VerificationRelationship *hederaDIDEventMethod `json:"VerificationRelationship"`
+	Service                  *didService           `json:"Service"`
+}
+
+// NewDIDHederaResolver creates a new DIDHederaResolver.
+func NewDIDHederaResolver(options DIDHederaResolverOptions) *DIDHederaResolver {
+	clients := map[string]*mirror.Client{}
+	for network, client := range options.MirrorClients {
+		if client != nil {
+			clients[strings.ToLower(strings.TrimSpace(network))] = client
+		}
+	}
+	return &DIDHederaResolver{
+		mirrorAPIKey:  strings.TrimSpace(options.MirrorAPIKey),
+		mirrorClients: clients,
+	}
+}
+
+// Supports reports whether the resolver supports the provided input.
+func (resolver *DIDHederaResolver) Supports(did string) bool {
+	return strings.HasPrefix(strings.TrimSpace(did), "did:hedera:")
+}
+
+// Resolve resolves the requested identifier data.
+func (resolver *DIDHederaResolver) Resolve(ctx context.Context, did string) (*DIDDocument, error) {
+	trimmed, _ := sanitizeDidSpecificID(strings.TrimSpace(did))
+	matches := didHederaPattern.FindStringSubmatch(trimmed)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid did:hedera identifier: %s", did)
+	}
+	network, identifier, topicID := matches[1], matches[2], matches[3]
+
+	mirrorClient, err := resolver.mirrorClient(network)
+	if err != nil {
+		return nil, err
+	}
+	if topicID != "" {
+		return resolver.resolveTopic(ctx, mirrorClient, trimmed, identifier, topicID)
+	}
+	if hederaAccountIDPattern.MatchString(identifier) {
+		return resolver.resolveAccount(ctx, mirrorClient, trimmed, network, identifier)
+	}
+	return nil, fmt.Errorf("did:hedera key identifiers require a DID topic: %s", did)
+}
+
+func (resolver *DIDHederaResolver) mirrorClient(network string) (*mirror.Client, error) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	if client, ok := resolver.mirrorClients[network]; ok {
+		return client, nil
+	}
+	client, err := mirror.NewClient(mirror.Config{Network: network, APIKey: resolver.mirrorAPIKey})
+	if err != nil {
+		return nil, fmt.Errorf("no mirror node configured for %s: %w", network, err)
+	}
+	resolver.mirrorClients[network] = client
+	return client, nil
+}
+
+func (resolver *DIDHederaResolver) resolveAccount(
+	ctx context.Context,
+	mirrorClient *mirror.Client,
+	did string,
+	network string,
+	accountID string,
+) (*DIDDocument, error) {
+	account, err := mirrorClient.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	methodID := did + "#did-root-key"
+	method := DIDVerificationMethod{
+		ID:                  methodID,
+		Type:                blockchainVerificationMethodType,
+		Controller:          did,
+		BlockchainAccountID: "hedera:" + network + ":" + accountID,
+	}
+	keyType, _ := account.Key["_type"].(string)
+	keyHex, _ := account.Key["key"].(string)
+	if keyBytes, decodeErr := hex.DecodeString(keyHex); decodeErr == nil {
+		switch {
+		case keyType == "ED25519" && len(keyBytes) == ed25519.PublicKeySize:
+			method.Type = ed25519VerificationKeyType
+			method.PublicKeyMultibase = encodeMulticodecKey(multicodecEd25519Pub, keyBytes)
+		case keyType == "ECDSA_SECP256K1" && len(keyBytes) == 33:
+			method.Type = secp256k1VerificationKeyType
+			method.PublicKeyMultibase = encodeMulticodecKey(multicodecSecp256k1Pub, keyBytes)
+		}
+	}
+
+	return &DIDDocument{
+		ID:                 did,
+		VerificationMethod: []DIDVerificationMethod{method},
+		Authentication:     []string{methodID},
+		AssertionMethod:    []string{methodID},
+	}, nil
+}
+
+func (resolver *DIDHederaResolver) resolveTopic(
+	ctx context.Context,
+	mirrorClient *mirror.Client,
+	did string,
+	identifier string,
+	topicID string,
+) (*DIDDocument, error) {
+	ownerKey, err := decodeHederaDIDRootKey(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("invalid did:hedera root key: %w", err)
+	}
+	messages, err := mirrorClient.GetTopicMessages(ctx, topicID, mirror.MessageQueryOptions{Order: "asc"})
+	if err != nil {
+		return nil, err
+	}
+	return replayHederaDIDTopic(did, ownerKey, messages), nil
+}
+
+// replayHederaDIDTopic applies create, update, revoke and delete events in
+// consensus order. It returns nil when the DID was never created or has been
+// deactivated.
+func replayHederaDIDTopic(did string, ownerKey ed25519.PublicKey, messages []mirror.TopicMessage) *DIDDocument {
+	var (
+		owner         *DIDVerificationMethod
+		methods       []DIDVerificationMethod
+		relationships = map[string][]string{}
+		services      []ServiceEndpoint
+	)
+
+	for _, item := range messages {
+		var envelope hederaDIDTopicMessage
+		if err := mirror.DecodeMessageJSON(item, &envelope); err != nil || len(envelope.Message) == 0 {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(envelope.Signature)
+		if err != nil || !ed25519.Verify(ownerKey, envelope.Message, signature) {
+			continue
+		}
+		var message hederaDIDTopicEnvelope
+		if err := json.Unmarshal(envelope.Message, &message); err != nil || message.DID != did {
+			continue
+		}
+		eventJSON, err := base64.StdEncoding.DecodeString(message.Event)
+		if err != nil {
+			continue
+		}
+		var event hederaDIDEvent
+		if err := json.Unmarshal(eventJSON, &event); err != nil {
+			continue
+		}
+
+		switch {
+		case event.DIDOwner != nil:
+			if message.Operation == "delete" {
+				return nil
+			}
+			if message.Operation != "create" && message.Operation != "update" {
+				continue
+			}
+			method := convertDIDVerificationMethod(did, event.DIDOwner.didVerificationMethod)
+			if codec, key, decodeErr := decodeMulticodecKey(method.PublicKeyMultibase); decodeErr == nil && codec == multicodecEd25519Pub {
+				ownerKey = ed25519.PublicKey(key)
+			}
+			owner = &method
+		case event.VerificationMethod != nil:
+			method := convertDIDVerificationMethod(did, event.VerificationMethod.didVerificationMethod)
+			methods = applyHederaDIDMethodEvent(methods, method, message.Operation)
+		case event.VerificationRelationship != nil:
+			method := convertDIDVerificationMethod(did, event.VerificationRelationship.didVerificationMethod)
+			methods = applyHederaDIDMethodEvent(methods, method, message.Operation)
+			relationshipType := event.VerificationRelationship.RelationshipType
+			references := removeString(relationships[relationshipType], method.ID)
+			if message.Operation != "revoke" {
+				references = append(references, method.ID)
+			}
+			relationships[relationshipType] = references
+		case event.Service != nil:
+			service := ServiceEndpoint{
+				ID:              expandDIDURL(did, event.Service.ID),
+				Type:            firstJSONString(event.Service.Type),
+				ServiceEndpoint: firstJSONString(event.Service.ServiceEndpoint),
+				Source:          "did-document",
+			}
+			filtered := make([]ServiceEndpoint, 0, len(services))
+			for _, existing := range services {
+				if existing.ID != service.ID {
+					filtered = append(filtered, existing)
+				}
+			}
+			if message.Operation != "revoke" {
+				filtered = append(filtered, service)
+			}
+			services = filtered
+		}
+	}
+
+	if owner == nil {
+		return nil
+	}
+	document := &DIDDocument{
+		ID:                 did,
+		VerificationMethod: append([]DIDVerificationMethod{*owner}, methods...),
+		Authentication:     append([]string{owner.ID}, relationships["authentication"]...),
+		AssertionMethod:    append([]string{owner.ID}, relationships["assertionMethod"]...),
+		Service:            services,
+	}
+	return document
+}
+
+func applyHederaDIDMethodEvent(
+	methods []DIDVerificationMethod,
+	method DIDVerificationMethod,
+	operation string,
+) []DIDVerificationMethod {
+	filtered := make([]DIDVerificationMethod, 0, len(methods)+1)
+	for _, existing := range methods {
+		if existing.ID != method.ID {
+			filtered = append(filtered, existing)
+		}
+	}
+	if operation == "create" || operation == "update" {
+		filtered = append(filtered, method)
+	}
+	return filtered
+}
+
+// decodeHederaDIDRootKey accepts both the multibase (z6Mk...) and the legacy
+// raw base58 encodings of the Ed25519 root key.
+func decodeHederaDIDRootKey(identifier string) (ed25519.PublicKey, error) {
+	if strings.HasPrefix(identifier, "z") {
+		if codec, key, err := decodeMulticodecKey(identifier); err == nil && codec == multicodecEd25519Pub {
+			return ed25519.PublicKey(key), nil
+		}
+	}
+	decoded, err := base58Decode(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("root key must be a 32-byte Ed25519 key")
+	}
+	return ed25519.PublicKey(decoded), nil
+}
+
+func removeString(values []string, target string) []string {
+	filtered := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != target {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,48 @@
+package hcs14
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DIDKeyResolver resolves did:key identifiers for Ed25519 and secp256k1 keys
+// without any network access.
+type DIDKeyResolver struct{}
+
+// NewDIDKeyResolver creates a new DIDKeyResolver.
+func NewDIDKeyResolver() *DIDKeyResolver {
+	return &DIDKeyResolver{}
+}
+
+// Supports reports whether the resolver supports the provided input.
+func (resolver *DIDKeyResolver) Supports(did string) bool {
+	return strings.HasPrefix(strings.TrimSpace(did), "did:key:")
+}
+
+// Resolve resolves the requested identifier data.
+func (resolver *DIDKeyResolver) Resolve(_ context.Context, did string) (*DIDDocument, error) {
+	trimmed := strings.TrimSpace(did)
+	if !resolver.Supports(trimmed) {
+		return nil, fmt.Errorf("not a did:key identifier: %s", did)
+	}
+	multibase, _ := sanitizeDidSpecificID(strings.TrimPrefix(trimmed, "did:key:"))
+	codec, _, err := decodeMulticodecKey(multibase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid did:key %s: %w", did, err)
+	}
+
+	id := "did:key:" + multibase
+	methodID := id + "#" + multibase
+	return &DIDDocument{
+		ID: id,
+		VerificationMethod: []DIDVerificationMethod{{
+			ID:                 methodID,
+			Type:               verificationKeyType(codec),
+			Controller:         id,
+			PublicKeyMultibase: multibase,
+		}},
+		Authentication:  []string{methodID},
+		AssertionMethod: []string{methodID},
+	}, nil
+}
@@ -0,0 +1,56 @@
+package hcs14
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const blockchainVerificationMethodType = "BlockchainVerificationMethod2021"
+
+// DIDPKHResolver resolves did:pkh identifiers whose CAIP-10 account is an
+// eip155 or hedera account.
+type DIDPKHResolver struct{}
+
+// NewDIDPKHResolver creates a new DIDPKHResolver.
+func NewDIDPKHResolver() *DIDPKHResolver {
+	return &DIDPKHResolver{}
+}
+
+// Supports reports whether the resolver supports the provided input.
+func (resolver *DIDPKHResolver) Supports(did string) bool {
+	return strings.HasPrefix(strings.TrimSpace(did), "did:pkh:")
+}
+
+// Resolve resolves the requested identifier data.
+func (resolver *DIDPKHResolver) Resolve(_ context.Context, did string) (*DIDDocument, error) {
+	trimmed := strings.TrimSpace(did)
+	if !resolver.Supports(trimmed) {
+		return nil, fmt.Errorf("not a did:pkh identifier: %s", did)
+	}
+	accountID, _ := sanitizeDidSpecificID(strings.TrimPrefix(trimmed, "did:pkh:"))
+
+	methodType := ""
+	switch {
+	case isEIP155CAIP10(accountID):
+		methodType = "EcdsaSecp256k1RecoveryMethod2020"
+	case isHederaCAIP10(accountID):
+		methodType = blockchainVerificationMethodType
+	default:
+		return nil, fmt.Errorf("unsupported did:pkh account %s", accountID)
+	}
+
+	id := "did:pkh:" + accountID
+	methodID := id + "#blockchainAccountId"
+	return &DIDDocument{
+		ID: id,
+		VerificationMethod: []DIDVerificationMethod{{
+			ID:                  methodID,
+			Type:                methodType,
+			Controller:          id,
+			BlockchainAccountID: accountID,
+		}},
+		Authentication:  []string{methodID},
+		AssertionMethod: []string{methodID},
+	}, nil
+}
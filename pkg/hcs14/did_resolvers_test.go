@@ -0,0 +1,285 @@
+package hcs14
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+func TestDIDKeyResolver(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	multibase := encodeMulticodecKey(multicodecEd25519Pub, publicKey)
+	if !strings.HasPrefix(multibase, "z6Mk") {
+		t.Fatalf("unexpected Ed25519 multibase prefix: %s", multibase)
+	}
+
+	resolver := NewDIDKeyResolver()
+	document, err := resolver.Resolve(context.Background(), "did:key:"+multibase)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	method := document.VerificationMethod[0]
+	if method.Type != ed25519VerificationKeyType || method.PublicKeyMultibase != multibase {
+		t.Fatalf("unexpected verification method: %+v", method)
+	}
+	if document.Authentication[0] != "did:key:"+multibase+"#"+multibase {
+		t.Fatalf("unexpected authentication: %v", document.Authentication)
+	}
+
+	secp256k1Key := append([]byte{0x02}, make([]byte, 32)...)
+	document, err = resolver.Resolve(context.Background(), "did:key:"+encodeMulticodecKey(multicodecSecp256k1Pub, secp256k1Key))
+	if err != nil {
+		t.Fatalf("Resolve secp256k1 failed: %v", err)
+	}
+	if document.VerificationMethod[0].Type != secp256k1VerificationKeyType {
+		t.Fatalf("unexpected secp256k1 type: %s", document.VerificationMethod[0].Type)
+	}
+
+	if _, err := resolver.Resolve(context.Background(), "did:key:z"+base58Encode([]byte{0xed, 0x01, 0x01})); err == nil {
+		t.Fatal("expected error for short key")
+	}
+}
+
+func TestDIDPKHResolver(t *testing.T) {
+	resolver := NewDIDPKHResolver()
+	document, err := resolver.Resolve(context.Background(), "did:pkh:eip155:1:0x1111111111111111111111111111111111111111")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if document.VerificationMethod[0].BlockchainAccountID != "eip155:1:0x1111111111111111111111111111111111111111" {
+		t.Fatalf("unexpected account: %+v", document.VerificationMethod[0])
+	}
+
+	document, err = resolver.Resolve(context.Background(), "did:pkh:hedera:testnet:0.0.1234")
+	if err != nil {
+		t.Fatalf("Resolve hedera failed: %v", err)
+	}
+	if document.VerificationMethod[0].Type != blockchainVerificationMethodType {
+		t.Fatalf("unexpected type: %s", document.VerificationMethod[0].Type)
+	}
+
+	if _, err := resolver.Resolve(context.Background(), "did:pkh:solana:abc:def"); err == nil {
+		t.Fatal("expected unsupported namespace error")
+	}
+}
+
+func TestDIDWebResolverDocumentURL(t *testing.T) {
+	resolver := NewDIDWebResolver(DIDWebResolverOptions{})
+	cases := map[string]string{
+		"did:web:example.com":                "https://example.com/.well-known/did.json",
+		"did:web:example.com:user:alice":     "https://example.com/user/alice/did.json",
+		"did:web:localhost%3A8443:agents:a1": "https://localhost:8443/agents/a1/did.json",
+	}
+	for did, expected := range cases {
+		documentURL, err := resolver.DocumentURL(did)
+		if err != nil {
+			t.Fatalf("DocumentURL(%s) failed: %v", did, err)
+		}
+		if documentURL != expected {
+			t.Fatalf("DocumentURL(%s) = %s, want %s", did, documentURL, expected)
+		}
+	}
+	if _, err := resolver.DocumentURL("did:web:example.com:.."); err == nil {
+		t.Fatal("expected error for path traversal")
+	}
+}
+
+func TestDIDWebResolverResolve(t *testing.T) {
+	var did string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/agents/support/did.json" {
+			http.NotFound(writer, request)
+			return
+		}
+		_ = json.NewEncoder(writer).Encode(map[string]any{
+			"id": did,
+			"verificationMethod": []any{map[string]any{
+				"id": "#key-1", "type": ed25519VerificationKeyType, "publicKeyMultibase": "z6Mkexample",
+			}},
+			"authentication": []any{"#key-1", map[string]any{"id": "#key-2", "type": ed25519VerificationKeyType}},
+			"service": []any{map[string]any{
+				"id": "#a2a", "type": "A2A", "serviceEndpoint": []any{"https://agent.example.com/a2a"},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	did = "did:web:" + strings.ReplaceAll(host, ":", "%3A") + ":agents:support"
+	resolver := NewDIDWebResolver(DIDWebResolverOptions{HTTPClient: server.Client(), AllowHTTP: true})
+
+	document, err := resolver.Resolve(context.Background(), did)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(document.VerificationMethod) != 2 || document.VerificationMethod[0].ID != did+"#key-1" {
+		t.Fatalf("unexpected verification methods: %+v", document.VerificationMethod)
+	}
+	if len(document.Authentication) != 2 || document.Authentication[1] != did+"#key-2" {
+		t.Fatalf("unexpected authentication: %v", document.Authentication)
+	}
+	if document.Service[0].ServiceEndpoint != "https://agent.example.com/a2a" {
+		t.Fatalf("unexpected service: %+v", document.Service)
+	}
+
+	missing, err := resolver.Resolve(context.Background(), "did:web:"+strings.ReplaceAll(host, ":", "%3A"))
+	if !errors.Is(err, ErrDIDDocumentNotFound) || missing != nil {
+		t.Fatalf("expected not-found error for 404, got %+v %v", missing, err)
+	}
+}
+
+func TestDIDHederaResolverAccount(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/api/v1/accounts/0.0.1234" {
+			http.NotFound(writer, request)
+			return
+		}
+		_ = json.NewEncoder(writer).Encode(map[string]any{
+			"account": "0.0.1234",
+			"key":     map[string]any{"_type": "ED25519", "key": hex.EncodeToString(publicKey)},
+		})
+	}))
+	defer server.Close()
+
+	resolver := newTestDIDHederaResolver(t, server.URL)
+	document, err := resolver.Resolve(context.Background(), "did:hedera:testnet:0.0.1234")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	method := document.VerificationMethod[0]
+	if method.PublicKeyMultibase != encodeMulticodecKey(multicodecEd25519Pub, publicKey) {
+		t.Fatalf("unexpected key: %+v", method)
+	}
+	if method.BlockchainAccountID != "hedera:testnet:0.0.1234" {
+		t.Fatalf("unexpected account: %s", method.BlockchainAccountID)
+	}
+}
+
+func TestDIDHederaResolverTopic(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	_, forgerKey, _ := ed25519.GenerateKey(nil)
+	rootMultibase := encodeMulticodecKey(multicodecEd25519Pub, publicKey)
+	did := "did:hedera:testnet:" + rootMultibase + "_0.0.900"
+
+	message := func(signer ed25519.PrivateKey, operation string, event map[string]any) map[string]any {
+		eventJSON, _ := json.Marshal(event)
+		envelope, _ := json.Marshal(map[string]any{
+			"timestamp": "2026-01-01T00:00:00Z",
+			"operation": operation,
+			"did":       did,
+			"event":     base64.StdEncoding.EncodeToString(eventJSON),
+		})
+		payload, _ := json.Marshal(map[string]any{
+			"message":   json.RawMessage(envelope),
+			"signature": base64.StdEncoding.EncodeToString(ed25519.Sign(signer, envelope)),
+		})
+		return map[string]any{"message": base64.StdEncoding.EncodeToString(payload)}
+	}
+	service := func(id string, endpoint string) map[string]any {
+		return map[string]any{"Service": map[string]any{"id": did + id, "type": "LinkedDomains", "serviceEndpoint": endpoint}}
+	}
+	messages := []map[string]any{
+		message(privateKey, "create", map[string]any{"DIDOwner": map[string]any{
+			"id": did + "#did-root-key", "type": ed25519VerificationKeyType, "controller": did, "publicKeyMultibase": rootMultibase,
+		}}),
+		message(privateKey, "create", service("#service-1", "https://one.example.com")),
+		message(privateKey, "create", service("#service-2", "https://two.example.com")),
+		message(privateKey, "revoke", service("#service-1", "https://one.example.com")),
+		message(forgerKey, "create", service("#forged", "https://evil.example.com")),
+		message(privateKey, "create", map[string]any{"VerificationRelationship": map[string]any{
+			"id": did + "#key-1", "type": ed25519VerificationKeyType, "controller": did,
+			"publicKeyMultibase": rootMultibase, "relationshipType": "authentication",
+		}}),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/api/v1/topics/0.0.900/messages" {
+			http.NotFound(writer, request)
+			return
+		}
+		_ = json.NewEncoder(writer).Encode(map[string]any{"messages": messages})
+	}))
+	defer server.Close()
+
+	resolver := newTestDIDHederaResolver(t, server.URL)
+	document, err := resolver.Resolve(context.Background(), did)
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if document == nil || document.ID != did {
+		t.Fatalf("unexpected document: %+v", document)
+	}
+	if len(document.Service) != 1 || document.Service[0].ID != did+"#service-2" {
+		t.Fatalf("unexpected services: %+v", document.Service)
+	}
+	if len(document.VerificationMethod) != 2 || len(document.Authentication) != 2 {
+		t.Fatalf("unexpected methods: %+v %v", document.VerificationMethod, document.Authentication)
+	}
+
+	messages = append(messages, message(privateKey, "delete", map[string]any{"DIDOwner": map[string]any{"id": did + "#did-root-key"}}))
+	document, err = resolver.Resolve(context.Background(), did)
+	if err != nil || document != nil {
+		t.Fatalf("expected deactivated DID, got %+v %v", document, err)
+	}
+}
+
+func TestClientRegistersDefaultDIDResolvers(t *testing.T) {
+	publicKey, _, _ := ed25519.GenerateKey(nil)
+	did := "did:key:" + encodeMulticodecKey(multicodecEd25519Pub, publicKey)
+
+	client := NewClient(ClientOptions{})
+	document, err := client.Registry().ResolveDID(context.Background(), did)
+	if err != nil || document == nil || document.ID != did {
+		t.Fatalf("expected did:key document, got %+v %v", document, err)
+	}
+
+	trace := NewResolutionTrace()
+	document, err = client.Registry().ResolveDID(WithResolutionTrace(context.Background(), trace), "did:key:zinvalid")
+	if err != nil || document != nil {
+		t.Fatalf("expected built-in failures to yield no document, got %+v %v", document, err)
+	}
+	if steps := trace.Steps(); len(steps) != 1 || steps[0].Reason == "" {
+		t.Fatalf("expected the failure to be noted in the trace, got %+v", steps)
+	}
+
+	client = NewClient(ClientOptions{StrictDIDResolution: true})
+	document, err = client.Registry().ResolveDID(context.Background(), "did:key:zinvalid")
+	if err == nil || document != nil {
+		t.Fatalf("expected strict failures to be returned, got %+v %v", document, err)
+	}
+
+	client = NewClient(ClientOptions{DisableDefaultDIDResolvers: true})
+	if document, _ := client.Registry().ResolveDID(context.Background(), did); document != nil {
+		t.Fatal("expected no resolvers when defaults are disabled")
+	}
+}
+
+func newTestDIDHederaResolver(t *testing.T, mirrorURL string) *DIDHederaResolver {
+	t.Helper()
+	mirrorClient, err := mirror.NewClient(mirror.Config{Network: "testnet", BaseURL: mirrorURL})
+	if err != nil {
+		t.Fatalf("failed to create mirror client: %v", err)
+	}
+	return NewDIDHederaResolver(DIDHederaResolverOptions{
+		MirrorClients: map[string]*mirror.Client{"testnet": mirrorClient},
+	})
+}
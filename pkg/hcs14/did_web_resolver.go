@@ -0,0 +1,120 @@
+package hcs14
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const maxDIDDocumentBytes = 1 << 20
+
+// DIDWebResolver resolves did:web identifiers by fetching their did.json.
+type DIDWebResolver struct {
+	httpClient *http.Client
+	scheme     string
+}
+
+type DIDWebResolverOptions struct {
+	HTTPClient *http.Client
+	// AllowHTTP fetches documents over plain HTTP. It exists for local testing.
+	AllowHTTP bool
+}
+
+// NewDIDWebResolver creates a new DIDWebResolver.
+func NewDIDWebResolver(options DIDWebResolverOptions) *DIDWebResolver {
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	scheme := "https"
+	if options.AllowHTTP {
+		scheme = "http"
+	}
+	return &DIDWebResolver{
+		httpClient: withTraceTransport(httpClient),
+		scheme:     scheme,
+	}
+}
+
+// Supports reports whether the resolver supports the provided input.
+func (resolver *DIDWebResolver) Supports(did string) bool {
+	return strings.HasPrefix(strings.TrimSpace(did), "did:web:")
+}
+
+// Resolve fetches and validates the did.json document. A 404 response is
+// reported as ErrDIDDocumentNotFound.
+func (resolver *DIDWebResolver) Resolve(ctx context.Context, did string) (*DIDDocument, error) {
+	trimmed := strings.TrimSpace(did)
+	documentURL, err := resolver.DocumentURL(trimmed)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("accept", "application/did+json, application/json")
+
+	response, err := resolver.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", documentURL, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrDIDDocumentNotFound, documentURL)
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("request for %s failed with status %d", documentURL, response.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxDIDDocumentBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := parseDIDDocumentJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	expectedID, _ := sanitizeDidSpecificID(trimmed)
+	if document.ID != expectedID {
+		return nil, fmt.Errorf("DID document id %s does not match %s", document.ID, expectedID)
+	}
+	return document, nil
+}
+
+// DocumentURL returns the did.json URL for a did:web identifier:
+// did:web:example.com maps to https://example.com/.well-known/did.json and
+// did:web:example.com:user:alice to https://example.com/user/alice/did.json.
+func (resolver *DIDWebResolver) DocumentURL(did string) (string, error) {
+	trimmed := strings.TrimSpace(did)
+	if !resolver.Supports(trimmed) {
+		return "", fmt.Errorf("not a did:web identifier: %s", did)
+	}
+	specificID, _ := sanitizeDidSpecificID(strings.TrimPrefix(trimmed, "did:web:"))
+	segments := strings.Split(specificID, ":")
+
+	host, err := url.PathUnescape(segments[0])
+	if err != nil || strings.TrimSpace(host) == "" || strings.ContainsAny(host, "/?#@") {
+		return "", fmt.Errorf("invalid did:web host in %s", did)
+	}
+
+	path := "/.well-known"
+	if len(segments) > 1 {
+		parts := make([]string, 0, len(segments)-1)
+		for _, segment := range segments[1:] {
+			decoded, err := url.PathUnescape(segment)
+			if err != nil || decoded == "" || decoded == "." || decoded == ".." || strings.Contains(decoded, "/") {
+				return "", fmt.Errorf("invalid did:web path in %s", did)
+			}
+			parts = append(parts, url.PathEscape(decoded))
+		}
+		path = "/" + strings.Join(parts, "/")
+	}
+	return resolver.scheme + "://" + host + path + "/did.json", nil
+}
@@ -2,10 +2,17 @@ package hcs14
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"io"
 	"net"
 	"regexp"
 	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
 )
 
 var fqdnLabelPattern = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?$`)
@@ -79,3 +86,120 @@ func nodeDNSTXTLookup(ctx context.Context, hostname string) ([]string, error) {
 	}
 	return records, nil
 }
+
+// NewDNSServerTXTLookup returns a TTL-aware TXT lookup that queries server
+// (host:port) directly over UDP, retrying over TCP when the answer is
+// truncated. Responses whose ID or question does not match the query are
+// rejected. The reported TTL is the smallest TTL among the TXT answers. Pass
+// it as DNSCacheOptions.TTLLookup to cache answers for their DNS TTL.
+func NewDNSServerTXTLookup(server string) DNSTTLLookupFunc {
+	return func(ctx context.Context, hostname string) ([]string, time.Duration, error) {
+		name, err := dnsmessage.NewName(strings.TrimSuffix(strings.TrimSpace(hostname), ".") + ".")
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid DNS name %q: %w", hostname, err)
+		}
+		var id [2]byte
+		if _, err := rand.Read(id[:]); err != nil {
+			return nil, 0, fmt.Errorf("failed to generate DNS query ID: %w", err)
+		}
+		query := dnsmessage.Message{
+			Header:    dnsmessage.Header{ID: binary.BigEndian.Uint16(id[:]), RecursionDesired: true},
+			Questions: []dnsmessage.Question{{Name: name, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET}},
+		}
+		packed, err := query.Pack()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		response, err := exchangeDNS(ctx, "udp", server, packed)
+		if err == nil && response.Truncated {
+			response, err = exchangeDNS(ctx, "tcp", server, packed)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if response.ID != query.ID {
+			return nil, 0, fmt.Errorf("DNS response ID mismatch for %s", hostname)
+		}
+		if !response.Response || !sameDNSQuestion(response.Questions, query.Questions[0]) {
+			return nil, 0, fmt.Errorf("DNS response question mismatch for %s", hostname)
+		}
+		switch response.RCode {
+		case dnsmessage.RCodeSuccess:
+		case dnsmessage.RCodeNameError:
+			return []string{}, 0, nil
+		default:
+			return nil, 0, fmt.Errorf("DNS query for %s failed: %s", hostname, response.RCode)
+		}
+
+		records := make([]string, 0, len(response.Answers))
+		var ttl time.Duration
+		for _, answer := range response.Answers {
+			txt, ok := answer.Body.(*dnsmessage.TXTResource)
+			if !ok {
+				continue
+			}
+			records = append(records, strings.Join(txt.TXT, ""))
+			answerTTL := time.Duration(answer.Header.TTL) * time.Second
+			if len(records) == 1 || answerTTL < ttl {
+				ttl = answerTTL
+			}
+		}
+		return records, ttl, nil
+	}
+}
+
+// sameDNSQuestion reports whether questions is exactly question, comparing
+// names case-insensitively.
+func sameDNSQuestion(questions []dnsmessage.Question, question dnsmessage.Question) bool {
+	return len(questions) == 1 &&
+		strings.EqualFold(questions[0].Name.String(), question.Name.String()) &&
+		questions[0].Type == question.Type &&
+		questions[0].Class == question.Class
+}
+
+func exchangeDNS(ctx context.Context, network string, server string, query []byte) (*dnsmessage.Message, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	}
+
+	var raw []byte
+	if network == "tcp" {
+		framed := append([]byte{byte(len(query) >> 8), byte(len(query))}, query...)
+		if _, err := conn.Write(framed); err != nil {
+			return nil, err
+		}
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return nil, err
+		}
+		raw = make([]byte, int(length[0])<<8|int(length[1]))
+		if _, err := io.ReadFull(conn, raw); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		buffer := make([]byte, 65535)
+		read, err := conn.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		raw = buffer[:read]
+	}
+
+	var message dnsmessage.Message
+	if err := message.Unpack(raw); err != nil {
+		return nil, fmt.Errorf("invalid DNS response: %w", err)
+	}
+	return &message, nil
+}
@@ -0,0 +1,318 @@
+package hcs14
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// MaxTXTStringLength is the longest character-string a TXT record holds.
+	MaxTXTStringLength = 255
+
+	defaultDNSRecordTTL = 3600
+)
+
+var uaidDNSFieldOrder = []string{"target", "id", "uid", "registry", "proto", "nativeId", "domain", "src", "version", "did"}
+
+// DNSRecord is a TXT record ready to publish. Value is the logical record;
+// Chunks splits it into character-strings of at most MaxTXTStringLength
+// bytes, which resolvers concatenate back into Value.
+type DNSRecord struct {
+	Name   string
+	Type   string
+	TTL    int
+	Value  string
+	Chunks []string
+}
+
+type UAIDDNSRecordOptions struct {
+	// DID is published as the did field of a uaid:did record.
+	DID string
+	TTL int
+}
+
+type AIDDNSRecordOptions struct {
+	// Protocol defaults to the UAID's proto parameter.
+	Protocol string
+	Endpoint string
+	// Version defaults to aid1.
+	Version   string
+	PublicKey string
+	KeyID     string
+	TTL       int
+}
+
+type DNSFieldMismatch struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+// DomainBindingReport compares the _uaid TXT records on a domain with the
+// record BuildUAIDDNSRecord produces for the UAID. Mismatches describe the
+// closest published record when none matches.
+type DomainBindingReport struct {
+	Domain         string
+	DNSName        string
+	UAID           string
+	ExpectedRecord string
+	Records        []string
+	Matched        bool
+	Mismatches     []DNSFieldMismatch
+	Reason         string
+}
+
+// BuildUAIDDNSRecord builds the _uaid TXT record that binds the UAID to the
+// domain in its nativeId parameter.
+func BuildUAIDDNSRecord(uaid string, options UAIDDNSRecordOptions) (DNSRecord, error) {
+	parsed, err := ParseUAID(uaid)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+	params := canonicalizeNativeDomainParams(parsed.Params)
+
+	nativeID := params["nativeId"]
+	if !isFQDN(nativeID) {
+		return DNSRecord{}, fmt.Errorf("UAID nativeId must be a fully qualified domain name")
+	}
+	for _, key := range []string{"uid", "proto"} {
+		if params[key] == "" {
+			return DNSRecord{}, fmt.Errorf("UAID %s parameter is required for a _uaid record", key)
+		}
+	}
+
+	fields := map[string]string{
+		"target": parsed.Target,
+		"id":     parsed.ID,
+	}
+	for key, value := range params {
+		if value == "" {
+			continue
+		}
+		if !isUAIDDNSField(key) || key == "target" || key == "id" || key == "did" {
+			return DNSRecord{}, fmt.Errorf("UAID parameter %q cannot be represented in a _uaid record", key)
+		}
+		fields[key] = value
+	}
+	if did := strings.TrimSpace(options.DID); did != "" {
+		if parsed.Target != "did" || !strings.HasPrefix(did, "did:") {
+			return DNSRecord{}, fmt.Errorf("did field requires a uaid:did identifier and a did: value")
+		}
+		fields["did"] = did
+	}
+
+	value, err := joinTXTFields(uaidDNSFieldOrder, fields)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+	return newTXTRecord("_uaid."+nativeID, value, options.TTL), nil
+}
+
+// BuildAIDDNSRecord builds the _agent TXT record that advertises the
+// endpoint of a uaid:aid agent on the domain in its nativeId parameter.
+func BuildAIDDNSRecord(uaid string, options AIDDNSRecordOptions) (DNSRecord, error) {
+	parsed, err := ParseUAID(uaid)
+	if err != nil {
+		return DNSRecord{}, err
+	}
+	if parsed.Target != "aid" {
+		return DNSRecord{}, fmt.Errorf("_agent records apply only to uaid:aid identifiers")
+	}
+	nativeID := normalizeDomain(parsed.Params["nativeId"])
+	if !isFQDN(nativeID) {
+		return DNSRecord{}, fmt.Errorf("UAID nativeId must be a fully qualified domain name")
+	}
+
+	version := strings.TrimSpace(options.Version)
+	if version == "" {
+		version = "aid1"
+	}
+	if !strings.HasPrefix(strings.ToLower(version), "aid") {
+		return DNSRecord{}, fmt.Errorf("AID record version must start with aid")
+	}
+	protocol := strings.TrimSpace(options.Protocol)
+	if protocol == "" {
+		protocol = strings.TrimSpace(parsed.Params["proto"])
+	}
+	if protocol == "" {
+		return DNSRecord{}, fmt.Errorf("AID record protocol is required")
+	}
+	endpoint := strings.TrimSpace(options.Endpoint)
+	parsedEndpoint, err := url.Parse(endpoint)
+	if err != nil || endpoint == "" || parsedEndpoint.Host == "" {
+		return DNSRecord{}, fmt.Errorf("AID record endpoint must be an absolute URI")
+	}
+	switch strings.ToLower(parsedEndpoint.Scheme) {
+	case "https", "http", "wss", "ws":
+	default:
+		return DNSRecord{}, fmt.Errorf("unsupported AID endpoint scheme %q", parsedEndpoint.Scheme)
+	}
+
+	value, err := joinTXTFields([]string{"v", "p", "u", "k", "i"}, map[string]string{
+		"v": version,
+		"p": protocol,
+		"u": parsedEndpoint.String(),
+		"k": strings.TrimSpace(options.PublicKey),
+		"i": strings.TrimSpace(options.KeyID),
+	})
+	if err != nil {
+		return DNSRecord{}, err
+	}
+	return newTXTRecord("_agent."+nativeID, value, options.TTL), nil
+}
+
+// ZoneLine renders the record in zone-file syntax, one quoted string per chunk.
+func (record DNSRecord) ZoneLine() string {
+	quoted := make([]string, 0, len(record.Chunks))
+	for _, chunk := range record.Chunks {
+		escaped := strings.ReplaceAll(chunk, `\`, `\\`)
+		escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+		quoted = append(quoted, `"`+escaped+`"`)
+	}
+	return fmt.Sprintf("%s. %d IN %s %s", record.Name, record.TTL, record.Type, strings.Join(quoted, " "))
+}
+
+// VerifyDomainBinding checks the domain's _uaid TXT records against the UAID
+// using the system resolver.
+func VerifyDomainBinding(ctx context.Context, domain string, uaid string) (DomainBindingReport, error) {
+	return verifyDomainBinding(ctx, nodeDNSTXTLookup, domain, uaid)
+}
+
+// VerifyDomainBinding checks the domain's _uaid TXT records against the UAID
+// using the client's DNS lookup.
+func (client *Client) VerifyDomainBinding(ctx context.Context, domain string, uaid string) (DomainBindingReport, error) {
+	return verifyDomainBinding(ctx, client.dnsLookup, domain, uaid)
+}
+
+func verifyDomainBinding(
+	ctx context.Context,
+	lookup DNSLookupFunc,
+	domain string,
+	uaid string,
+) (DomainBindingReport, error) {
+	normalizedDomain := normalizeDomain(domain)
+	if !isFQDN(normalizedDomain) {
+		return DomainBindingReport{}, fmt.Errorf("domain must be a fully qualified domain name")
+	}
+	expected, err := BuildUAIDDNSRecord(uaid, UAIDDNSRecordOptions{})
+	if err != nil {
+		return DomainBindingReport{}, err
+	}
+
+	report := DomainBindingReport{
+		Domain:         normalizedDomain,
+		DNSName:        "_uaid." + normalizedDomain,
+		UAID:           uaid,
+		ExpectedRecord: expected.Value,
+	}
+	expectedFields := parseSemicolonFields(expected.Value)
+	if expectedFields["nativeId"] != normalizedDomain {
+		report.Mismatches = []DNSFieldMismatch{{Field: "nativeId", Expected: normalizedDomain, Actual: expectedFields["nativeId"]}}
+		report.Reason = "UAID nativeId does not name this domain"
+		return report, nil
+	}
+
+	records, err := lookup(ctx, report.DNSName)
+	if err != nil {
+		return DomainBindingReport{}, err
+	}
+	report.Records = records
+
+	var best []DNSFieldMismatch
+	candidates := 0
+	for _, record := range records {
+		fields := parseSemicolonFields(record)
+		if _, ok := fields["target"]; !ok {
+			continue
+		}
+		candidates++
+		mismatches := compareUAIDDNSFields(expectedFields, fields)
+		if len(mismatches) == 0 && validateUAIDDNSRecord(fields, normalizedDomain) != nil {
+			report.Matched = true
+			report.Mismatches = nil
+			return report, nil
+		}
+		if best == nil || len(mismatches) < len(best) {
+			best = mismatches
+		}
+	}
+
+	switch {
+	case len(records) == 0:
+		report.Reason = "no _uaid TXT record found"
+	case candidates == 0:
+		report.Reason = "no TXT record carries a target field"
+	default:
+		report.Reason = "no _uaid TXT record matches the UAID"
+		report.Mismatches = best
+	}
+	return report, nil
+}
+
+// compareUAIDDNSFields reports field differences, ignoring the optional did
+// field when the expected record omits it.
+func compareUAIDDNSFields(expected map[string]string, actual map[string]string) []DNSFieldMismatch {
+	mismatches := make([]DNSFieldMismatch, 0)
+	for _, key := range uaidDNSFieldOrder {
+		expectedValue := expected[key]
+		actualValue := actual[key]
+		if key == "did" && expectedValue == "" {
+			continue
+		}
+		if key == "nativeId" || key == "domain" {
+			expectedValue = normalizeDomain(expectedValue)
+			actualValue = normalizeDomain(actualValue)
+		}
+		if expectedValue != actualValue {
+			mismatches = append(mismatches, DNSFieldMismatch{Field: key, Expected: expectedValue, Actual: actualValue})
+		}
+	}
+	return mismatches
+}
+
+func joinTXTFields(order []string, fields map[string]string) (string, error) {
+	parts := make([]string, 0, len(order))
+	for _, key := range order {
+		value := fields[key]
+		if value == "" {
+			continue
+		}
+		if strings.ContainsAny(value, ";\"") || strings.TrimSpace(value) != value {
+			return "", fmt.Errorf("value of %s cannot be encoded in a TXT record: %q", key, value)
+		}
+		parts = append(parts, key+"="+value)
+	}
+	return strings.Join(parts, ";"), nil
+}
+
+func newTXTRecord(name string, value string, ttl int) DNSRecord {
+	if ttl <= 0 {
+		ttl = defaultDNSRecordTTL
+	}
+	return DNSRecord{
+		Name:   name,
+		Type:   "TXT",
+		TTL:    ttl,
+		Value:  value,
+		Chunks: chunkTXTValue(value),
+	}
+}
+
+func chunkTXTValue(value string) []string {
+	chunks := make([]string, 0, len(value)/MaxTXTStringLength+1)
+	for start := 0; start < len(value); start += MaxTXTStringLength {
+		chunks = append(chunks, value[start:min(start+MaxTXTStringLength, len(value))])
+	}
+	return chunks
+}
+
+func isUAIDDNSField(key string) bool {
+	for _, field := range uaidDNSFieldOrder {
+		if field == key {
+			return true
+		}
+	}
+	return false
+}
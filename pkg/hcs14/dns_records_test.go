@@ -0,0 +1,140 @@
+package hcs14
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const dnsRecordTestUAID = "uaid:aid:test123;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=ans;proto=a2a;nativeId=ote.agent.cs3p.com;version=1.0.1"
+
+func TestBuildUAIDDNSRecord(t *testing.T) {
+	record, err := BuildUAIDDNSRecord(dnsRecordTestUAID, UAIDDNSRecordOptions{})
+	if err != nil {
+		t.Fatalf("BuildUAIDDNSRecord failed: %v", err)
+	}
+	if record.Name != "_uaid.ote.agent.cs3p.com" || record.Type != "TXT" || record.TTL != defaultDNSRecordTTL {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+	expected := "target=aid;id=test123;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=ans;proto=a2a;nativeId=ote.agent.cs3p.com;version=1.0.1"
+	if record.Value != expected {
+		t.Fatalf("unexpected value: %s", record.Value)
+	}
+
+	resolver := NewUaidDNSWebResolver(UaidDNSWebResolverOptions{
+		DNSLookup: func(ctx context.Context, hostname string) ([]string, error) {
+			return []string{strings.Join(record.Chunks, "")}, nil
+		},
+	})
+	result, err := resolver.Resolve(context.Background(), dnsRecordTestUAID, nil)
+	if err != nil || !result.Metadata.Resolved {
+		t.Fatalf("expected record to resolve, got %+v %v", result, err)
+	}
+
+	if _, err := BuildUAIDDNSRecord("uaid:aid:abc;uid=1;proto=a2a;nativeId=localhost", UAIDDNSRecordOptions{}); err == nil {
+		t.Fatal("expected error for non-FQDN nativeId")
+	}
+	if _, err := BuildUAIDDNSRecord(dnsRecordTestUAID, UAIDDNSRecordOptions{DID: "did:key:z6Mk"}); err == nil {
+		t.Fatal("expected error for did field on uaid:aid")
+	}
+}
+
+func TestBuildUAIDDNSRecordChunksLongValues(t *testing.T) {
+	uid := strings.Repeat("a", 300)
+	record, err := BuildUAIDDNSRecord("uaid:did:abc;uid="+uid+";proto=a2a;nativeId=example.com", UAIDDNSRecordOptions{
+		DID: "did:web:example.com",
+		TTL: 300,
+	})
+	if err != nil {
+		t.Fatalf("BuildUAIDDNSRecord failed: %v", err)
+	}
+	if len(record.Chunks) != 2 || len(record.Chunks[0]) != MaxTXTStringLength {
+		t.Fatalf("unexpected chunks: %d", len(record.Chunks))
+	}
+	if strings.Join(record.Chunks, "") != record.Value || !strings.HasSuffix(record.Value, ";did=did:web:example.com") {
+		t.Fatalf("unexpected value: %s", record.Value)
+	}
+	line := record.ZoneLine()
+	if !strings.HasPrefix(line, "_uaid.example.com. 300 IN TXT \"target=did;") || strings.Count(line, "\"") != 4 {
+		t.Fatalf("unexpected zone line: %s", line)
+	}
+}
+
+func TestBuildAIDDNSRecord(t *testing.T) {
+	uaid := "uaid:aid:abc;uid=agent;proto=a2a;nativeId=Agent.Example.com."
+	record, err := BuildAIDDNSRecord(uaid, AIDDNSRecordOptions{
+		Endpoint:  "https://agent.example.com/a2a",
+		PublicKey: "z6Mkabc",
+		KeyID:     "key-1",
+	})
+	if err != nil {
+		t.Fatalf("BuildAIDDNSRecord failed: %v", err)
+	}
+	if record.Name != "_agent.agent.example.com" {
+		t.Fatalf("unexpected name: %s", record.Name)
+	}
+	if record.Value != "v=aid1;p=a2a;u=https://agent.example.com/a2a;k=z6Mkabc;i=key-1" {
+		t.Fatalf("unexpected value: %s", record.Value)
+	}
+
+	if _, err := BuildAIDDNSRecord(uaid, AIDDNSRecordOptions{Endpoint: "ftp://agent.example.com"}); err == nil {
+		t.Fatal("expected unsupported scheme error")
+	}
+	if _, err := BuildAIDDNSRecord("uaid:did:abc;uid=agent;proto=a2a;nativeId=example.com", AIDDNSRecordOptions{Endpoint: "https://example.com"}); err == nil {
+		t.Fatal("expected error for uaid:did")
+	}
+}
+
+func TestVerifyDomainBinding(t *testing.T) {
+	var records []string
+	client := NewClient(ClientOptions{
+		DisableDefaultDIDResolvers: true,
+		DNSLookup: func(ctx context.Context, hostname string) ([]string, error) {
+			if hostname != "_uaid.ote.agent.cs3p.com" {
+				t.Fatalf("unexpected hostname: %s", hostname)
+			}
+			return records, nil
+		},
+	})
+
+	report, err := client.VerifyDomainBinding(context.Background(), "ote.agent.cs3p.com", dnsRecordTestUAID)
+	if err != nil {
+		t.Fatalf("VerifyDomainBinding failed: %v", err)
+	}
+	if report.Matched || report.Reason != "no _uaid TXT record found" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	records = []string{
+		"v=spf1 -all",
+		"target=aid;id=other;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=hol;proto=a2a;nativeId=ote.agent.cs3p.com;version=1.0.1",
+		"target=aid;id=test123;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=ans;proto=mcp;nativeId=ote.agent.cs3p.com;version=1.0.1",
+	}
+	report, err = client.VerifyDomainBinding(context.Background(), "ote.agent.cs3p.com", dnsRecordTestUAID)
+	if err != nil {
+		t.Fatalf("VerifyDomainBinding failed: %v", err)
+	}
+	if report.Matched || len(report.Mismatches) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if mismatch := report.Mismatches[0]; mismatch.Field != "proto" || mismatch.Expected != "a2a" || mismatch.Actual != "mcp" {
+		t.Fatalf("unexpected mismatch: %+v", mismatch)
+	}
+
+	records = append(records, report.ExpectedRecord+";m=demo")
+	report, err = client.VerifyDomainBinding(context.Background(), "OTE.agent.cs3p.com.", dnsRecordTestUAID)
+	if err != nil {
+		t.Fatalf("VerifyDomainBinding failed: %v", err)
+	}
+	if !report.Matched || len(report.Mismatches) != 0 {
+		t.Fatalf("expected match, got %+v", report)
+	}
+
+	report, err = client.VerifyDomainBinding(context.Background(), "other.example.com", dnsRecordTestUAID)
+	if err != nil {
+		t.Fatalf("VerifyDomainBinding failed: %v", err)
+	}
+	if report.Matched || report.Mismatches[0].Field != "nativeId" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
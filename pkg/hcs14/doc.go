@@ -1,7 +1,9 @@
 // Package hcs14 implements the HCS-14 Universal Agent ID (UAID) specification
 // for the Hedera Consensus Service (HCS). It provides UAID generation, parsing,
-// profile resolution via _uaid, _agent, and ANS _ans DNS TXT records, and
-// uaid:did base DID reconstruction.
+// profile resolution via _uaid, _agent, and ANS _ans DNS TXT records,
+// uaid:did base DID reconstruction, built-in did:hedera, did:web, did:key
+// and did:pkh resolvers, and _uaid/_agent TXT record builders with a domain
+// binding verifier.
 //
 // HCS-14 defines a universal, verifiable identity standard for AI agents,
 // enabling cross-platform agent discovery and authentication anchored to the
@@ -24,6 +26,39 @@
 //		"uaid:aid:my-agent;registry=ans;proto=a2a",
 //	)
 //
+// # Tracing and caching
+//
+// ResolveWithTrace returns each step taken during resolution: the profiles
+// tried and why they declined, DNS answers, HTTP fetches and DID lookups.
+// DNSCache and HTTPCache in ClientOptions reuse TXT answers and HTTP
+// responses. HTTPCache honours Cache-Control; DNSCache keeps answers for
+// DefaultTTL, or for their DNS TTL when its TTLLookup is a TTL-aware lookup
+// such as NewDNSServerTXTLookup:
+//
+//	client := hcs14.NewClient(hcs14.ClientOptions{
+//		DNSCache:  hcs14.NewDNSCache(hcs14.DNSCacheOptions{}),
+//		HTTPCache: hcs14.NewHTTPCache(hcs14.HTTPCacheOptions{}),
+//	})
+//	result, trace, err := client.ResolveWithTrace(ctx, uaid)
+//	fmt.Print(trace)
+//
+// # Proving control of a UAID
+//
+// SignChallenge produces a compact JWS over a challenge with the agent's
+// signer; VerifyUAIDSignature resolves the UAID to its authentication keys
+// and checks the signature:
+//
+//	jws, err := hcs14.SignChallenge(ctx, uaid, shared.NewLocalSigner(privateKey), challenge)
+//	verified, err := client.VerifyUAIDSignature(ctx, uaid, challenge, jws)
+//
+// # Publishing DNS records
+//
+// Build the _uaid TXT record for a domain-anchored UAID and check that the
+// domain publishes it:
+//
+//	record, err := hcs14.BuildUAIDDNSRecord(uaid, hcs14.UAIDDNSRecordOptions{})
+//	report, err := client.VerifyDomainBinding(ctx, "agent.example.com", uaid)
+//
 // This package is part of the HOL Standards SDK for Go.
 // See https://hol.org for more information about the HOL ecosystem.
 package hcs14
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrInvalidBase58Character = errors.New("invalid base58 character")
 	ErrInvalidMultibase       = errors.New("invalid multibase base58btc")
+	ErrUAIDSignatureInvalid   = errors.New("invalid UAID signature")
+	ErrDIDDocumentNotFound    = errors.New("DID document not found")
 )
@@ -2,6 +2,7 @@ package hcs14
 
 import (
 	"context"
+	"errors"
 	"strings"
 )
 
@@ -39,19 +40,30 @@ func (registry *ResolverRegistry) RegisterUAIDProfileResolver(resolver UAIDProfi
 	registry.uaidProfileResolvers = append(registry.uaidProfileResolvers, resolver)
 }
 
-// ResolveDID resolves the requested identifier data.
+// ResolveDID resolves a DID with the first supporting resolver that returns a
+// document. A resolver reporting ErrDIDDocumentNotFound is treated as having
+// no document; any other resolver error is returned, so (nil, nil) always
+// means no registered resolver has a document for the DID.
 func (registry *ResolverRegistry) ResolveDID(ctx context.Context, did string) (*DIDDocument, error) {
 	for _, resolver := range registry.didResolvers {
 		if !resolver.Supports(did) {
 			continue
 		}
-		document, err := resolver.Resolve(ctx, did)
+		stepCtx, span := startTraceStep(ctx, TraceStepDID, did)
+		document, err := resolver.Resolve(stepCtx, did)
+		if errors.Is(err, ErrDIDDocumentNotFound) {
+			span.finish(TraceOutcomeNoResult, err.Error(), nil)
+			continue
+		}
 		if err != nil {
+			span.finish(TraceOutcomeError, err.Error(), nil)
 			return nil, err
 		}
 		if document != nil {
+			span.finish(TraceOutcomeResolved, "", nil)
 			return document, nil
 		}
+		span.finish(TraceOutcomeNoResult, "", nil)
 	}
 	return nil, nil
 }
@@ -137,6 +149,8 @@ func (registry *ResolverRegistry) resolveUaidProfileInternal(
 			continue
 		}
 		if profileID == "" && !resolver.Supports(uaid, parsed) {
+			_, span := startTraceStep(ctx, TraceStepProfile, resolver.ProfileID())
+			span.finish(TraceOutcomeUnsupported, "resolver does not support this UAID", nil)
 			continue
 		}
 
@@ -165,7 +179,9 @@ func (registry *ResolverRegistry) resolveUaidProfileInternal(
 			},
 		}
 
-		resolved, resolveErr := resolver.ResolveProfile(ctx, uaid, context)
+		profileCtx, span := startTraceStep(ctx, TraceStepProfile, resolver.ProfileID())
+		resolved, resolveErr := resolver.ResolveProfile(profileCtx, uaid, context)
+		finishProfileStep(span, resolved, resolveErr)
 		if resolveErr != nil {
 			return nil, resolveErr
 		}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -166,3 +167,23 @@ func TestClientResolveANSViaRegistryFlow(t *testing.T) {
 		t.Fatalf("unexpected endpoint: %s", result.Metadata.Endpoint)
 	}
 }
+
+func TestResolverRegistryResolveDIDSkipsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	did := "did:web:" + strings.ReplaceAll(strings.TrimPrefix(server.URL, "http://"), ":", "%3A")
+	registry := NewResolverRegistry()
+	registry.RegisterDIDResolver(NewDIDWebResolver(DIDWebResolverOptions{HTTPClient: server.Client(), AllowHTTP: true}))
+
+	document, err := registry.ResolveDID(context.Background(), did)
+	if err != nil || document != nil {
+		t.Fatalf("expected no document for a not-found DID, got %+v %v", document, err)
+	}
+
+	registry.RegisterDIDResolver(&mockDIDResolver{document: &DIDDocument{ID: did}})
+	document, err = registry.ResolveDID(context.Background(), did)
+	if err != nil || document == nil || document.ID != did {
+		t.Fatalf("expected the next resolver's document, got %+v %v", document, err)
+	}
+}
@@ -0,0 +1,253 @@
+package hcs14
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TraceStepProfile = "profile"
+	TraceStepDID     = "did"
+	TraceStepDNS     = "dns"
+	TraceStepHTTP    = "http"
+
+	TraceOutcomeResolved    = "resolved"
+	TraceOutcomeDeclined    = "declined"
+	TraceOutcomeUnsupported = "unsupported"
+	TraceOutcomeNoResult    = "no-result"
+	TraceOutcomeAnswered    = "answered"
+	TraceOutcomeError       = "error"
+)
+
+// ResolutionTraceStep records one action taken while resolving a UAID.
+// Target is the profile ID, DID, DNS name or URL the step acted on; Profile
+// is the UAID profile that was running when the step happened.
+type ResolutionTraceStep struct {
+	Kind       string
+	Profile    string
+	Target     string
+	Outcome    string
+	Reason     string
+	Answers    []string
+	StatusCode int
+	Cached     bool
+	Started    time.Time
+	Duration   time.Duration
+}
+
+// ResolutionTrace collects the steps of one or more resolutions. Attach it to
+// a context with WithResolutionTrace; it is safe for concurrent use.
+type ResolutionTrace struct {
+	mutex sync.Mutex
+	steps []ResolutionTraceStep
+}
+
+type traceContextKey struct{}
+
+type traceProfileContextKey struct{}
+
+type traceStepContextKey struct{}
+
+// NewResolutionTrace creates a new ResolutionTrace.
+func NewResolutionTrace() *ResolutionTrace {
+	return &ResolutionTrace{steps: make([]ResolutionTraceStep, 0)}
+}
+
+// WithResolutionTrace returns a context that records resolution steps into trace.
+func WithResolutionTrace(ctx context.Context, trace *ResolutionTrace) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, trace)
+}
+
+// ResolutionTraceFromContext returns the trace attached to ctx, if any.
+func ResolutionTraceFromContext(ctx context.Context) *ResolutionTrace {
+	trace, _ := ctx.Value(traceContextKey{}).(*ResolutionTrace)
+	return trace
+}
+
+// Steps returns a copy of the recorded steps in the order they started.
+func (trace *ResolutionTrace) Steps() []ResolutionTraceStep {
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+
+	steps := make([]ResolutionTraceStep, len(trace.steps))
+	for index, step := range trace.steps {
+		step.Answers = append([]string(nil), step.Answers...)
+		steps[index] = step
+	}
+	return steps
+}
+
+// String renders the trace one step per line for logs and debugging.
+func (trace *ResolutionTrace) String() string {
+	var builder strings.Builder
+	for _, step := range trace.Steps() {
+		fmt.Fprintf(&builder, "%-7s %s -> %s", step.Kind, step.Target, step.Outcome)
+		if step.StatusCode != 0 {
+			fmt.Fprintf(&builder, " status=%d", step.StatusCode)
+		}
+		if step.Cached {
+			builder.WriteString(" cached")
+		}
+		fmt.Fprintf(&builder, " (%s)", step.Duration.Round(time.Microsecond))
+		if step.Profile != "" && step.Kind != TraceStepProfile {
+			fmt.Fprintf(&builder, " profile=%s", step.Profile)
+		}
+		if step.Reason != "" {
+			fmt.Fprintf(&builder, " reason=%q", step.Reason)
+		}
+		for _, answer := range step.Answers {
+			fmt.Fprintf(&builder, "\n        %q", answer)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// traceSpan is an in-flight step. A nil span is valid and records nothing,
+// so call sites do not need to check whether tracing is enabled.
+type traceSpan struct {
+	trace *ResolutionTrace
+	index int
+}
+
+func startTraceStep(ctx context.Context, kind string, target string) (context.Context, *traceSpan) {
+	trace := ResolutionTraceFromContext(ctx)
+	if trace == nil {
+		return ctx, nil
+	}
+	profile, _ := ctx.Value(traceProfileContextKey{}).(string)
+	if kind == TraceStepProfile {
+		profile = target
+		ctx = context.WithValue(ctx, traceProfileContextKey{}, target)
+	}
+
+	trace.mutex.Lock()
+	trace.steps = append(trace.steps, ResolutionTraceStep{
+		Kind:    kind,
+		Profile: profile,
+		Target:  target,
+		Started: time.Now(),
+	})
+	span := &traceSpan{trace: trace, index: len(trace.steps) - 1}
+	trace.mutex.Unlock()
+
+	return context.WithValue(ctx, traceStepContextKey{}, span), span
+}
+
+func (span *traceSpan) finish(outcome string, reason string, update func(step *ResolutionTraceStep)) {
+	if span == nil {
+		return
+	}
+	span.trace.mutex.Lock()
+	defer span.trace.mutex.Unlock()
+
+	step := &span.trace.steps[span.index]
+	step.Outcome = outcome
+	if reason != "" {
+		step.Reason = reason
+	}
+	step.Duration = time.Since(step.Started)
+	if update != nil {
+		update(step)
+	}
+}
+
+// markTraceStepCached flags the innermost in-flight step as served from a cache.
+func markTraceStepCached(ctx context.Context) {
+	updateCurrentTraceStep(ctx, func(step *ResolutionTraceStep) {
+		step.Cached = true
+	})
+}
+
+// noteTraceStep sets the reason of the innermost in-flight step, for
+// failures that are handled before they reach the step's caller.
+func noteTraceStep(ctx context.Context, reason string) {
+	updateCurrentTraceStep(ctx, func(step *ResolutionTraceStep) {
+		step.Reason = reason
+	})
+}
+
+func updateCurrentTraceStep(ctx context.Context, update func(step *ResolutionTraceStep)) {
+	span, _ := ctx.Value(traceStepContextKey{}).(*traceSpan)
+	if span == nil {
+		return
+	}
+	span.trace.mutex.Lock()
+	update(&span.trace.steps[span.index])
+	span.trace.mutex.Unlock()
+}
+
+// finishProfileStep records how a UAID profile resolver responded.
+func finishProfileStep(span *traceSpan, result *UAIDResolutionResult, err error) {
+	switch {
+	case err != nil:
+		span.finish(TraceOutcomeError, err.Error(), nil)
+	case result == nil:
+		span.finish(TraceOutcomeNoResult, "", nil)
+	case result.Error != nil:
+		span.finish(TraceOutcomeDeclined, result.Error.Code+": "+result.Error.Message, nil)
+	case !result.Metadata.Resolved:
+		span.finish(TraceOutcomeDeclined, "profile returned an unresolved result", nil)
+	default:
+		span.finish(TraceOutcomeResolved, "", nil)
+	}
+}
+
+func traceDNSLookup(lookup DNSLookupFunc) DNSLookupFunc {
+	return func(ctx context.Context, hostname string) ([]string, error) {
+		ctx, span := startTraceStep(ctx, TraceStepDNS, hostname)
+		records, err := lookup(ctx, hostname)
+		if err != nil {
+			span.finish(TraceOutcomeError, err.Error(), nil)
+			return nil, err
+		}
+		outcome, reason := TraceOutcomeAnswered, ""
+		if len(records) == 0 {
+			outcome, reason = TraceOutcomeNoResult, "no TXT records"
+		}
+		span.finish(outcome, reason, func(step *ResolutionTraceStep) {
+			step.Answers = append([]string(nil), records...)
+		})
+		return records, nil
+	}
+}
+
+type traceTransport struct {
+	base http.RoundTripper
+}
+
+func (transport *traceTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, span := startTraceStep(request.Context(), TraceStepHTTP, request.URL.String())
+	if span != nil {
+		request = request.WithContext(ctx)
+	}
+	response, err := transport.base.RoundTrip(request)
+	if err != nil {
+		span.finish(TraceOutcomeError, err.Error(), nil)
+		return nil, err
+	}
+	outcome, reason := TraceOutcomeAnswered, ""
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		outcome, reason = TraceOutcomeError, response.Status
+	}
+	span.finish(outcome, reason, func(step *ResolutionTraceStep) {
+		step.StatusCode = response.StatusCode
+	})
+	return response, nil
+}
+
+// withTraceTransport returns a copy of client whose requests are recorded in
+// any trace attached to the request context.
+func withTraceTransport(client *http.Client) *http.Client {
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	traced := *client
+	traced.Transport = &traceTransport{base: base}
+	return &traced
+}
@@ -0,0 +1,111 @@
+package hcs14
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientResolveWithTraceAndCaches(t *testing.T) {
+	var cardFetches atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		cardFetches.Add(1)
+		writer.Header().Set("Cache-Control", "public, max-age=120")
+		_ = json.NewEncoder(writer).Encode(map[string]any{
+			"ansName":   "ans://v1.0.1.ote.agent.cs3p.com",
+			"endpoints": map[string]any{"a2a": map[string]any{"url": "https://ote.agent.cs3p.com/a2a"}},
+		})
+	}))
+	defer server.Close()
+
+	var dnsQueries atomic.Int32
+	client := NewClient(ClientOptions{
+		DisableDefaultDIDResolvers: true,
+		DNSCache: NewDNSCache(DNSCacheOptions{
+			Lookup: func(ctx context.Context, hostname string) ([]string, error) {
+				dnsQueries.Add(1)
+				switch hostname {
+				case "_uaid.ote.agent.cs3p.com":
+					return []string{"target=aid;id=test123;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=ans;proto=a2a;nativeId=ote.agent.cs3p.com;version=1.0.1"}, nil
+				case "_ans.ote.agent.cs3p.com":
+					return []string{fmt.Sprintf("v=ans1;version=1.0.1;url=%s", server.URL)}, nil
+				}
+				return []string{}, nil
+			},
+		}),
+		HTTPCache: NewHTTPCache(HTTPCacheOptions{}),
+		HTTP: ANSDNSWebResolverOptions{
+			HTTPClient: &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}},
+		},
+	})
+
+	uaid := "uaid:aid:test123;uid=ans://v1.0.1.ote.agent.cs3p.com;registry=ans;proto=a2a;nativeId=ote.agent.cs3p.com;version=1.0.1"
+	result, trace, err := client.ResolveWithTrace(context.Background(), uaid)
+	if err != nil {
+		t.Fatalf("ResolveWithTrace failed: %v", err)
+	}
+	if result.Metadata.Endpoint != "https://ote.agent.cs3p.com/a2a" {
+		t.Fatalf("unexpected result: %+v", result.Metadata)
+	}
+
+	steps := trace.Steps()
+	if steps[0].Kind != TraceStepProfile || steps[0].Target != UAIDDNSWebProfileID || steps[0].Outcome != TraceOutcomeResolved {
+		t.Fatalf("unexpected first step: %+v", steps[0])
+	}
+	if steps[1].Kind != TraceStepDNS || steps[1].Target != "_uaid.ote.agent.cs3p.com" || len(steps[1].Answers) != 1 {
+		t.Fatalf("unexpected DNS step: %+v", steps[1])
+	}
+	last := steps[len(steps)-1]
+	if last.Kind != TraceStepHTTP || last.Target != server.URL || last.StatusCode != http.StatusOK ||
+		last.Profile != ANSDNSWebProfileID || last.Cached {
+		t.Fatalf("expected follow-up agent card fetch in trace:\n%s", trace)
+	}
+
+	queries := dnsQueries.Load()
+	_, trace, err = client.ResolveWithTrace(context.Background(), uaid)
+	if err != nil {
+		t.Fatalf("second ResolveWithTrace failed: %v", err)
+	}
+	if dnsQueries.Load() != queries || cardFetches.Load() != 1 {
+		t.Fatalf("expected cached answers, got %d DNS queries and %d fetches", dnsQueries.Load(), cardFetches.Load())
+	}
+	for _, step := range trace.Steps() {
+		if (step.Kind == TraceStepDNS || step.Kind == TraceStepHTTP) && !step.Cached {
+			t.Fatalf("expected cached step: %+v", step)
+		}
+	}
+}
+
+func TestResolveWithoutTraceRecordsNothing(t *testing.T) {
+	trace := NewResolutionTrace()
+	client := NewClient(ClientOptions{
+		DisableDefaultDIDResolvers: true,
+		DNSLookup: func(ctx context.Context, hostname string) ([]string, error) {
+			return []string{}, nil
+		},
+	})
+	if _, err := client.Resolve(context.Background(), "uaid:aid:abc;uid=1;proto=a2a;nativeId=example.com"); err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(trace.Steps()) != 0 {
+		t.Fatal("expected no steps without an attached trace")
+	}
+
+	ctx := WithResolutionTrace(context.Background(), trace)
+	if _, err := client.ResolveProfile(ctx, "uaid:aid:abc;uid=1;proto=a2a;nativeId=example.com", AIDDNSWebProfileID); err != nil {
+		t.Fatalf("ResolveProfile failed: %v", err)
+	}
+	steps := trace.Steps()
+	if len(steps) != 2 || steps[0].Outcome != TraceOutcomeDeclined || !strings.HasPrefix(steps[0].Reason, "ERR_NO_DNS_RECORD") {
+		t.Fatalf("unexpected steps: %+v", steps)
+	}
+	if steps[1].Kind != TraceStepDNS || steps[1].Profile != AIDDNSWebProfileID || steps[1].Outcome != TraceOutcomeNoResult {
+		t.Fatalf("unexpected DNS step: %+v", steps[1])
+	}
+}
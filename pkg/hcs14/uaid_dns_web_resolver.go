@@ -34,7 +34,7 @@ func NewUaidDNSWebResolver(options UaidDNSWebResolverOptions) *UaidDNSWebResolve
 	}
 
 	return &UaidDNSWebResolver{
-		dnsLookup:                lookup,
+		dnsLookup:                traceDNSLookup(lookup),
 		requireFullResolution:    options.RequireFullResolution,
 		enableFollowupResolution: options.EnableFollowupResolution,
 	}
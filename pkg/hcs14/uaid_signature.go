@@ -0,0 +1,263 @@
+package hcs14
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+const (
+	JWSAlgorithmEdDSA  = "EdDSA"
+	JWSAlgorithmES256K = "ES256K"
+)
+
+type uaidJWSHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+	UAID      string `json:"uaid"`
+}
+
+// VerifiedUAIDSignature describes a signature accepted by VerifyUAIDSignature.
+type VerifiedUAIDSignature struct {
+	UAID               string
+	Algorithm          string
+	VerificationMethod DIDVerificationMethod
+}
+
+// SignChallenge signs payload on behalf of uaid and returns a compact JWS
+// with the payload attached. Ed25519 signers produce EdDSA signatures and
+// secp256k1 signers ES256K signatures. The protected header carries the UAID
+// so a signature cannot be replayed for another identifier sharing the key.
+func SignChallenge(ctx context.Context, uaid string, signer shared.Signer, payload []byte) (string, error) {
+	return SignChallengeWithKeyID(ctx, uaid, signer, payload, "")
+}
+
+// SignChallengeWithKeyID is SignChallenge with a kid header naming the
+// verification method to check first, such as did:hedera:testnet:0.0.1#did-root-key.
+//
+// ES256K signs a SHA-256 digest, while Signer implementations sign secp256k1
+// messages over Keccak-256 for Hedera, so secp256k1 challenges require a
+// shared.DigestSigner such as shared.LocalSigner, shared.RemoteSigner or
+// shared.PKCS11Signer.
+func SignChallengeWithKeyID(
+	ctx context.Context,
+	uaid string,
+	signer shared.Signer,
+	payload []byte,
+	keyID string,
+) (string, error) {
+	if _, err := ParseUAID(uaid); err != nil {
+		return "", err
+	}
+	if signer == nil {
+		return "", fmt.Errorf("signer is required")
+	}
+	if len(payload) == 0 {
+		return "", fmt.Errorf("challenge payload is required")
+	}
+
+	keyType, err := shared.KeyType(signer.PublicKey())
+	if err != nil {
+		return "", err
+	}
+	algorithm := JWSAlgorithmEdDSA
+	var digestSigner shared.DigestSigner
+	if keyType == shared.KeyTypeECDSASecp256k1 {
+		algorithm = JWSAlgorithmES256K
+		var ok bool
+		if digestSigner, ok = signer.(shared.DigestSigner); !ok {
+			return "", fmt.Errorf("ES256K challenges require a signer implementing shared.DigestSigner")
+		}
+	}
+
+	header, err := json.Marshal(uaidJWSHeader{
+		Algorithm: algorithm,
+		KeyID:     strings.TrimSpace(keyID),
+		UAID:      strings.TrimSpace(uaid),
+	})
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	if algorithm == JWSAlgorithmEdDSA {
+		signature, err = signer.Sign(ctx, []byte(signingInput))
+	} else {
+		// Hedera signs secp256k1 messages over Keccak-256; ES256K requires SHA-256.
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err = digestSigner.SignDigest(ctx, digest[:])
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign challenge: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// VerifyUAIDSignature checks a compact JWS produced by SignChallenge against
+// the verification methods the UAID resolves to, using a default Client.
+func VerifyUAIDSignature(
+	ctx context.Context,
+	uaid string,
+	payload []byte,
+	signature string,
+) (*VerifiedUAIDSignature, error) {
+	return NewClient(ClientOptions{}).VerifyUAIDSignature(ctx, uaid, payload, signature)
+}
+
+// VerifyUAIDSignature checks a compact JWS produced by SignChallenge. The
+// UAID is resolved to its DID document, or to the Hedera account key for
+// account-anchored identifiers, and the signature must verify against one of
+// its Ed25519 or secp256k1 verification methods referenced by
+// authentication; keys listed only for other purposes, such as
+// assertionMethod or key agreement, are ignored. Resolution failures are returned as-is. It returns
+// an error wrapping ErrUAIDSignatureInvalid when the signature does not
+// verify.
+func (client *Client) VerifyUAIDSignature(
+	ctx context.Context,
+	uaid string,
+	payload []byte,
+	signature string,
+) (*VerifiedUAIDSignature, error) {
+	header, signingInput, rawSignature, err := parseUAIDJWS(signature, payload)
+	if err != nil {
+		return nil, err
+	}
+	if header.UAID != strings.TrimSpace(uaid) {
+		return nil, fmt.Errorf("%w: signed for %s", ErrUAIDSignatureInvalid, header.UAID)
+	}
+
+	result, err := client.Resolve(ctx, uaid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve UAID %s: %w", uaid, err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to resolve UAID %s: %s: %s", uaid, result.Error.Code, result.Error.Message)
+	}
+	methods := orderVerificationMethods(authenticatingMethods(result), header.KeyID)
+	if len(methods) == 0 {
+		return nil, fmt.Errorf(
+			"%w: UAID %s has no authentication verification methods",
+			ErrUAIDSignatureInvalid,
+			uaid,
+		)
+	}
+
+	for _, method := range methods {
+		codec, key, decodeErr := decodeMulticodecKey(method.PublicKeyMultibase)
+		if decodeErr != nil {
+			continue
+		}
+		if verifyJWSSignature(header.Algorithm, codec, key, signingInput, rawSignature) {
+			return &VerifiedUAIDSignature{
+				UAID:               header.UAID,
+				Algorithm:          header.Algorithm,
+				VerificationMethod: method,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no verification method of %s matches", ErrUAIDSignatureInvalid, uaid)
+}
+
+// parseUAIDJWS splits a compact JWS and checks its payload against payload.
+// A detached JWS, with an empty payload segment, is also accepted.
+func parseUAIDJWS(jws string, payload []byte) (uaidJWSHeader, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimSpace(jws), ".")
+	if len(parts) != 3 {
+		return uaidJWSHeader{}, nil, nil, fmt.Errorf("signature must be a compact JWS")
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return uaidJWSHeader{}, nil, nil, fmt.Errorf("invalid JWS header: %w", err)
+	}
+	var header uaidJWSHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return uaidJWSHeader{}, nil, nil, fmt.Errorf("invalid JWS header: %w", err)
+	}
+	if header.Algorithm != JWSAlgorithmEdDSA && header.Algorithm != JWSAlgorithmES256K {
+		return uaidJWSHeader{}, nil, nil, fmt.Errorf("unsupported JWS algorithm %q", header.Algorithm)
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	if parts[1] != "" {
+		signedPayload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		if err != nil {
+			return uaidJWSHeader{}, nil, nil, fmt.Errorf("invalid JWS payload: %w", err)
+		}
+		if !bytes.Equal(signedPayload, payload) {
+			return uaidJWSHeader{}, nil, nil, fmt.Errorf("%w: payload does not match", ErrUAIDSignatureInvalid)
+		}
+	}
+
+	rawSignature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return uaidJWSHeader{}, nil, nil, fmt.Errorf("invalid JWS signature: %w", err)
+	}
+	return header, []byte(parts[0] + "." + encodedPayload), rawSignature, nil
+}
+
+func verifyJWSSignature(algorithm string, codec uint64, key []byte, signingInput []byte, signature []byte) bool {
+	switch {
+	case algorithm == JWSAlgorithmEdDSA && codec == multicodecEd25519Pub:
+		return ed25519.Verify(ed25519.PublicKey(key), signingInput, signature)
+	case algorithm == JWSAlgorithmES256K && codec == multicodecSecp256k1Pub:
+		if len(signature) != 64 {
+			return false
+		}
+		publicKey, err := btcec.ParsePubKey(key)
+		if err != nil {
+			return false
+		}
+		var r, s btcec.ModNScalar
+		if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:]) || r.IsZero() || s.IsZero() {
+			return false
+		}
+		// Reject the malleable high-S form of otherwise valid signatures.
+		if s.IsOverHalfOrder() {
+			return false
+		}
+		digest := sha256.Sum256(signingInput)
+		return btcecdsa.NewSignature(&r, &s).Verify(digest[:], publicKey)
+	}
+	return false
+}
+
+// authenticatingMethods returns the verification methods referenced by the
+// authentication relationship.
+func authenticatingMethods(result *UAIDResolutionResult) []DIDVerificationMethod {
+	referenced := map[string]bool{}
+	for _, id := range result.Authentication {
+		referenced[id] = true
+	}
+	methods := make([]DIDVerificationMethod, 0, len(result.VerificationMethod))
+	for _, method := range result.VerificationMethod {
+		if referenced[method.ID] {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
+// orderVerificationMethods puts the method named by keyID first.
+func orderVerificationMethods(methods []DIDVerificationMethod, keyID string) []DIDVerificationMethod {
+	ordered := make([]DIDVerificationMethod, 0, len(methods))
+	for _, method := range methods {
+		if keyID != "" && method.ID == keyID {
+			ordered = append([]DIDVerificationMethod{method}, ordered...)
+			continue
+		}
+		ordered = append(ordered, method)
+	}
+	return ordered
+}
@@ -0,0 +1,208 @@
+package hcs14
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func TestUAIDSignatureWithHederaAccountKey(t *testing.T) {
+	for name, generate := range map[string]func() (hedera.PrivateKey, error){
+		"ed25519": hedera.PrivateKeyGenerateEd25519,
+		"ecdsa":   hedera.PrivateKeyGenerateEcdsa,
+	} {
+		t.Run(name, func(t *testing.T) {
+			privateKey, err := generate()
+			if err != nil {
+				t.Fatalf("failed to generate key: %v", err)
+			}
+			keyType := "ED25519"
+			if name == "ecdsa" {
+				keyType = "ECDSA_SECP256K1"
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				if request.URL.Path != "/api/v1/accounts/0.0.1234" {
+					http.NotFound(writer, request)
+					return
+				}
+				_ = json.NewEncoder(writer).Encode(map[string]any{
+					"account": "0.0.1234",
+					"key":     map[string]any{"_type": keyType, "key": hex.EncodeToString(privateKey.PublicKey().BytesRaw())},
+				})
+			}))
+			defer server.Close()
+
+			uaid, err := CreateUAIDAID(CanonicalAgentData{
+				Registry: "hol",
+				Name:     "Signer",
+				Version:  "1.0.0",
+				Protocol: "hcs-10",
+				NativeID: "hedera:testnet:0.0.1234",
+			}, RoutingParams{Proto: "hcs-10"}, true)
+			if err != nil {
+				t.Fatalf("CreateUAIDAID failed: %v", err)
+			}
+			client := NewClient(ClientOptions{
+				DIDHedera: DIDHederaResolverOptions{MirrorClients: newTestDIDHederaResolver(t, server.URL).mirrorClients},
+			})
+
+			payload := []byte(`{"nonce":"abc123"}`)
+			jws, err := SignChallenge(context.Background(), uaid, shared.NewLocalSigner(privateKey), payload)
+			if err != nil {
+				t.Fatalf("SignChallenge failed: %v", err)
+			}
+			if strings.Count(jws, ".") != 2 {
+				t.Fatalf("expected compact JWS, got %s", jws)
+			}
+
+			verified, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, jws)
+			if err != nil {
+				t.Fatalf("VerifyUAIDSignature failed: %v", err)
+			}
+			if verified.VerificationMethod.ID != "did:hedera:testnet:0.0.1234#did-root-key" {
+				t.Fatalf("unexpected method: %+v", verified.VerificationMethod)
+			}
+
+			if _, err := client.VerifyUAIDSignature(context.Background(), uaid, []byte("tampered"), jws); !errors.Is(err, ErrUAIDSignatureInvalid) {
+				t.Fatalf("expected payload mismatch, got %v", err)
+			}
+			otherKey, _ := generate()
+			forged, _ := SignChallenge(context.Background(), uaid, shared.NewLocalSigner(otherKey), payload)
+			if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, forged); !errors.Is(err, ErrUAIDSignatureInvalid) {
+				t.Fatalf("expected invalid signature, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUAIDSignatureWithDIDKey(t *testing.T) {
+	privateKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	did := "did:key:" + encodeMulticodecKey(multicodecEd25519Pub, privateKey.PublicKey().BytesRaw())
+	uaid, err := CreateUAIDFromDID(did, RoutingParams{Src: "z" + base58Encode([]byte(did))})
+	if err != nil {
+		t.Fatalf("CreateUAIDFromDID failed: %v", err)
+	}
+	client := NewClient(ClientOptions{})
+
+	payload := []byte("challenge")
+	parts := strings.Split(mustSignChallenge(t, uaid, privateKey, payload, did+"#missing"), ".")
+	detached := parts[0] + ".." + parts[2]
+	if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, detached); err != nil {
+		t.Fatalf("expected detached JWS to verify: %v", err)
+	}
+
+	otherUAID, _ := CreateUAIDFromDID(did, RoutingParams{Src: "z" + base58Encode([]byte(did)), Proto: "a2a"})
+	if _, err := client.VerifyUAIDSignature(context.Background(), otherUAID, payload, detached); !errors.Is(err, ErrUAIDSignatureInvalid) {
+		t.Fatalf("expected UAID mismatch, got %v", err)
+	}
+}
+
+type staticDIDResolver struct {
+	document *DIDDocument
+	err      error
+}
+
+func (resolver *staticDIDResolver) Supports(did string) bool {
+	return strings.HasPrefix(did, "did:web:")
+}
+
+func (resolver *staticDIDResolver) Resolve(context.Context, string) (*DIDDocument, error) {
+	return resolver.document, resolver.err
+}
+
+func TestUAIDSignatureRequiresAuthenticationMethod(t *testing.T) {
+	privateKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	did := "did:web:agent.example.com"
+	uaid, err := CreateUAIDFromDID(did, RoutingParams{Src: "z" + base58Encode([]byte(did))})
+	if err != nil {
+		t.Fatalf("CreateUAIDFromDID failed: %v", err)
+	}
+	resolver := &staticDIDResolver{document: &DIDDocument{
+		ID: did,
+		VerificationMethod: []DIDVerificationMethod{{
+			ID:                 did + "#key-agreement",
+			Type:               ed25519VerificationKeyType,
+			Controller:         did,
+			PublicKeyMultibase: encodeMulticodecKey(multicodecEd25519Pub, privateKey.PublicKey().BytesRaw()),
+		}},
+	}}
+	registry := NewResolverRegistry()
+	registry.RegisterDIDResolver(resolver)
+	client := NewClient(ClientOptions{Registry: registry, DisableDefaultDIDResolvers: true})
+
+	payload := []byte("challenge")
+	jws := mustSignChallenge(t, uaid, privateKey, payload, "")
+	if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, jws); !errors.Is(err, ErrUAIDSignatureInvalid) {
+		t.Fatalf("expected key agreement key to be rejected, got %v", err)
+	}
+
+	resolver.document.AssertionMethod = []string{did + "#key-agreement"}
+	if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, jws); !errors.Is(err, ErrUAIDSignatureInvalid) {
+		t.Fatalf("expected assertion-only key to be rejected, got %v", err)
+	}
+
+	resolver.document.Authentication = []string{did + "#key-agreement"}
+	if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, jws); err != nil {
+		t.Fatalf("expected authentication key to verify: %v", err)
+	}
+
+	resolver.err = errors.New("agent.example.com unreachable")
+	if _, err := client.VerifyUAIDSignature(context.Background(), uaid, payload, jws); err == nil ||
+		!strings.Contains(err.Error(), "unreachable") {
+		t.Fatalf("expected resolver error to surface, got %v", err)
+	}
+}
+
+func TestVerifyJWSSignatureRejectsHighS(t *testing.T) {
+	privateKey, err := hedera.PrivateKeyGenerateEcdsa()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	uaid := "uaid:did:z6Mkexample;uid=0;proto=hcs-10;nativeId=hedera:testnet:0.0.1234"
+	jws, err := SignChallenge(context.Background(), uaid, shared.NewLocalSigner(privateKey), []byte("challenge"))
+	if err != nil {
+		t.Fatalf("SignChallenge failed: %v", err)
+	}
+	header, signingInput, signature, err := parseUAIDJWS(jws, []byte("challenge"))
+	if err != nil {
+		t.Fatalf("parseUAIDJWS failed: %v", err)
+	}
+	publicKey := privateKey.PublicKey().BytesRaw()
+	if !verifyJWSSignature(header.Algorithm, multicodecSecp256k1Pub, publicKey, signingInput, signature) {
+		t.Fatal("expected low-S signature to verify")
+	}
+
+	var s btcec.ModNScalar
+	s.SetByteSlice(signature[32:])
+	s.Negate()
+	highS := s.Bytes()
+	malleated := append(append([]byte{}, signature[:32]...), highS[:]...)
+	if verifyJWSSignature(header.Algorithm, multicodecSecp256k1Pub, publicKey, signingInput, malleated) {
+		t.Fatal("expected high-S signature to be rejected")
+	}
+}
+
+func mustSignChallenge(t *testing.T, uaid string, privateKey hedera.PrivateKey, payload []byte, keyID string) string {
+	t.Helper()
+	jws, err := SignChallengeWithKeyID(context.Background(), uaid, shared.NewLocalSigner(privateKey), payload, keyID)
+	if err != nil {
+		t.Fatalf("SignChallengeWithKeyID failed: %v", err)
+	}
+	return jws
+}
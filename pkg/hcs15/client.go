@@ -13,6 +13,7 @@ import (
 
 type Client struct {
 	hederaClient *hedera.Client
+	executor     *shared.Executor
 	mirrorClient *mirror.Client
 }
 
@@ -23,11 +24,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
-	hederaClient, _, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -44,6 +46,7 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient: hederaClient,
+		executor:     config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient: mirrorClient,
 	}, nil
 }
@@ -58,18 +61,19 @@ func (c *Client) MirrorClient() *mirror.Client {
 	return c.mirrorClient
 }
 
-// CreateBaseAccount creates the requested resource.
+// CreateBaseAccount creates an HCS-15 base account. The key comes from
+// options.PublicKey, options.Mnemonic or options.Signer, or is generated when
+// none is set. When the key can sign, the creation is signed with it and the
+// account gets the key's EVM address as its alias.
 func (c *Client) CreateBaseAccount(
 	ctx context.Context,
 	options BaseAccountCreateOptions,
 ) (BaseAccountCreateResult, error) {
-	_ = ctx
-
-	privateKey, err := hedera.PrivateKeyGenerateEcdsa()
+	baseKey, err := resolveBaseAccountKey(options)
 	if err != nil {
-		return BaseAccountCreateResult{}, fmt.Errorf("failed to generate ecdsa private key: %w", err)
+		return BaseAccountCreateResult{}, err
 	}
-	publicKey := privateKey.PublicKey()
+	publicKey := baseKey.publicKey
 
 	initialBalance := options.InitialBalanceHbar
 	if initialBalance <= 0 {
@@ -78,6 +82,7 @@ func (c *Client) CreateBaseAccount(
 
 	transaction, err := BuildBaseAccountCreateTx(BaseAccountCreateTxParams{
 		PublicKey:                     publicKey,
+		OmitAlias:                     !baseKey.canSign(),
 		InitialBalanceHbar:            initialBalance,
 		MaxAutomaticTokenAssociations: options.MaxAutomaticTokenAssociations,
 		AccountMemo:                   options.AccountMemo,
@@ -87,45 +92,53 @@ func (c *Client) CreateBaseAccount(
 		return BaseAccountCreateResult{}, err
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return BaseAccountCreateResult{}, fmt.Errorf("failed to execute base account create transaction: %w", err)
+	if baseKey.canSign() {
+		if _, err := transaction.FreezeWith(c.hederaClient); err != nil {
+			return BaseAccountCreateResult{}, fmt.Errorf("failed to freeze base account create transaction: %w", err)
+		}
+		if baseKey.privateKey != nil {
+			transaction.Sign(*baseKey.privateKey)
+		} else {
+			if err := shared.SignTransaction(ctx, transaction, baseKey.signer); err != nil {
+				return BaseAccountCreateResult{}, err
+			}
+		}
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
-		return BaseAccountCreateResult{}, fmt.Errorf("failed to retrieve base account create receipt: %w", err)
+		return BaseAccountCreateResult{}, fmt.Errorf("failed to execute base account create transaction: %w", err)
 	}
+	receipt := executed.Receipt
 	if receipt.AccountID == nil {
 		return BaseAccountCreateResult{}, fmt.Errorf("HCS-15 BASE_ACCOUNT_CREATE_FAILED")
 	}
 
-	return BaseAccountCreateResult{
-		AccountID:     receipt.AccountID.String(),
-		PrivateKey:    privateKey,
-		PrivateKeyRaw: privateKey.StringRaw(),
-		PublicKey:     publicKey,
-		EVMAddress:    normalizeEVMAddress(publicKey.ToEvmAddress()),
-		Receipt:       receipt,
-	}, nil
+	result := BaseAccountCreateResult{
+		AccountID: receipt.AccountID.String(),
+		PublicKey: publicKey,
+		Receipt:   receipt,
+	}
+	if baseKey.privateKey != nil {
+		result.PrivateKey = *baseKey.privateKey
+		result.PrivateKeyRaw = baseKey.privateKey.StringRaw()
+	}
+	if baseKey.canSign() {
+		result.EVMAddress = normalizeEVMAddress(publicKey.ToEvmAddress())
+	}
+	return result, nil
 }
 
-// CreatePetalAccount creates the requested resource.
+// CreatePetalAccount creates a petal account sharing the base account's key,
+// taken from options.BaseSigner, options.BasePublicKey or options.BasePrivateKey.
 func (c *Client) CreatePetalAccount(
 	ctx context.Context,
 	options PetalAccountCreateOptions,
 ) (PetalAccountCreateResult, error) {
-	_ = ctx
-
-	basePrivateKey := strings.TrimSpace(options.BasePrivateKey)
-	if basePrivateKey == "" {
-		return PetalAccountCreateResult{}, fmt.Errorf("base private key is required")
-	}
-
-	parsedBaseKey, err := hedera.PrivateKeyFromStringECDSA(basePrivateKey)
+	publicKey, err := resolvePetalBasePublicKey(options)
 	if err != nil {
-		return PetalAccountCreateResult{}, fmt.Errorf("invalid base private key: %w", err)
+		return PetalAccountCreateResult{}, err
 	}
-	publicKey := parsedBaseKey.PublicKey()
 
 	initialBalance := options.InitialBalanceHbar
 	if initialBalance <= 0 {
@@ -143,14 +156,11 @@ func (c *Client) CreatePetalAccount(
 		return PetalAccountCreateResult{}, err
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return PetalAccountCreateResult{}, fmt.Errorf("failed to execute petal account create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return PetalAccountCreateResult{}, fmt.Errorf("failed to retrieve petal account create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.AccountID == nil {
 		return PetalAccountCreateResult{}, fmt.Errorf("HCS-15 PETAL_ACCOUNT_CREATE_FAILED")
 	}
@@ -1,7 +1,17 @@
 // Package hcs15 implements the HCS-15 Base/Petal Account specification for the
 // Hedera Consensus Service (HCS). It provides base and petal account creation,
 // transaction builders, and key verification helpers for hierarchical account
-// structures on the Hedera public ledger.
+// structures on the Hedera public ledger. ListPetals finds the petals sharing a
+// base key through the mirror node, TransferBetweenPetals moves HBAR between
+// them, and RotateBaseKey moves the base and every petal to a new key, either
+// immediately, rolling back if any update fails, or as schedules that execute
+// together. Both accept a Signer in
+// place of a private key, and a rotation only needs the new public key when the
+// new key's holder signs the schedules separately.
+//
+// Base accounts can be created for an existing public key, a key derived from
+// a BIP-39 mnemonic, or a Signer backed by a KMS or HSM, so the private key
+// never has to be held by the process.
 //
 // HCS-15 defines a standard for creating hierarchical account relationships
 // where a base account can spawn and manage petal accounts, enabling structured
@@ -0,0 +1,343 @@
+package hcs15
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+// PetalSet is a mirror node snapshot of a base account and the petal accounts
+// sharing its key. Call ListPetals again to refresh memos and balances.
+type PetalSet struct {
+	BaseAccountID string
+	PublicKey     string
+	Base          PetalAccount
+	Petals        []PetalAccount
+}
+
+// AccountIDs returns the petal account IDs in mirror node order.
+func (set *PetalSet) AccountIDs() []string {
+	accountIDs := make([]string, 0, len(set.Petals))
+	for _, petal := range set.Petals {
+		accountIDs = append(accountIDs, petal.AccountID)
+	}
+	return accountIDs
+}
+
+// Get returns the petal with the given account ID.
+func (set *PetalSet) Get(accountID string) (PetalAccount, bool) {
+	trimmed := strings.TrimSpace(accountID)
+	for _, petal := range set.Petals {
+		if petal.AccountID == trimmed {
+			return petal, true
+		}
+	}
+	return PetalAccount{}, false
+}
+
+// ByMemo returns the petals whose account memo equals memo.
+func (set *PetalSet) ByMemo(memo string) []PetalAccount {
+	trimmed := strings.TrimSpace(memo)
+	matches := make([]PetalAccount, 0)
+	for _, petal := range set.Petals {
+		if petal.Memo == trimmed {
+			matches = append(matches, petal)
+		}
+	}
+	return matches
+}
+
+// TotalBalanceTinybars returns the combined balance of the petals, excluding the base account.
+func (set *PetalSet) TotalBalanceTinybars() int64 {
+	var total int64
+	for _, petal := range set.Petals {
+		total += petal.BalanceTinybars
+	}
+	return total
+}
+
+// ListPetals looks up the accounts that share the base account's public key.
+// Deleted accounts and the base account itself are not listed as petals.
+func (c *Client) ListPetals(ctx context.Context, baseAccountID string) (*PetalSet, error) {
+	baseAccountID = strings.TrimSpace(baseAccountID)
+	if baseAccountID == "" {
+		return nil, fmt.Errorf("base account ID is required")
+	}
+
+	baseInfo, err := c.mirrorClient.GetAccount(ctx, baseAccountID)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := extractMirrorKey(baseInfo.Key)
+	if publicKey == "" {
+		return nil, fmt.Errorf("base account %s has no public key", baseAccountID)
+	}
+
+	accounts, err := c.mirrorClient.GetAccountsByPublicKey(ctx, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list petal accounts: %w", err)
+	}
+
+	set := &PetalSet{
+		BaseAccountID: baseAccountID,
+		PublicKey:     publicKey,
+		Base:          toPetalAccount(baseInfo),
+		Petals:        make([]PetalAccount, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		if account.Deleted {
+			continue
+		}
+		if account.Account == baseAccountID {
+			set.Base = toPetalAccount(account)
+			continue
+		}
+		if accountKey := extractMirrorKey(account.Key); accountKey != "" && accountKey != publicKey {
+			continue
+		}
+		set.Petals = append(set.Petals, toPetalAccount(account))
+	}
+	return set, nil
+}
+
+// TransferBetweenPetals moves HBAR from one petal to another, signing with the
+// shared base key from options.BaseSigner or options.BasePrivateKey. Either
+// side may also be the base account.
+func (c *Client) TransferBetweenPetals(
+	ctx context.Context,
+	options TransferBetweenPetalsOptions,
+) (TransferBetweenPetalsResult, error) {
+	fromAccountID, err := hedera.AccountIDFromString(strings.TrimSpace(options.FromAccountID))
+	if err != nil {
+		return TransferBetweenPetalsResult{}, fmt.Errorf("invalid source account ID: %w", err)
+	}
+	toAccountID, err := hedera.AccountIDFromString(strings.TrimSpace(options.ToAccountID))
+	if err != nil {
+		return TransferBetweenPetalsResult{}, fmt.Errorf("invalid destination account ID: %w", err)
+	}
+	baseSigner, err := resolveBaseSigner("base", options.BasePrivateKey, options.BaseSigner)
+	if err != nil {
+		return TransferBetweenPetalsResult{}, err
+	}
+
+	transaction, err := BuildPetalTransferTx(PetalTransferTxParams{
+		FromAccountID:   fromAccountID,
+		ToAccountID:     toAccountID,
+		AmountTinybars:  options.AmountTinybars,
+		TransactionMemo: options.TransactionMemo,
+	})
+	if err != nil {
+		return TransferBetweenPetalsResult{}, err
+	}
+
+	frozen, err := transaction.FreezeWith(c.hederaClient)
+	if err != nil {
+		return TransferBetweenPetalsResult{}, fmt.Errorf("failed to freeze petal transfer transaction: %w", err)
+	}
+	if err := shared.SignTransaction(ctx, frozen, baseSigner); err != nil {
+		return TransferBetweenPetalsResult{}, fmt.Errorf("failed to sign petal transfer transaction: %w", err)
+	}
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozen)
+	if err != nil {
+		return TransferBetweenPetalsResult{}, fmt.Errorf("failed to execute petal transfer transaction: %w", err)
+	}
+
+	return TransferBetweenPetalsResult{
+		TransactionID: executed.TransactionID,
+		Receipt:       executed.Receipt,
+	}, nil
+}
+
+// RotateBaseKey replaces the key of the base account and every petal listed
+// by ListPetals. Petals are updated before the base account. When ExecuteAt is
+// set each update is scheduled to run at that time instead, so the accounts
+// switch keys together.
+//
+// Hedera requires key changes to be signed by both the current and the new
+// key. The current key signs through options.CurrentSigner or
+// options.CurrentPrivateKey and the new key through options.NewSigner. When
+// the new key is only given as options.NewPublicKey the updates must be
+// scheduled, and its holder signs each returned schedule before ExecuteAt.
+//
+// The base account's EVM alias is derived from the original key and does not
+// change. If an immediate update fails, the accounts already rotated are
+// switched back to the current key in reverse order and listed in
+// RolledBack; Updates then holds only the accounts left on the new key,
+// which is none unless a rollback also failed. Schedules created before a
+// scheduled update fails cannot be withdrawn and are returned in Updates
+// alongside the error.
+func (c *Client) RotateBaseKey(ctx context.Context, options RotateBaseKeyOptions) (RotateBaseKeyResult, error) {
+	currentSigner, err := resolveBaseSigner("current", options.CurrentPrivateKey, options.CurrentSigner)
+	if err != nil {
+		return RotateBaseKeyResult{}, err
+	}
+	newPublicKey, err := resolveNewBasePublicKey(options)
+	if err != nil {
+		return RotateBaseKeyResult{}, err
+	}
+	if !options.ExecuteAt.IsZero() && !options.ExecuteAt.After(time.Now()) {
+		return RotateBaseKeyResult{}, fmt.Errorf("execution time must be in the future")
+	}
+	if options.NewSigner == nil && options.ExecuteAt.IsZero() {
+		return RotateBaseKeyResult{}, fmt.Errorf("a new key given only as a public key requires a scheduled rotation")
+	}
+
+	set, err := c.ListPetals(ctx, options.BaseAccountID)
+	if err != nil {
+		return RotateBaseKeyResult{}, err
+	}
+	if !strings.EqualFold(strings.TrimPrefix(set.PublicKey, "0x"), currentSigner.PublicKey().StringRaw()) {
+		return RotateBaseKeyResult{}, fmt.Errorf("current key does not control base account %s", set.BaseAccountID)
+	}
+
+	rotate := func(accountID string) (KeyRotationUpdate, error) {
+		return c.submitKeyUpdate(ctx, accountID, currentSigner, options.NewSigner, newPublicKey, options)
+	}
+	var rollback func(accountID string) (KeyRotationUpdate, error)
+	if options.ExecuteAt.IsZero() {
+		rollback = func(accountID string) (KeyRotationUpdate, error) {
+			return c.submitKeyUpdate(ctx, accountID, options.NewSigner, currentSigner, currentSigner.PublicKey(), options)
+		}
+	}
+	return rotateAccounts(
+		RotateBaseKeyResult{NewPublicKey: newPublicKey},
+		append(set.AccountIDs(), set.BaseAccountID),
+		rotate,
+		rollback,
+	)
+}
+
+// rotateAccounts rotates each account in order. When one fails and rollback
+// is set, the accounts already rotated are restored in reverse order.
+func rotateAccounts(
+	result RotateBaseKeyResult,
+	accountIDs []string,
+	rotate func(accountID string) (KeyRotationUpdate, error),
+	rollback func(accountID string) (KeyRotationUpdate, error),
+) (RotateBaseKeyResult, error) {
+	result.Updates = make([]KeyRotationUpdate, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		update, err := rotate(accountID)
+		if err == nil {
+			result.Updates = append(result.Updates, update)
+			continue
+		}
+		if rollback == nil {
+			return result, err
+		}
+
+		errs := []error{err}
+		kept := make([]KeyRotationUpdate, 0)
+		for index := len(result.Updates) - 1; index >= 0; index-- {
+			rotated := result.Updates[index]
+			restored, rollbackErr := rollback(rotated.AccountID)
+			if rollbackErr != nil {
+				errs = append(errs, fmt.Errorf("failed to roll back key update for %s: %w", rotated.AccountID, rollbackErr))
+				kept = append([]KeyRotationUpdate{rotated}, kept...)
+				continue
+			}
+			result.RolledBack = append(result.RolledBack, restored)
+		}
+		result.Updates = kept
+		return result, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+func (c *Client) submitKeyUpdate(
+	ctx context.Context,
+	accountID string,
+	currentSigner shared.Signer,
+	newSigner shared.Signer,
+	newPublicKey hedera.PublicKey,
+	options RotateBaseKeyOptions,
+) (KeyRotationUpdate, error) {
+	parsedAccountID, err := hedera.AccountIDFromString(accountID)
+	if err != nil {
+		return KeyRotationUpdate{}, fmt.Errorf("invalid account ID %s: %w", accountID, err)
+	}
+	params := AccountKeyUpdateTxParams{
+		AccountID:       parsedAccountID,
+		NewPublicKey:    newPublicKey,
+		TransactionMemo: options.TransactionMemo,
+	}
+
+	if options.ExecuteAt.IsZero() {
+		transaction, err := BuildAccountKeyUpdateTx(params)
+		if err != nil {
+			return KeyRotationUpdate{}, err
+		}
+		frozen, err := transaction.FreezeWith(c.hederaClient)
+		if err != nil {
+			return KeyRotationUpdate{}, fmt.Errorf("failed to freeze key update for %s: %w", accountID, err)
+		}
+		if err := signKeyUpdate(ctx, frozen, currentSigner, newSigner); err != nil {
+			return KeyRotationUpdate{}, fmt.Errorf("failed to sign key update for %s: %w", accountID, err)
+		}
+		executed, err := c.executor.Execute(ctx, c.hederaClient, frozen)
+		if err != nil {
+			return KeyRotationUpdate{}, fmt.Errorf("failed to execute key update for %s: %w", accountID, err)
+		}
+		return KeyRotationUpdate{AccountID: accountID, TransactionID: executed.TransactionID}, nil
+	}
+
+	transaction, err := BuildScheduledAccountKeyUpdateTx(params, options.ExecuteAt, options.ScheduleMemo)
+	if err != nil {
+		return KeyRotationUpdate{}, err
+	}
+	frozen, err := transaction.FreezeWith(c.hederaClient)
+	if err != nil {
+		return KeyRotationUpdate{}, fmt.Errorf("failed to freeze scheduled key update for %s: %w", accountID, err)
+	}
+	if err := signKeyUpdate(ctx, frozen, currentSigner, newSigner); err != nil {
+		return KeyRotationUpdate{}, fmt.Errorf("failed to sign scheduled key update for %s: %w", accountID, err)
+	}
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozen)
+	if err != nil {
+		return KeyRotationUpdate{}, fmt.Errorf("failed to schedule key update for %s: %w", accountID, err)
+	}
+	receipt := executed.Receipt
+	if receipt.ScheduleID == nil {
+		return KeyRotationUpdate{}, fmt.Errorf("HCS-15 KEY_ROTATION_SCHEDULE_FAILED")
+	}
+	return KeyRotationUpdate{
+		AccountID:     accountID,
+		TransactionID: executed.TransactionID,
+		ScheduleID:    receipt.ScheduleID.String(),
+	}, nil
+}
+
+// signKeyUpdate signs a frozen key update with the current key and, when
+// available, the new key.
+func signKeyUpdate[T interface {
+	GetSignableNodeBodyBytesList() ([]hedera.SignableNodeTransactionBodyBytes, error)
+	AddSignatureV2(hedera.PublicKey, []byte, hedera.TransactionID, hedera.AccountID) (T, error)
+}](ctx context.Context, transaction T, currentSigner shared.Signer, newSigner shared.Signer) error {
+	if err := shared.SignTransaction(ctx, transaction, currentSigner); err != nil {
+		return err
+	}
+	if newSigner == nil {
+		return nil
+	}
+	return shared.SignTransaction(ctx, transaction, newSigner)
+}
+
+func toPetalAccount(info mirror.AccountInfo) PetalAccount {
+	petal := PetalAccount{
+		AccountID:        info.Account,
+		Memo:             info.Memo,
+		EVMAddress:       info.EVMAddress,
+		CreatedTimestamp: info.CreatedTimestamp,
+	}
+	if info.Balance != nil {
+		petal.BalanceTinybars = info.Balance.Balance
+	}
+	return petal
+}
@@ -0,0 +1,187 @@
+package hcs15
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func newPetalMirrorClient(t *testing.T, publicKey string) *Client {
+	t.Helper()
+	key := map[string]any{"_type": "ECDSA_SECP256K1", "key": publicKey}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/api/v1/accounts/0.0.100":
+			_ = json.NewEncoder(writer).Encode(map[string]any{"account": "0.0.100", "key": key})
+		case "/api/v1/accounts":
+			if request.URL.Query().Get("account.publickey") != publicKey {
+				t.Errorf("unexpected public key filter: %s", request.URL.RawQuery)
+			}
+			_ = json.NewEncoder(writer).Encode(map[string]any{
+				"accounts": []any{
+					map[string]any{"account": "0.0.100", "key": key, "balance": map[string]any{"balance": 900}},
+					map[string]any{"account": "0.0.101", "key": key, "memo": "treasury", "balance": map[string]any{"balance": 300}},
+					map[string]any{"account": "0.0.102", "key": key, "memo": "ops", "balance": map[string]any{"balance": 200}},
+					map[string]any{"account": "0.0.103", "key": key, "memo": "ops", "deleted": true},
+				},
+				"links": map[string]any{"next": nil},
+			})
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	mirrorClient, err := mirror.NewClient(mirror.Config{Network: "testnet", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create mirror client: %v", err)
+	}
+	return &Client{mirrorClient: mirrorClient}
+}
+
+func TestListPetals(t *testing.T) {
+	client := newPetalMirrorClient(t, "02abc")
+
+	set, err := client.ListPetals(t.Context(), "0.0.100")
+	if err != nil {
+		t.Fatalf("ListPetals failed: %v", err)
+	}
+	if set.Base.BalanceTinybars != 900 {
+		t.Fatalf("unexpected base account: %+v", set.Base)
+	}
+	accountIDs := set.AccountIDs()
+	if len(accountIDs) != 2 || accountIDs[0] != "0.0.101" || accountIDs[1] != "0.0.102" {
+		t.Fatalf("unexpected petals: %v", accountIDs)
+	}
+	if set.TotalBalanceTinybars() != 500 {
+		t.Fatalf("unexpected total balance: %d", set.TotalBalanceTinybars())
+	}
+	if petals := set.ByMemo("ops"); len(petals) != 1 || petals[0].AccountID != "0.0.102" {
+		t.Fatalf("unexpected memo lookup: %+v", petals)
+	}
+	if petal, ok := set.Get("0.0.101"); !ok || petal.Memo != "treasury" {
+		t.Fatalf("unexpected petal: %+v", petal)
+	}
+}
+
+func newTestECDSASigner(t *testing.T) shared.Signer {
+	t.Helper()
+	privateKey, err := hedera.PrivateKeyGenerateEcdsa()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return shared.NewLocalSigner(privateKey)
+}
+
+func TestRotateBaseKeyRejectsForeignKey(t *testing.T) {
+	client := newPetalMirrorClient(t, "02abc")
+
+	_, err := client.RotateBaseKey(t.Context(), RotateBaseKeyOptions{
+		BaseAccountID:     "0.0.100",
+		CurrentPrivateKey: "3030020100300706052b8104000a042204208776c6b831a1b61ac10dac0304a2843de4716f54b1919bb91a2685273fe2f8c2",
+		NewSigner:         newTestECDSASigner(t),
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not control") {
+		t.Fatalf("expected error when current key does not match the base account, got %v", err)
+	}
+}
+
+func TestRotateBaseKeyValidatesKeys(t *testing.T) {
+	client := newPetalMirrorClient(t, "02abc")
+	current := newTestECDSASigner(t)
+	next := newTestECDSASigner(t)
+	edKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	cases := map[string]RotateBaseKeyOptions{
+		"missing current key": {NewSigner: next},
+		"both current keys":   {CurrentSigner: current, CurrentPrivateKey: "302e", NewSigner: next},
+		"missing new key":     {CurrentSigner: current},
+		"ed25519 new signer":  {CurrentSigner: current, NewSigner: shared.NewLocalSigner(edKey)},
+		"mismatched new key": {
+			CurrentSigner: current,
+			NewSigner:     next,
+			NewPublicKey:  current.PublicKey().StringRaw(),
+		},
+		"unscheduled public key": {CurrentSigner: current, NewPublicKey: next.PublicKey().StringRaw()},
+		"past execution": {
+			CurrentSigner: current,
+			NewPublicKey:  next.PublicKey().StringRaw(),
+			ExecuteAt:     time.Now().Add(-time.Minute),
+		},
+	}
+	for name, options := range cases {
+		t.Run(name, func(t *testing.T) {
+			options.BaseAccountID = "0.0.100"
+			if _, err := client.RotateBaseKey(t.Context(), options); err == nil {
+				t.Fatal("expected validation error")
+			}
+		})
+	}
+}
+
+func TestRotateAccountsRollsBackOnFailure(t *testing.T) {
+	rotate := func(accountID string) (KeyRotationUpdate, error) {
+		if accountID == "0.0.100" {
+			return KeyRotationUpdate{}, errors.New("base update rejected")
+		}
+		return KeyRotationUpdate{AccountID: accountID, TransactionID: "rotate-" + accountID}, nil
+	}
+	rolledBack := make([]string, 0)
+	rollback := func(accountID string) (KeyRotationUpdate, error) {
+		rolledBack = append(rolledBack, accountID)
+		if accountID == "0.0.101" {
+			return KeyRotationUpdate{}, errors.New("rollback rejected")
+		}
+		return KeyRotationUpdate{AccountID: accountID, TransactionID: "restore-" + accountID}, nil
+	}
+	accountIDs := []string{"0.0.101", "0.0.102", "0.0.100"}
+
+	result, err := rotateAccounts(RotateBaseKeyResult{}, accountIDs, rotate, rollback)
+	if err == nil || !strings.Contains(err.Error(), "base update rejected") || !strings.Contains(err.Error(), "0.0.101") {
+		t.Fatalf("expected the update and rollback failures, got %v", err)
+	}
+	if strings.Join(rolledBack, ",") != "0.0.102,0.0.101" {
+		t.Fatalf("expected rollback in reverse order, got %v", rolledBack)
+	}
+	if len(result.RolledBack) != 1 || result.RolledBack[0].AccountID != "0.0.102" {
+		t.Fatalf("unexpected rolled back updates %+v", result.RolledBack)
+	}
+	if len(result.Updates) != 1 || result.Updates[0].AccountID != "0.0.101" {
+		t.Fatalf("expected only the account that failed to roll back to stay rotated, got %+v", result.Updates)
+	}
+
+	result, err = rotateAccounts(RotateBaseKeyResult{}, accountIDs, rotate, nil)
+	if err == nil || len(result.Updates) != 2 || len(result.RolledBack) != 0 {
+		t.Fatalf("expected scheduled updates to be reported without rollback, got %+v, %v", result, err)
+	}
+}
+
+func TestTransferBetweenPetalsRequiresECDSASigner(t *testing.T) {
+	client := newPetalMirrorClient(t, "02abc")
+	edKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	_, err = client.TransferBetweenPetals(t.Context(), TransferBetweenPetalsOptions{
+		FromAccountID:  "0.0.101",
+		ToAccountID:    "0.0.102",
+		AmountTinybars: 10,
+		BaseSigner:     shared.NewLocalSigner(edKey),
+	})
+	if err == nil || !strings.Contains(err.Error(), "ECDSA") {
+		t.Fatalf("expected ECDSA signer error, got %v", err)
+	}
+}
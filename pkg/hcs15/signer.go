@@ -0,0 +1,160 @@
+package hcs15
+
+import (
+	"fmt"
+	"strings"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+// baseAccountKey is the key a base account is created for, together with
+// whatever can sign for it.
+type baseAccountKey struct {
+	publicKey  hedera.PublicKey
+	privateKey *hedera.PrivateKey
+	signer     shared.Signer
+}
+
+func (key baseAccountKey) canSign() bool {
+	return key.privateKey != nil || key.signer != nil
+}
+
+func resolveBaseAccountKey(options BaseAccountCreateOptions) (baseAccountKey, error) {
+	publicKey := strings.TrimSpace(options.PublicKey)
+	mnemonic := strings.TrimSpace(options.Mnemonic)
+
+	sources := 0
+	for _, set := range []bool{publicKey != "", mnemonic != "", options.Signer != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return baseAccountKey{}, fmt.Errorf("only one of public key, mnemonic and signer may be set")
+	}
+
+	switch {
+	case publicKey != "":
+		parsed, err := hedera.PublicKeyFromStringECDSA(publicKey)
+		if err != nil {
+			return baseAccountKey{}, fmt.Errorf("invalid base public key: %w", err)
+		}
+		return baseAccountKey{publicKey: parsed}, nil
+	case mnemonic != "":
+		privateKey, err := privateKeyFromMnemonic(mnemonic, options.MnemonicPassphrase, options.DerivationPath)
+		if err != nil {
+			return baseAccountKey{}, err
+		}
+		return baseAccountKey{publicKey: privateKey.PublicKey(), privateKey: &privateKey}, nil
+	case options.Signer != nil:
+		signerKey := options.Signer.PublicKey()
+		if !isECDSAPublicKey(signerKey) {
+			return baseAccountKey{}, fmt.Errorf("base account signer must hold an ECDSA secp256k1 key")
+		}
+		return baseAccountKey{publicKey: signerKey, signer: options.Signer}, nil
+	}
+
+	privateKey, err := hedera.PrivateKeyGenerateEcdsa()
+	if err != nil {
+		return baseAccountKey{}, fmt.Errorf("failed to generate ecdsa private key: %w", err)
+	}
+	return baseAccountKey{publicKey: privateKey.PublicKey(), privateKey: &privateKey}, nil
+}
+
+func resolvePetalBasePublicKey(options PetalAccountCreateOptions) (hedera.PublicKey, error) {
+	if options.BaseSigner != nil {
+		signerKey := options.BaseSigner.PublicKey()
+		if !isECDSAPublicKey(signerKey) {
+			return hedera.PublicKey{}, fmt.Errorf("base signer must hold an ECDSA secp256k1 key")
+		}
+		return signerKey, nil
+	}
+	if basePublicKey := strings.TrimSpace(options.BasePublicKey); basePublicKey != "" {
+		parsed, err := hedera.PublicKeyFromStringECDSA(basePublicKey)
+		if err != nil {
+			return hedera.PublicKey{}, fmt.Errorf("invalid base public key: %w", err)
+		}
+		return parsed, nil
+	}
+
+	basePrivateKey := strings.TrimSpace(options.BasePrivateKey)
+	if basePrivateKey == "" {
+		return hedera.PublicKey{}, fmt.Errorf("base private key, public key or signer is required")
+	}
+	parsedBaseKey, err := hedera.PrivateKeyFromStringECDSA(basePrivateKey)
+	if err != nil {
+		return hedera.PublicKey{}, fmt.Errorf("invalid base private key: %w", err)
+	}
+	return parsedBaseKey.PublicKey(), nil
+}
+
+// resolveBaseSigner returns signer, or a local signer for privateKey. Base
+// keys are ECDSA secp256k1, so other signers are rejected. role names the key
+// in errors.
+func resolveBaseSigner(role string, privateKey string, signer shared.Signer) (shared.Signer, error) {
+	privateKey = strings.TrimSpace(privateKey)
+	switch {
+	case privateKey != "" && signer != nil:
+		return nil, fmt.Errorf("only one of %s private key and signer may be set", role)
+	case signer != nil:
+		if !isECDSAPublicKey(signer.PublicKey()) {
+			return nil, fmt.Errorf("%s signer must hold an ECDSA secp256k1 key", role)
+		}
+		return signer, nil
+	case privateKey != "":
+		parsed, err := hedera.PrivateKeyFromStringECDSA(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s private key: %w", role, err)
+		}
+		return shared.NewLocalSigner(parsed), nil
+	}
+	return nil, fmt.Errorf("%s private key or signer is required", role)
+}
+
+// resolveNewBasePublicKey returns the key a rotation moves to, taken from
+// options.NewSigner or options.NewPublicKey.
+func resolveNewBasePublicKey(options RotateBaseKeyOptions) (hedera.PublicKey, error) {
+	newPublicKey := strings.TrimSpace(options.NewPublicKey)
+	if options.NewSigner != nil {
+		signerKey := options.NewSigner.PublicKey()
+		if !isECDSAPublicKey(signerKey) {
+			return hedera.PublicKey{}, fmt.Errorf("new signer must hold an ECDSA secp256k1 key")
+		}
+		if newPublicKey != "" && !strings.EqualFold(strings.TrimPrefix(newPublicKey, "0x"), signerKey.StringRaw()) {
+			return hedera.PublicKey{}, fmt.Errorf("new public key does not match the new signer")
+		}
+		return signerKey, nil
+	}
+	if newPublicKey == "" {
+		return hedera.PublicKey{}, fmt.Errorf("new public key or signer is required")
+	}
+	parsed, err := hedera.PublicKeyFromStringECDSA(newPublicKey)
+	if err != nil {
+		return hedera.PublicKey{}, fmt.Errorf("invalid new public key: %w", err)
+	}
+	return parsed, nil
+}
+
+func privateKeyFromMnemonic(phrase string, passphrase string, derivationPath string) (hedera.PrivateKey, error) {
+	mnemonic, err := hedera.MnemonicFromString(phrase)
+	if err != nil {
+		return hedera.PrivateKey{}, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	var privateKey hedera.PrivateKey
+	if path := strings.TrimSpace(derivationPath); path != "" {
+		privateKey, err = mnemonic.ToStandardECDSAsecp256k1PrivateKeyCustomDerivationPath(passphrase, path)
+	} else {
+		privateKey, err = mnemonic.ToStandardECDSAsecp256k1PrivateKey(passphrase, 0)
+	}
+	if err != nil {
+		return hedera.PrivateKey{}, fmt.Errorf("failed to derive ecdsa private key from mnemonic: %w", err)
+	}
+	return privateKey, nil
+}
+
+func isECDSAPublicKey(publicKey hedera.PublicKey) bool {
+	return len(publicKey.BytesRaw()) == 33
+}
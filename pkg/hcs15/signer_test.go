@@ -0,0 +1,91 @@
+package hcs15
+
+import (
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func TestResolveBaseAccountKey(t *testing.T) {
+	generated, err := resolveBaseAccountKey(BaseAccountCreateOptions{})
+	if err != nil || generated.privateKey == nil || !generated.canSign() {
+		t.Fatalf("expected generated key, got %+v, %v", generated, err)
+	}
+
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	publicOnly, err := resolveBaseAccountKey(BaseAccountCreateOptions{PublicKey: privateKey.PublicKey().StringRaw()})
+	if err != nil {
+		t.Fatalf("resolveBaseAccountKey failed: %v", err)
+	}
+	if publicOnly.canSign() || publicOnly.publicKey.StringRaw() != privateKey.PublicKey().StringRaw() {
+		t.Fatalf("expected public-key-only base key, got %+v", publicOnly)
+	}
+
+	signer := shared.NewLocalSigner(privateKey)
+	external, err := resolveBaseAccountKey(BaseAccountCreateOptions{Signer: signer})
+	if err != nil || external.signer == nil || external.privateKey != nil {
+		t.Fatalf("expected signer-backed key, got %+v, %v", external, err)
+	}
+
+	if _, err := resolveBaseAccountKey(BaseAccountCreateOptions{PublicKey: "02ab", Signer: signer}); err == nil {
+		t.Fatalf("expected error when several key sources are set")
+	}
+	edKey, _ := hedera.PrivateKeyGenerateEd25519()
+	if _, err := resolveBaseAccountKey(BaseAccountCreateOptions{Signer: shared.NewLocalSigner(edKey)}); err == nil {
+		t.Fatalf("expected error for ed25519 signer")
+	}
+}
+
+func TestResolveBaseAccountKeyFromMnemonic(t *testing.T) {
+	mnemonic, err := hedera.GenerateMnemonic24()
+	if err != nil {
+		t.Fatalf("failed to generate mnemonic: %v", err)
+	}
+	expected, _ := mnemonic.ToStandardECDSAsecp256k1PrivateKey("secret", 0)
+
+	resolved, err := resolveBaseAccountKey(BaseAccountCreateOptions{
+		Mnemonic:           mnemonic.String(),
+		MnemonicPassphrase: "secret",
+	})
+	if err != nil {
+		t.Fatalf("resolveBaseAccountKey failed: %v", err)
+	}
+	if resolved.privateKey.StringRaw() != expected.StringRaw() {
+		t.Fatalf("unexpected derived key")
+	}
+
+	custom, err := resolveBaseAccountKey(BaseAccountCreateOptions{
+		Mnemonic:           mnemonic.String(),
+		MnemonicPassphrase: "secret",
+		DerivationPath:     "m/44'/3030'/0'/0/1",
+	})
+	if err != nil {
+		t.Fatalf("resolveBaseAccountKey with derivation path failed: %v", err)
+	}
+	if custom.privateKey.StringRaw() == expected.StringRaw() {
+		t.Fatalf("expected derivation path to select another key")
+	}
+
+	if _, err := resolveBaseAccountKey(BaseAccountCreateOptions{Mnemonic: "not a mnemonic"}); err == nil {
+		t.Fatalf("expected error for invalid mnemonic")
+	}
+}
+
+func TestResolvePetalBasePublicKey(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	for name, options := range map[string]PetalAccountCreateOptions{
+		"private key": {BasePrivateKey: privateKey.StringRaw()},
+		"public key":  {BasePublicKey: privateKey.PublicKey().StringRaw()},
+		"signer":      {BaseSigner: shared.NewLocalSigner(privateKey)},
+	} {
+		publicKey, err := resolvePetalBasePublicKey(options)
+		if err != nil {
+			t.Fatalf("%s: resolvePetalBasePublicKey failed: %v", name, err)
+		}
+		if publicKey.StringRaw() != privateKey.PublicKey().StringRaw() {
+			t.Fatalf("%s: unexpected public key", name)
+		}
+	}
+}
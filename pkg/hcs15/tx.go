@@ -3,6 +3,7 @@ package hcs15
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 )
@@ -20,9 +21,11 @@ func BuildBaseAccountCreateTx(params BaseAccountCreateTxParams) (*hedera.Account
 
 	transaction := hedera.NewAccountCreateTransaction().
 		SetKey(params.PublicKey).
-		SetAlias(params.PublicKey.ToEvmAddress()).
 		SetInitialBalance(hedera.NewHbar(initialBalance)).
 		SetTransactionMemo(normalizeMemo(params.TransactionMemo, HCS15BaseAccountCreateTransactionMemo))
+	if !params.OmitAlias {
+		transaction.SetAlias(params.PublicKey.ToEvmAddress())
+	}
 
 	if params.MaxAutomaticTokenAssociations != nil {
 		transaction.SetMaxAutomaticTokenAssociations(*params.MaxAutomaticTokenAssociations)
@@ -60,8 +63,65 @@ func BuildPetalAccountCreateTx(params PetalAccountCreateTxParams) (*hedera.Accou
 	return transaction, nil
 }
 
+// BuildPetalTransferTx builds an HBAR transfer between two accounts that share
+// the base key.
+func BuildPetalTransferTx(params PetalTransferTxParams) (*hedera.TransferTransaction, error) {
+	if params.AmountTinybars <= 0 {
+		return nil, fmt.Errorf("transfer amount must be positive")
+	}
+	if params.FromAccountID == params.ToAccountID {
+		return nil, fmt.Errorf("source and destination accounts must differ")
+	}
+
+	return hedera.NewTransferTransaction().
+		AddHbarTransfer(params.FromAccountID, hedera.HbarFromTinybar(-params.AmountTinybars)).
+		AddHbarTransfer(params.ToAccountID, hedera.HbarFromTinybar(params.AmountTinybars)).
+		SetTransactionMemo(normalizeMemo(params.TransactionMemo, HCS15PetalTransferTransactionMemo)), nil
+}
+
+// BuildAccountKeyUpdateTx builds an account update that replaces the account key.
+func BuildAccountKeyUpdateTx(params AccountKeyUpdateTxParams) (*hedera.AccountUpdateTransaction, error) {
+	if params.NewPublicKey.String() == "" {
+		return nil, fmt.Errorf("new public key is required")
+	}
+
+	return hedera.NewAccountUpdateTransaction().
+		SetAccountID(params.AccountID).
+		SetKey(params.NewPublicKey).
+		SetTransactionMemo(normalizeMemo(params.TransactionMemo, HCS15KeyRotationTransactionMemo)), nil
+}
+
+// BuildScheduledAccountKeyUpdateTx wraps a key update in a schedule that waits
+// until executeAt before running, so several accounts can switch keys together.
+func BuildScheduledAccountKeyUpdateTx(
+	params AccountKeyUpdateTxParams,
+	executeAt time.Time,
+	scheduleMemo string,
+) (*hedera.ScheduleCreateTransaction, error) {
+	if executeAt.IsZero() {
+		return nil, fmt.Errorf("execution time is required")
+	}
+	inner, err := BuildAccountKeyUpdateTx(params)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := hedera.NewScheduleCreateTransaction().SetScheduledTransaction(inner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule key update: %w", err)
+	}
+	transaction.
+		SetWaitForExpiry(true).
+		SetExpirationTime(executeAt).
+		SetScheduleMemo(normalizeMemo(scheduleMemo, HCS15KeyRotationTransactionMemo))
+	return transaction, nil
+}
+
 type BaseAccountCreateTxParams struct {
-	PublicKey                     hedera.PublicKey
+	PublicKey hedera.PublicKey
+	// OmitAlias skips the EVM alias, which the network only accepts when the
+	// transaction is signed by the aliased key.
+	OmitAlias                     bool
 	InitialBalanceHbar            float64
 	MaxAutomaticTokenAssociations *int32
 	AccountMemo                   string
@@ -76,6 +136,19 @@ type PetalAccountCreateTxParams struct {
 	TransactionMemo               string
 }
 
+type PetalTransferTxParams struct {
+	FromAccountID   hedera.AccountID
+	ToAccountID     hedera.AccountID
+	AmountTinybars  int64
+	TransactionMemo string
+}
+
+type AccountKeyUpdateTxParams struct {
+	AccountID       hedera.AccountID
+	NewPublicKey    hedera.PublicKey
+	TransactionMemo string
+}
+
 func normalizeMemo(value string, fallback string) string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -2,6 +2,7 @@ package hcs15
 
 import (
 	"testing"
+	"time"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 )
@@ -103,3 +104,64 @@ func TestBuildPetalAccountCreateTx_WithMemoOverride(t *testing.T) {
 		t.Fatalf("expected memo override, got %s", transaction.GetTransactionMemo())
 	}
 }
+
+func TestBuildPetalTransferTx(t *testing.T) {
+	from := hedera.AccountID{Account: 1001}
+	to := hedera.AccountID{Account: 1002}
+	transaction, err := BuildPetalTransferTx(PetalTransferTxParams{
+		FromAccountID:  from,
+		ToAccountID:    to,
+		AmountTinybars: 500,
+	})
+	if err != nil {
+		t.Fatalf("BuildPetalTransferTx failed: %v", err)
+	}
+	transfers := transaction.GetHbarTransfers()
+	if transfers[from].AsTinybar() != -500 || transfers[to].AsTinybar() != 500 {
+		t.Fatalf("unexpected transfers: %v", transfers)
+	}
+	if transaction.GetTransactionMemo() != HCS15PetalTransferTransactionMemo {
+		t.Fatalf("unexpected transaction memo: %s", transaction.GetTransactionMemo())
+	}
+
+	if _, err := BuildPetalTransferTx(PetalTransferTxParams{FromAccountID: from, ToAccountID: to}); err == nil {
+		t.Fatalf("expected error for zero amount")
+	}
+	if _, err := BuildPetalTransferTx(PetalTransferTxParams{FromAccountID: from, ToAccountID: from, AmountTinybars: 1}); err == nil {
+		t.Fatalf("expected error for identical accounts")
+	}
+}
+
+func TestBuildScheduledAccountKeyUpdateTx(t *testing.T) {
+	privateKey, err := hedera.PrivateKeyGenerateEcdsa()
+	if err != nil {
+		t.Fatalf("failed to generate private key: %v", err)
+	}
+	params := AccountKeyUpdateTxParams{
+		AccountID:    hedera.AccountID{Account: 1001},
+		NewPublicKey: privateKey.PublicKey(),
+	}
+
+	update, err := BuildAccountKeyUpdateTx(params)
+	if err != nil {
+		t.Fatalf("BuildAccountKeyUpdateTx failed: %v", err)
+	}
+	if update.GetTransactionMemo() != HCS15KeyRotationTransactionMemo {
+		t.Fatalf("unexpected transaction memo: %s", update.GetTransactionMemo())
+	}
+
+	executeAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	scheduled, err := BuildScheduledAccountKeyUpdateTx(params, executeAt, "rotate")
+	if err != nil {
+		t.Fatalf("BuildScheduledAccountKeyUpdateTx failed: %v", err)
+	}
+	if !scheduled.GetWaitForExpiry() || !scheduled.GetExpirationTime().Equal(executeAt) {
+		t.Fatalf("expected schedule to wait until %s", executeAt)
+	}
+	if scheduled.GetScheduleMemo() != "rotate" {
+		t.Fatalf("unexpected schedule memo: %s", scheduled.GetScheduleMemo())
+	}
+	if _, err := BuildScheduledAccountKeyUpdateTx(params, time.Time{}, ""); err == nil {
+		t.Fatalf("expected error for missing execution time")
+	}
+}
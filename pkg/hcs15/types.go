@@ -1,36 +1,69 @@
 package hcs15
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 const (
 	HCS15BaseAccountCreateTransactionMemo  = "hcs-15:op:base_create"
 	HCS15PetalAccountCreateTransactionMemo = "hcs-15:op:petal_create"
+	HCS15PetalTransferTransactionMemo      = "hcs-15:op:petal_transfer"
+	HCS15KeyRotationTransactionMemo        = "hcs-15:op:key_rotate"
 )
 
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
+// BaseAccountCreateOptions configures CreateBaseAccount. At most one of
+// PublicKey, Mnemonic and Signer may be set; when none is, a new ECDSA key is
+// generated and returned in the result.
 type BaseAccountCreateOptions struct {
+	// PublicKey creates the account for an existing ECDSA public key. No key
+	// can sign the creation, so the account is created without an EVM alias.
+	PublicKey string
+	// Mnemonic derives the ECDSA key from a BIP-39 phrase at DerivationPath,
+	// which defaults to m/44'/3030'/0'/0/0.
+	Mnemonic           string
+	MnemonicPassphrase string
+	DerivationPath     string
+	// Signer creates the account for a key held by a KMS or HSM, which signs
+	// the creation so the EVM alias can be set.
+	Signer                        shared.Signer
 	InitialBalanceHbar            float64
 	MaxAutomaticTokenAssociations *int32
 	AccountMemo                   string
 	TransactionMemo               string
 }
 
+// PetalAccountCreateOptions configures CreatePetalAccount. Creating a petal
+// only needs the base public key, so BasePublicKey or BaseSigner can be used
+// instead of BasePrivateKey.
 type PetalAccountCreateOptions struct {
 	BasePrivateKey                string
+	BasePublicKey                 string
+	BaseSigner                    shared.Signer
 	InitialBalanceHbar            float64
 	MaxAutomaticTokenAssociations *int32
 	AccountMemo                   string
 	TransactionMemo               string
 }
 
+// BaseAccountCreateResult describes a created base account. PrivateKey and
+// PrivateKeyRaw are only set when the key was generated or derived from a
+// mnemonic, and EVMAddress only when the account was given an EVM alias.
 type BaseAccountCreateResult struct {
 	AccountID     string
 	PrivateKey    hedera.PrivateKey
@@ -44,3 +77,60 @@ type PetalAccountCreateResult struct {
 	AccountID string
 	Receipt   hedera.TransactionReceipt
 }
+
+type PetalAccount struct {
+	AccountID        string
+	Memo             string
+	BalanceTinybars  int64
+	EVMAddress       string
+	CreatedTimestamp string
+}
+
+type TransferBetweenPetalsOptions struct {
+	FromAccountID string
+	ToAccountID   string
+	// AmountTinybars is the amount moved from FromAccountID to ToAccountID.
+	AmountTinybars int64
+	// BaseSigner or BasePrivateKey signs for the shared ECDSA key that
+	// controls both petals.
+	BaseSigner      shared.Signer
+	BasePrivateKey  string
+	TransactionMemo string
+}
+
+type TransferBetweenPetalsResult struct {
+	TransactionID string
+	Receipt       hedera.TransactionReceipt
+}
+
+// RotateBaseKeyOptions configures RotateBaseKey. The current key is given by
+// CurrentSigner or CurrentPrivateKey, and the new ECDSA key by NewSigner or,
+// for scheduled rotations signed elsewhere, NewPublicKey.
+type RotateBaseKeyOptions struct {
+	BaseAccountID     string
+	CurrentSigner     shared.Signer
+	CurrentPrivateKey string
+	NewSigner         shared.Signer
+	NewPublicKey      string
+	// ExecuteAt schedules every key update to run together at that time. When
+	// zero the updates are submitted immediately, petals first.
+	ExecuteAt       time.Time
+	ScheduleMemo    string
+	TransactionMemo string
+}
+
+type KeyRotationUpdate struct {
+	AccountID     string
+	TransactionID string
+	ScheduleID    string
+}
+
+type RotateBaseKeyResult struct {
+	NewPublicKey hedera.PublicKey
+	// Updates lists the accounts rotated to, or scheduled to rotate to,
+	// NewPublicKey.
+	Updates []KeyRotationUpdate
+	// RolledBack lists the updates that switched accounts back to the
+	// current key after a failed immediate rotation.
+	RolledBack []KeyRotationUpdate
+}
@@ -3,7 +3,9 @@ package hcs16
 import (
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -17,11 +19,13 @@ import (
 
 type Client struct {
 	hederaClient     *hedera.Client
+	executor         *shared.Executor
 	mirrorClient     *mirror.Client
 	operatorID       hedera.AccountID
 	operatorKey      hedera.PrivateKey
-	operatorKeyRaw   string
+	operatorSigner   shared.Signer
 	network          string
+	executionMode    shared.ExecutionMode
 	inscriberAuthURL string
 	inscriberAPIURL  string
 }
@@ -32,12 +36,17 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	executionMode, err := shared.NormalizeExecutionMode(config.ExecutionMode)
+	if err != nil {
+		return nil, err
+	}
 
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -54,11 +63,13 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:     hederaClient,
+		executor:         config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:     mirrorClient,
 		operatorID:       operator.AccountID,
 		operatorKey:      operator.PrivateKey,
-		operatorKeyRaw:   operator.PrivateKeyRaw,
+		operatorSigner:   operator.Signer,
 		network:          network,
+		executionMode:    executionMode,
 		inscriberAuthURL: strings.TrimSpace(config.InscriberAuthURL),
 		inscriberAPIURL:  strings.TrimSpace(config.InscriberAPIURL),
 	}, nil
@@ -195,64 +206,32 @@ func (c *Client) BuildFloraTopicCreateTxs(
 	}, nil
 }
 
-// CreateFloraTopic creates the requested resource.
+// CreateFloraTopic creates the requested resource. It is not available in
+// envelope mode; freeze BuildCreateFloraTopicTx with BuildEnvelope instead.
 func (c *Client) CreateFloraTopic(
 	ctx context.Context,
 	options CreateFloraTopicOptions,
 ) (string, error) {
-	_ = ctx
-
 	transaction, err := BuildCreateFloraTopicTx(options)
 	if err != nil {
 		return "", err
 	}
-
-	if len(options.SignerKeys) > 0 {
-		frozen, freezeErr := transaction.FreezeWith(c.hederaClient)
-		if freezeErr != nil {
-			return "", fmt.Errorf("failed to freeze flora topic create transaction: %w", freezeErr)
-		}
-		for _, signerKey := range options.SignerKeys {
-			frozen = frozen.Sign(signerKey)
-		}
-		response, executeErr := frozen.Execute(c.hederaClient)
-		if executeErr != nil {
-			return "", fmt.Errorf("failed to execute flora topic create transaction: %w", executeErr)
-		}
-		receipt, receiptErr := response.GetReceipt(c.hederaClient)
-		if receiptErr != nil {
-			return "", fmt.Errorf("failed to get flora topic create receipt: %w", receiptErr)
-		}
-		if receipt.TopicID == nil {
-			return "", fmt.Errorf("failed to create flora topic")
-		}
-		return receipt.TopicID.String(), nil
-	}
-
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return "", fmt.Errorf("failed to execute flora topic create transaction: %w", err)
-	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", fmt.Errorf("failed to get flora topic create receipt: %w", err)
-	}
-	if receipt.TopicID == nil {
-		return "", fmt.Errorf("failed to create flora topic")
-	}
-	return receipt.TopicID.String(), nil
+	signers := append(localSigners(options.SignerKeys), options.Signers...)
+	return c.executeTopicCreateWithSigners(ctx, transaction, signers)
 }
 
-// CreateFloraAccount creates the requested resource.
+// CreateFloraAccount creates the requested resource. It is not available in
+// envelope mode; freeze BuildCreateFloraAccountTx with BuildEnvelope instead.
 func (c *Client) CreateFloraAccount(
 	ctx context.Context,
 	options CreateFloraAccountOptions,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	if options.KeyList == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("key list is required")
 	}
+	if err := c.requireExecuteMode(); err != nil {
+		return "", hedera.TransactionReceipt{}, err
+	}
 
 	transaction, err := BuildCreateFloraAccountTx(
 		options.KeyList,
@@ -264,14 +243,11 @@ func (c *Client) CreateFloraAccount(
 		return "", hedera.TransactionReceipt{}, err
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute flora account create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get flora account create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.AccountID == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to create flora account")
 	}
@@ -279,11 +255,32 @@ func (c *Client) CreateFloraAccount(
 	return receipt.AccountID.String(), receipt, nil
 }
 
+// BuildEnvelope freezes transaction, typically built by one of the package
+// Build*Tx functions, and signs it with signers. It is the envelope mode
+// counterpart of the methods that only return a receipt, such as
+// SendFloraCreated and CreateFloraAccount.
+func (c *Client) BuildEnvelope(
+	ctx context.Context,
+	transaction hedera.TransactionInterface,
+	signers ...shared.Signer,
+) (*shared.TxEnvelope, error) {
+	if transaction == nil {
+		return nil, fmt.Errorf("transaction is required")
+	}
+	envelope, _, err := c.freezeEnvelope(ctx, transaction, signers)
+	return envelope, err
+}
+
 // CreateFloraAccountWithTopics creates the requested resource.
 func (c *Client) CreateFloraAccountWithTopics(
 	ctx context.Context,
 	options CreateFloraAccountWithTopicsOptions,
 ) (CreateFloraAccountWithTopicsResult, error) {
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return CreateFloraAccountWithTopicsResult{}, fmt.Errorf(
+			"flora account and topic creation is not supported in envelope mode; create the account first",
+		)
+	}
 	keyList, err := c.AssembleKeyList(ctx, options.Members, options.Threshold)
 	if err != nil {
 		return CreateFloraAccountWithTopicsResult{}, err
@@ -312,15 +309,15 @@ func (c *Client) CreateFloraAccountWithTopics(
 		return CreateFloraAccountWithTopicsResult{}, err
 	}
 
-	communicationTopicID, err := c.executeTopicCreate(transactions[FloraTopicTypeCommunication])
+	communicationTopic, err := c.executeTopicCreate(ctx, transactions[FloraTopicTypeCommunication])
 	if err != nil {
 		return CreateFloraAccountWithTopicsResult{}, err
 	}
-	transactionTopicID, err := c.executeTopicCreate(transactions[FloraTopicTypeTransaction])
+	transactionTopic, err := c.executeTopicCreate(ctx, transactions[FloraTopicTypeTransaction])
 	if err != nil {
 		return CreateFloraAccountWithTopicsResult{}, err
 	}
-	stateTopicID, err := c.executeTopicCreate(transactions[FloraTopicTypeState])
+	stateTopic, err := c.executeTopicCreate(ctx, transactions[FloraTopicTypeState])
 	if err != nil {
 		return CreateFloraAccountWithTopicsResult{}, err
 	}
@@ -328,9 +325,9 @@ func (c *Client) CreateFloraAccountWithTopics(
 	return CreateFloraAccountWithTopicsResult{
 		FloraAccountID: floraAccountID,
 		Topics: FloraTopics{
-			Communication: communicationTopicID,
-			Transaction:   transactionTopicID,
-			State:         stateTopicID,
+			Communication: communicationTopic,
+			Transaction:   transactionTopic,
+			State:         stateTopic,
 		},
 	}, nil
 }
@@ -343,12 +340,29 @@ func (c *Client) SendFloraCreated(
 	floraAccountID string,
 	topics FloraTopics,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
 	transaction, err := BuildFloraCreatedTx(topicID, operatorID, floraAccountID, topics)
 	if err != nil {
 		return hedera.TransactionReceipt{}, err
 	}
-	return c.executeMessage(transaction)
+	return c.executeReceipt(ctx, transaction, nil)
+}
+
+// SendFloraCreatedWithSigner is SendFloraCreated paid for by operatorID,
+// whose account key signer holds, so LoadFlora honours the message when
+// operatorID is not the client operator.
+func (c *Client) SendFloraCreatedWithSigner(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	floraAccountID string,
+	topics FloraTopics,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildFloraCreatedTx(topicID, operatorID, floraAccountID, topics)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
 }
 
 // SendTransaction performs the requested operation.
@@ -359,12 +373,29 @@ func (c *Client) SendTransaction(
 	scheduleID string,
 	data string,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
 	transaction, err := BuildTransactionTx(topicID, operatorID, scheduleID, data)
 	if err != nil {
 		return hedera.TransactionReceipt{}, err
 	}
-	return c.executeMessage(transaction)
+	return c.executeReceipt(ctx, transaction, nil)
+}
+
+// SendTransactionWithSigner is SendTransaction paid for by operatorID,
+// whose account key signer holds, so ListPendingTransactions lists the
+// proposal when operatorID is not the client operator.
+func (c *Client) SendTransactionWithSigner(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	scheduleID string,
+	data string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildTransactionTx(topicID, operatorID, scheduleID, data)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
 }
 
 // SendStateUpdate performs the requested operation.
@@ -380,7 +411,38 @@ func (c *Client) SendStateUpdate(
 	transactionMemo string,
 	signerKeys []hedera.PrivateKey,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.SendStateUpdateWithSigners(
+		ctx,
+		topicID,
+		operatorID,
+		hash,
+		epoch,
+		accountID,
+		topics,
+		memo,
+		transactionMemo,
+		localSigners(signerKeys),
+	)
+	return result.Receipt, err
+}
+
+// SendStateUpdateWithSigners is SendStateUpdate for member keys held by
+// signers, for example in a KMS or HSM.
+func (c *Client) SendStateUpdateWithSigners(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	hash string,
+	epoch *int64,
+	accountID string,
+	topics []string,
+	memo string,
+	transactionMemo string,
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
 	transaction, err := BuildStateUpdateTx(
 		topicID,
 		operatorID,
@@ -392,9 +454,9 @@ func (c *Client) SendStateUpdate(
 		transactionMemo,
 	)
 	if err != nil {
-		return hedera.TransactionReceipt{}, err
+		return FloraTransactionResult{}, err
 	}
-	return c.executeMessageWithSigners(transaction, signerKeys)
+	return c.executeMessageWithSigners(ctx, transaction, signers)
 }
 
 // SendFloraJoinRequest performs the requested operation.
@@ -408,7 +470,34 @@ func (c *Client) SendFloraJoinRequest(
 	connectionSequence int64,
 	signerKey *hedera.PrivateKey,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.SendFloraJoinRequestWithSigner(
+		ctx,
+		topicID,
+		operatorID,
+		accountID,
+		connectionRequestID,
+		connectionTopicID,
+		connectionSequence,
+		localSigner(signerKey),
+	)
+	return result.Receipt, err
+}
+
+// SendFloraJoinRequestWithSigner is SendFloraJoinRequest for a key held by
+// signer. A nil signer submits with the operator key only.
+func (c *Client) SendFloraJoinRequestWithSigner(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	accountID string,
+	connectionRequestID int64,
+	connectionTopicID string,
+	connectionSequence int64,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
 	transaction, err := BuildFloraJoinRequestTx(
 		topicID,
 		operatorID,
@@ -418,12 +507,9 @@ func (c *Client) SendFloraJoinRequest(
 		connectionSequence,
 	)
 	if err != nil {
-		return hedera.TransactionReceipt{}, err
-	}
-	if signerKey == nil {
-		return c.executeMessage(transaction)
+		return FloraTransactionResult{}, err
 	}
-	return c.executeMessageWithSigners(transaction, []hedera.PrivateKey{*signerKey})
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
 }
 
 // SendFloraJoinVote performs the requested operation.
@@ -437,7 +523,34 @@ func (c *Client) SendFloraJoinVote(
 	connectionSequence int64,
 	signerKey *hedera.PrivateKey,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.SendFloraJoinVoteWithSigner(
+		ctx,
+		topicID,
+		operatorID,
+		accountID,
+		approve,
+		connectionRequestID,
+		connectionSequence,
+		localSigner(signerKey),
+	)
+	return result.Receipt, err
+}
+
+// SendFloraJoinVoteWithSigner is SendFloraJoinVote for a member key held by
+// signer. A nil signer submits with the operator key only.
+func (c *Client) SendFloraJoinVoteWithSigner(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	accountID string,
+	approve bool,
+	connectionRequestID int64,
+	connectionSequence int64,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
 	transaction, err := BuildFloraJoinVoteTx(
 		topicID,
 		operatorID,
@@ -447,12 +560,9 @@ func (c *Client) SendFloraJoinVote(
 		connectionSequence,
 	)
 	if err != nil {
-		return hedera.TransactionReceipt{}, err
+		return FloraTransactionResult{}, err
 	}
-	if signerKey == nil {
-		return c.executeMessage(transaction)
-	}
-	return c.executeMessageWithSigners(transaction, []hedera.PrivateKey{*signerKey})
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
 }
 
 // SendFloraJoinAccepted performs the requested operation.
@@ -464,12 +574,94 @@ func (c *Client) SendFloraJoinAccepted(
 	epoch *int64,
 	signerKeys []hedera.PrivateKey,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.SendFloraJoinAcceptedWithSigners(ctx, topicID, operatorID, members, epoch, localSigners(signerKeys))
+	return result.Receipt, err
+}
+
+// SendFloraJoinAcceptedWithSigners is SendFloraJoinAccepted for member keys
+// held by signers.
+func (c *Client) SendFloraJoinAcceptedWithSigners(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	members []string,
+	epoch *int64,
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
 	transaction, err := BuildFloraJoinAcceptedTx(topicID, operatorID, members, epoch)
 	if err != nil {
-		return hedera.TransactionReceipt{}, err
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, signers)
+}
+
+// SendFloraRemoveProposal publishes a proposal to remove accountID from the flora.
+func (c *Client) SendFloraRemoveProposal(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	accountID string,
+	reason string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildFloraRemoveProposalTx(topicID, operatorID, accountID, reason)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
+}
+
+// SendFloraThresholdProposal publishes a proposal to change the flora threshold.
+func (c *Client) SendFloraThresholdProposal(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	threshold int,
+	reason string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildFloraThresholdProposalTx(topicID, operatorID, threshold, reason)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
+}
+
+// SendFloraProposalVote publishes a vote on a removal or threshold proposal.
+func (c *Client) SendFloraProposalVote(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	proposalSequence int64,
+	approve bool,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildFloraProposalVoteTx(topicID, operatorID, proposalSequence, approve)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, optionalSigners(signer))
+}
+
+// SendFloraProposalAccepted publishes the outcome of an accepted proposal.
+func (c *Client) SendFloraProposalAccepted(
+	ctx context.Context,
+	topicID string,
+	operatorID string,
+	proposalSequence int64,
+	members []string,
+	threshold int,
+	epoch *int64,
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
+	transaction, err := BuildFloraProposalAcceptedTx(topicID, operatorID, proposalSequence, members, threshold, epoch)
+	if err != nil {
+		return FloraTransactionResult{}, err
 	}
-	return c.executeMessageWithSigners(transaction, signerKeys)
+	return c.executeMemberMessage(ctx, transaction, operatorID, signers)
 }
 
 // SignSchedule signs the requested transaction payload.
@@ -478,30 +670,45 @@ func (c *Client) SignSchedule(
 	scheduleID string,
 	signerKey hedera.PrivateKey,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.SignScheduleWithSigner(ctx, scheduleID, shared.NewLocalSigner(signerKey))
+	return result.Receipt, err
+}
 
+// SignScheduleWithSigner is SignSchedule for a member key held by signer,
+// for example in a KMS or HSM.
+func (c *Client) SignScheduleWithSigner(
+	ctx context.Context,
+	scheduleID string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	if signer == nil {
+		return FloraTransactionResult{}, fmt.Errorf("signer is required")
+	}
 	parsedScheduleID, err := hedera.ScheduleIDFromString(strings.TrimSpace(scheduleID))
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("invalid schedule ID: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("invalid schedule ID: %w", err)
 	}
 
 	transaction := hedera.NewScheduleSignTransaction().SetScheduleID(parsedScheduleID)
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return c.envelopeResult(ctx, transaction, []shared.Signer{signer})
+	}
 	frozen, err := transaction.FreezeWith(c.hederaClient)
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to freeze schedule sign transaction: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("failed to freeze schedule sign transaction: %w", err)
 	}
-
-	signed := frozen.Sign(signerKey)
-	response, err := signed.Execute(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to execute schedule sign transaction: %w", err)
+	if err := shared.SignTransaction(ctx, frozen, signer); err != nil {
+		return FloraTransactionResult{}, fmt.Errorf("failed to sign schedule sign transaction: %w", err)
 	}
 
-	receipt, err := response.GetReceipt(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozen)
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to get schedule sign receipt: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("failed to execute schedule sign transaction: %w", err)
 	}
-	return receipt, nil
+	return FloraTransactionResult{TransactionID: executed.TransactionID, Receipt: executed.Receipt}, nil
 }
 
 // PublishFloraCreated publishes the requested message payload.
@@ -552,9 +759,10 @@ func (c *Client) CreateFloraProfile(
 	hcs11Client, err := hcs11.NewClient(hcs11.ClientConfig{
 		Network:      c.network,
 		HederaClient: c.hederaClient,
+		Executor:     c.executor,
 		Auth: hcs11.Auth{
 			OperatorID: c.operatorID.String(),
-			PrivateKey: c.operatorKeyRaw,
+			Signer:     c.operatorSigner,
 		},
 		MirrorBaseURL:    c.mirrorClient.BaseURL(),
 		InscriberAuthURL: c.inscriberAuthURL,
@@ -668,61 +876,193 @@ func (c *Client) GetLatestMessage(
 }
 
 func (c *Client) executeTopicCreate(
+	ctx context.Context,
 	transaction *hedera.TopicCreateTransaction,
 ) (string, error) {
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return "", fmt.Errorf("failed to execute flora topic create transaction: %w", err)
+	return c.executeTopicCreateWithSigners(ctx, transaction, nil)
+}
+
+func (c *Client) executeTopicCreateWithSigners(
+	ctx context.Context,
+	transaction *hedera.TopicCreateTransaction,
+	signers []shared.Signer,
+) (string, error) {
+	if err := c.requireExecuteMode(); err != nil {
+		return "", err
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+	if len(signers) > 0 {
+		frozen, err := transaction.FreezeWith(c.hederaClient)
+		if err != nil {
+			return "", fmt.Errorf("failed to freeze flora topic create transaction: %w", err)
+		}
+		for _, signer := range signers {
+			if err := shared.SignTransaction(ctx, frozen, signer); err != nil {
+				return "", fmt.Errorf("failed to sign flora topic create transaction: %w", err)
+			}
+		}
+		transaction = frozen
+	}
+
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
-		return "", fmt.Errorf("failed to get flora topic create receipt: %w", err)
+		return "", fmt.Errorf("failed to execute flora topic create transaction: %w", err)
 	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", fmt.Errorf("failed to create flora topic")
 	}
 	return receipt.TopicID.String(), nil
 }
 
-func (c *Client) executeMessage(
+var errEnvelopeUnsupported = errors.New(
+	"method returns a receipt and is not available in envelope mode; use BuildEnvelope or a *WithSigner(s) variant",
+)
+
+// requireExecuteMode rejects envelope mode in the methods whose results
+// predate it and cannot carry an envelope.
+func (c *Client) requireExecuteMode() error {
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return errEnvelopeUnsupported
+	}
+	return nil
+}
+
+// executeReceipt executes a message for the receipt-only methods.
+func (c *Client) executeReceipt(
+	ctx context.Context,
 	transaction *hedera.TopicMessageSubmitTransaction,
+	signers []shared.Signer,
 ) (hedera.TransactionReceipt, error) {
-	response, err := transaction.Execute(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to execute flora message transaction: %w", err)
+	if err := c.requireExecuteMode(); err != nil {
+		return hedera.TransactionReceipt{}, err
+	}
+	result, err := c.executeMessageWithSigners(ctx, transaction, signers)
+	return result.Receipt, err
+}
+
+func (c *Client) executeMessage(
+	ctx context.Context,
+	transaction *hedera.TopicMessageSubmitTransaction,
+) (FloraTransactionResult, error) {
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return c.envelopeResult(ctx, transaction, nil)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to get flora message receipt: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("failed to execute flora message transaction: %w", err)
 	}
-	return receipt, nil
+	return FloraTransactionResult{TransactionID: executed.TransactionID, Receipt: executed.Receipt}, nil
 }
 
 func (c *Client) executeMessageWithSigners(
+	ctx context.Context,
 	transaction *hedera.TopicMessageSubmitTransaction,
-	signerKeys []hedera.PrivateKey,
-) (hedera.TransactionReceipt, error) {
-	if len(signerKeys) == 0 {
-		return c.executeMessage(transaction)
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
+	if len(signers) == 0 {
+		return c.executeMessage(ctx, transaction)
+	}
+	if c.executionMode == shared.ExecutionModeEnvelope {
+		return c.envelopeResult(ctx, transaction, signers)
 	}
 
 	frozen, err := transaction.FreezeWith(c.hederaClient)
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to freeze flora message transaction: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("failed to freeze flora message transaction: %w", err)
 	}
-	for _, signerKey := range signerKeys {
-		frozen = frozen.Sign(signerKey)
+	for _, signer := range signers {
+		if err := shared.SignTransaction(ctx, frozen, signer); err != nil {
+			return FloraTransactionResult{}, fmt.Errorf("failed to sign flora message transaction: %w", err)
+		}
 	}
 
-	response, err := frozen.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozen)
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to execute flora message transaction: %w", err)
+		return FloraTransactionResult{}, fmt.Errorf("failed to execute flora message transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
+	return FloraTransactionResult{TransactionID: executed.TransactionID, Receipt: executed.Receipt}, nil
+}
+
+// executeMemberMessage submits a message that acts for memberID. LoadFlora
+// only honours HCS-16 messages paid for by the account in their operator_id,
+// so a member other than the client operator pays for its own message and
+// signers must include that member's account key.
+func (c *Client) executeMemberMessage(
+	ctx context.Context,
+	transaction *hedera.TopicMessageSubmitTransaction,
+	memberID string,
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
+	memberID = strings.TrimSpace(memberID)
+	if memberID != "" && memberID != c.operatorID.String() {
+		payer, err := hedera.AccountIDFromString(memberID)
+		if err != nil {
+			return FloraTransactionResult{}, fmt.Errorf("invalid member account ID: %w", err)
+		}
+		if len(signers) == 0 {
+			return FloraTransactionResult{}, fmt.Errorf("a signer for %s is required to pay for its message", memberID)
+		}
+		transaction.SetTransactionID(hedera.TransactionIDGenerate(payer))
+	}
+	return c.executeMessageWithSigners(ctx, transaction, signers)
+}
+
+// freezeEnvelope freezes transaction into an envelope and adds signatures
+// from signers.
+func (c *Client) freezeEnvelope(
+	ctx context.Context,
+	transaction hedera.TransactionInterface,
+	signers []shared.Signer,
+) (*shared.TxEnvelope, string, error) {
+	envelope, err := shared.FreezeTxEnvelope(transaction, shared.FreezeOptions{Client: c.hederaClient})
 	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to get flora message receipt: %w", err)
+		return nil, "", err
+	}
+	for _, signer := range signers {
+		if err := envelope.Sign(ctx, signer); err != nil {
+			return nil, "", err
+		}
 	}
-	return receipt, nil
+	transactionID, err := envelope.TransactionID()
+	if err != nil {
+		return nil, "", err
+	}
+	return envelope, transactionID.String(), nil
+}
+
+func (c *Client) envelopeResult(
+	ctx context.Context,
+	transaction hedera.TransactionInterface,
+	signers []shared.Signer,
+) (FloraTransactionResult, error) {
+	envelope, transactionID, err := c.freezeEnvelope(ctx, transaction, signers)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return FloraTransactionResult{TransactionID: transactionID, Envelope: envelope}, nil
+}
+
+func localSigner(privateKey *hedera.PrivateKey) shared.Signer {
+	if privateKey == nil {
+		return nil
+	}
+	return shared.NewLocalSigner(*privateKey)
+}
+
+func optionalSigners(signer shared.Signer) []shared.Signer {
+	if signer == nil {
+		return nil
+	}
+	return []shared.Signer{signer}
+}
+
+func localSigners(privateKeys []hedera.PrivateKey) []shared.Signer {
+	signers := make([]shared.Signer, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		signers = append(signers, shared.NewLocalSigner(privateKey))
+	}
+	return signers
 }
 
 func (c *Client) fetchAccountPublicKey(ctx context.Context, accountID string) (hedera.PublicKey, error) {
@@ -760,6 +1100,33 @@ func (c *Client) fetchAccountPublicKey(ctx context.Context, accountID string) (h
 	)
 }
 
+// fetchAccountKey resolves an account key as reported by the mirror node.
+// Key lists, such as those of nested floras, come back as *hedera.KeyList.
+func (c *Client) fetchAccountKey(ctx context.Context, accountID string) (hedera.Key, error) {
+	info, err := c.mirrorClient.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	keyType, _ := info.Key["_type"].(string)
+	if keyType != "ProtobufEncoded" {
+		return c.fetchAccountPublicKey(ctx, accountID)
+	}
+	rawKey, _ := info.Key["key"].(string)
+	return decodeProtobufKey(rawKey)
+}
+
+// decodeProtobufKey parses a mirror node ProtobufEncoded key.
+func decodeProtobufKey(rawKey string) (hedera.Key, error) {
+	encoded, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(rawKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("key is empty")
+	}
+	return hedera.KeyFromBytes(encoded)
+}
+
 func extractMirrorKeyString(raw map[string]any) string {
 	if raw == nil {
 		return ""
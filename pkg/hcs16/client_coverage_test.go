@@ -2,11 +2,14 @@ package hcs16
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 func TestNewClientCoverage(t *testing.T) {
@@ -205,3 +208,61 @@ func TestConvertFloraMembers(t *testing.T) {
 		t.Fatal("unexpected output")
 	}
 }
+
+func TestEnvelopeModeSignsWithMemberKeys(t *testing.T) {
+	operatorKey, _ := hedera.PrivateKeyGenerateEd25519()
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	hederaClient := hedera.ClientForNetwork(map[string]hedera.AccountID{"127.0.0.1:50211": {Account: 3}})
+	hederaClient.SetOperator(hedera.AccountID{Account: 1234}, operatorKey)
+	client, err := NewClient(ClientConfig{
+		Network:       "testnet",
+		HederaClient:  hederaClient,
+		ExecutionMode: shared.ExecutionModeEnvelope,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	accepted, err := client.SendFloraJoinAcceptedWithSigners(
+		context.Background(),
+		"0.0.5005",
+		"0.0.1234",
+		[]string{"0.0.1234", "0.0.1235"},
+		nil,
+		[]shared.Signer{shared.NewLocalSigner(memberKey)},
+	)
+	if err != nil || accepted.Envelope == nil || accepted.TransactionID == "" {
+		t.Fatalf("expected an envelope result, got %+v, %v", accepted, err)
+	}
+	signed, err := accepted.Envelope.SignedBy(memberKey.PublicKey())
+	if err != nil || !signed {
+		t.Fatalf("expected member signature in envelope (%v)", err)
+	}
+
+	if _, err := client.SendTransaction(context.Background(), "0.0.5005", "0.0.1234", "0.0.77", ""); !errors.Is(err, errEnvelopeUnsupported) {
+		t.Fatalf("expected receipt-only send to be rejected in envelope mode, got %v", err)
+	}
+	if _, err := client.CreateFloraTopic(context.Background(), CreateFloraTopicOptions{
+		FloraAccountID: "0.0.5000",
+		TopicType:      FloraTopicTypeState,
+	}); !errors.Is(err, errEnvelopeUnsupported) {
+		t.Fatalf("expected topic creation to be rejected in envelope mode, got %v", err)
+	}
+	transaction, err := BuildCreateFloraTopicTx(CreateFloraTopicOptions{
+		FloraAccountID: "0.0.5000",
+		TopicType:      FloraTopicTypeState,
+	})
+	if err != nil {
+		t.Fatalf("BuildCreateFloraTopicTx failed: %v", err)
+	}
+	topic, err := client.BuildEnvelope(context.Background(), transaction, shared.NewLocalSigner(memberKey))
+	if err != nil || !topic.IsFrozen() {
+		t.Fatalf("expected a frozen topic envelope, got %v", err)
+	}
+	if signed, err := topic.SignedBy(memberKey.PublicKey()); err != nil || !signed {
+		t.Fatalf("expected member signature in topic envelope (%v)", err)
+	}
+	if _, err := client.CreateFloraAccountWithTopics(context.Background(), CreateFloraAccountWithTopicsOptions{}); err == nil {
+		t.Fatalf("expected combined account and topic creation to be rejected")
+	}
+}
@@ -1,9 +1,12 @@
 package hcs16
 
 import (
+	"context"
 	"testing"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 func TestParseTopicMemo(t *testing.T) {
@@ -53,3 +56,45 @@ func TestExtractMirrorKeyCandidate(t *testing.T) {
 		t.Fatalf("unexpected mirror key extraction result: %s", key)
 	}
 }
+
+func TestSendWithSignerPaysFromMember(t *testing.T) {
+	operatorKey, _ := hedera.PrivateKeyGenerateEd25519()
+	hederaClient := hedera.ClientForNetwork(map[string]hedera.AccountID{"127.0.0.1:50211": {Account: 3}})
+	hederaClient.SetOperator(hedera.AccountID{Account: 802}, operatorKey)
+	client, err := NewClient(ClientConfig{
+		Network:       "testnet",
+		HederaClient:  hederaClient,
+		ExecutionMode: shared.ExecutionModeEnvelope,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	signer := shared.NewLocalSigner(memberKey)
+	if _, err := client.SendTransactionWithSigner(context.Background(), "0.0.902", "0.0.801", "0.0.7001", "", nil); err == nil {
+		t.Fatal("expected error without the member's signer")
+	}
+	transaction, err := client.SendTransactionWithSigner(context.Background(), "0.0.902", "0.0.801", "0.0.7001", "pay vendor", signer)
+	if err != nil {
+		t.Fatalf("SendTransactionWithSigner failed: %v", err)
+	}
+	created, err := client.SendFloraCreatedWithSigner(context.Background(), "0.0.901", "0.0.801", "0.0.900", FloraTopics{}, signer)
+	if err != nil {
+		t.Fatalf("SendFloraCreatedWithSigner failed: %v", err)
+	}
+	for _, result := range []FloraTransactionResult{transaction, created} {
+		transactionID, err := result.Envelope.TransactionID()
+		if err != nil || transactionID.AccountID == nil || transactionID.AccountID.String() != "0.0.801" {
+			t.Fatalf("expected the member to pay for its message, got %v, %v", transactionID, err)
+		}
+	}
+
+	own, err := client.SendTransactionWithSigner(context.Background(), "0.0.902", "0.0.802", "0.0.7001", "", nil)
+	if err != nil {
+		t.Fatalf("SendTransactionWithSigner failed for the client operator: %v", err)
+	}
+	if transactionID, _ := own.Envelope.TransactionID(); transactionID.AccountID.String() != "0.0.802" {
+		t.Fatalf("expected the client operator to pay, got %v", transactionID)
+	}
+}
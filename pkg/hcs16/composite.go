@@ -0,0 +1,160 @@
+package hcs16
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs17"
+)
+
+// CalculateFloraStateHash computes a flora's HCS-17 composite state hash
+// without publishing it. Each current member contributes the latest state
+// hash it published on the flora state topic. The composite topics are the
+// communication and transaction topics; the state topic is left out because
+// every publication changes its running hash. The key fingerprint is derived
+// from the threshold key the mirror node reports for the flora account, so
+// every member computes the same hash from the same ledger state.
+func (c *Client) CalculateFloraStateHash(ctx context.Context, floraAccountID string) (FloraCompositeState, error) {
+	composite, _, err := c.computeFloraComposite(ctx, floraAccountID)
+	return composite, err
+}
+
+// ComputeFloraStateHash computes the flora's composite state hash like
+// CalculateFloraStateHash and publishes it to the flora state topic with the
+// current epoch.
+func (c *Client) ComputeFloraStateHash(ctx context.Context, floraAccountID string) (FloraCompositeState, error) {
+	composite, state, err := c.computeFloraComposite(ctx, floraAccountID)
+	if err != nil {
+		return FloraCompositeState{}, err
+	}
+
+	topicIDs := make([]string, 0, len(composite.Topics))
+	for _, topic := range composite.Topics {
+		topicIDs = append(topicIDs, topic.TopicID)
+	}
+	epoch := composite.Epoch
+	published, err := c.SendStateUpdateWithSigners(
+		ctx,
+		state.Topics.State,
+		c.operatorID.String(),
+		composite.StateHash,
+		&epoch,
+		composite.FloraAccountID,
+		topicIDs,
+		"",
+		"",
+		nil,
+	)
+	if err != nil {
+		return FloraCompositeState{}, fmt.Errorf("failed to publish flora state hash: %w", err)
+	}
+	composite.Receipt = published.Receipt
+	composite.Envelope = published.Envelope
+	return composite, nil
+}
+
+func (c *Client) computeFloraComposite(
+	ctx context.Context,
+	floraAccountID string,
+) (FloraCompositeState, FloraState, error) {
+	state, err := c.LoadFlora(ctx, floraAccountID)
+	if err != nil {
+		return FloraCompositeState{}, FloraState{}, err
+	}
+	if len(state.Members) == 0 {
+		return FloraCompositeState{}, FloraState{}, fmt.Errorf("flora %s has no members", state.FloraAccountID)
+	}
+
+	memberStates := make([]hcs17.CompositeMemberState, 0, len(state.Members))
+	for _, accountID := range state.MemberAccountIDs() {
+		published, ok := state.StateHashes[accountID]
+		if !ok {
+			return FloraCompositeState{}, FloraState{}, fmt.Errorf(
+				"member %s has not published a state hash from its own account on flora state topic %s",
+				accountID,
+				state.Topics.State,
+			)
+		}
+		memberStates = append(memberStates, hcs17.CompositeMemberState{
+			AccountID: accountID,
+			StateHash: published.StateHash,
+		})
+	}
+
+	topics := make([]hcs17.TopicState, 0, 2)
+	for _, topicID := range []string{state.Topics.Communication, state.Topics.Transaction} {
+		latest, err := c.mirrorClient.GetTopicMessageAt(ctx, topicID, "")
+		if err != nil {
+			return FloraCompositeState{}, FloraState{}, fmt.Errorf("failed to read flora topic %s: %w", topicID, err)
+		}
+		runningHash := ""
+		if latest != nil {
+			runningHash = latest.RunningHash
+		}
+		topics = append(topics, hcs17.TopicState{TopicID: topicID, LatestRunningHash: runningHash})
+	}
+
+	fingerprint, err := c.floraKeyFingerprint(ctx, state.FloraAccountID)
+	if err != nil {
+		return FloraCompositeState{}, FloraState{}, err
+	}
+
+	calculated, err := hcs17.CalculateCompositeStateHash(hcs17.CompositeStateInput{
+		CompositeAccountID:            state.FloraAccountID,
+		CompositePublicKeyFingerprint: fingerprint,
+		MemberStates:                  memberStates,
+		CompositeTopics:               topics,
+	})
+	if err != nil {
+		return FloraCompositeState{}, FloraState{}, err
+	}
+
+	return FloraCompositeState{
+		StateHash:      calculated.StateHash,
+		FloraAccountID: state.FloraAccountID,
+		Epoch:          state.Epoch,
+		MemberStates:   memberStates,
+		Topics:         topics,
+		KeyFingerprint: fingerprint,
+	}, state, nil
+}
+
+// floraKeyFingerprint fingerprints the threshold key the mirror node reports
+// for the flora account. A key list without a threshold requires every key.
+func (c *Client) floraKeyFingerprint(ctx context.Context, floraAccountID string) (string, error) {
+	account, err := c.mirrorClient.GetAccount(ctx, floraAccountID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load flora account %s: %w", floraAccountID, err)
+	}
+	keyType, _ := account.Key["_type"].(string)
+	rawKey, _ := account.Key["key"].(string)
+	if keyType != "ProtobufEncoded" || strings.TrimSpace(rawKey) == "" {
+		return "", fmt.Errorf("flora account %s does not have a key list", floraAccountID)
+	}
+
+	key, err := decodeProtobufKey(rawKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse flora account %s key: %w", floraAccountID, err)
+	}
+	keyList, ok := key.(*hedera.KeyList)
+	if !ok {
+		return "", fmt.Errorf("flora account %s does not have a key list", floraAccountID)
+	}
+
+	publicKeys := make([]hedera.PublicKey, 0, len(keyList.GetKeys()))
+	for _, member := range keyList.GetKeys() {
+		publicKey, ok := member.(hedera.PublicKey)
+		if !ok {
+			return "", fmt.Errorf("flora account %s key list contains a nested key", floraAccountID)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	threshold := keyList.GetThreshold()
+	if threshold <= 0 {
+		threshold = len(publicKeys)
+	}
+	return hcs17.CalculateKeyFingerprint(publicKeys, threshold)
+}
@@ -0,0 +1,123 @@
+package hcs16
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs17"
+)
+
+func floraKeyResponses(t *testing.T, threshold uint) (map[string]any, string) {
+	t.Helper()
+	keys := make([]hedera.PublicKey, 0, 3)
+	for range 3 {
+		privateKey, err := hedera.PrivateKeyGenerateEd25519()
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		keys = append(keys, privateKey.PublicKey())
+	}
+	encoded, err := hedera.KeyToBytes(hedera.KeyListWithThreshold(threshold).AddAllPublicKeys(keys))
+	if err != nil {
+		t.Fatalf("failed to encode key list: %v", err)
+	}
+	fingerprint, err := hcs17.CalculateKeyFingerprint(keys, int(threshold))
+	if err != nil {
+		t.Fatalf("CalculateKeyFingerprint failed: %v", err)
+	}
+	return map[string]any{
+		"/api/v1/accounts/0.0.900": map[string]any{
+			"account": "0.0.900",
+			"memo":    "hcs-11:hcs://1/0.0.950",
+			"key":     map[string]any{"_type": "ProtobufEncoded", "key": hex.EncodeToString(encoded)},
+		},
+		"/api/v1/topics/0.0.902/messages": map[string]any{
+			"messages": []map[string]any{{"consensus_timestamp": "1700000100.000000000", "running_hash": "tx-hash"}},
+		},
+	}, fingerprint
+}
+
+func TestCalculateFloraStateHash(t *testing.T) {
+	state := []any{
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "member-1", "account_id": "0.0.801", "topics": []string{}},
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "member-2-old", "account_id": "0.0.802", "topics": []string{}},
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "member-2", "account_id": "0.0.802", "topics": []string{}},
+		// Published by member 0.0.801 on behalf of 0.0.802, so it is ignored.
+		paidPayload{payer: "0.0.801", body: map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "forged", "account_id": "0.0.802", "topics": []string{}}},
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "flora", "account_id": "0.0.900", "topics": []string{}},
+	}
+	extra, fingerprint := floraKeyResponses(t, 2)
+	server := newFloraMirror(t, defaultFloraProfile(), nil, state, defaultFloraMemos(), extra)
+	defer server.Close()
+	client := newFloraStateClient(t, server.URL)
+
+	composite, err := client.CalculateFloraStateHash(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("CalculateFloraStateHash failed: %v", err)
+	}
+	expected, err := hcs17.CalculateCompositeStateHash(hcs17.CompositeStateInput{
+		CompositeAccountID:            "0.0.900",
+		CompositePublicKeyFingerprint: fingerprint,
+		MemberStates: []hcs17.CompositeMemberState{
+			{AccountID: "0.0.802", StateHash: "member-2"},
+			{AccountID: "0.0.801", StateHash: "member-1"},
+		},
+		CompositeTopics: []hcs17.TopicState{
+			{TopicID: "0.0.902", LatestRunningHash: "tx-hash"},
+			{TopicID: "0.0.901"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CalculateCompositeStateHash failed: %v", err)
+	}
+	if composite.StateHash != expected.StateHash || composite.KeyFingerprint != fingerprint {
+		t.Fatalf("unexpected composite %+v", composite)
+	}
+	if len(composite.MemberStates) != 2 || composite.MemberStates[1].StateHash != "member-2" {
+		t.Fatalf("expected the latest member state hashes, got %+v", composite.MemberStates)
+	}
+
+	again, err := client.CalculateFloraStateHash(context.Background(), "0.0.900")
+	if err != nil || again.StateHash != composite.StateHash {
+		t.Fatalf("expected a deterministic hash, got %s, %v", again.StateHash, err)
+	}
+
+	client.HederaClient().Close()
+	if _, err := client.ComputeFloraStateHash(context.Background(), "0.0.900"); err == nil ||
+		!strings.Contains(err.Error(), "failed to publish flora state hash") {
+		t.Fatalf("expected publication to fail on a closed client, got %v", err)
+	}
+}
+
+func TestCalculateFloraStateHashRequiresInputs(t *testing.T) {
+	state := []any{
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "member-1", "account_id": "0.0.801", "topics": []string{}},
+	}
+	extra, _ := floraKeyResponses(t, 2)
+	server := newFloraMirror(t, defaultFloraProfile(), nil, state, defaultFloraMemos(), extra)
+	defer server.Close()
+	client := newFloraStateClient(t, server.URL)
+
+	if _, err := client.CalculateFloraStateHash(context.Background(), "0.0.900"); err == nil ||
+		!strings.Contains(err.Error(), "member 0.0.802") {
+		t.Fatalf("expected an error for the member without a state hash, got %v", err)
+	}
+
+	state = append(state, map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "member-2", "account_id": "0.0.802", "topics": []string{}})
+	extra["/api/v1/accounts/0.0.900"] = map[string]any{
+		"account": "0.0.900",
+		"memo":    "hcs-11:hcs://1/0.0.950",
+		"key":     map[string]any{"_type": "ED25519", "key": "00"},
+	}
+	single := newFloraMirror(t, defaultFloraProfile(), nil, state, defaultFloraMemos(), extra)
+	defer single.Close()
+	client = newFloraStateClient(t, single.URL)
+	if _, err := client.CalculateFloraStateHash(context.Background(), "0.0.900"); err == nil ||
+		!strings.Contains(err.Error(), "does not have a key list") {
+		t.Fatalf("expected an error for a flora without a key list, got %v", err)
+	}
+}
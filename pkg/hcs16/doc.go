@@ -8,6 +8,49 @@
 // keys to require multi-party consensus for operations, enabling
 // decentralized governance and collaborative decision-making.
 //
+// # Loading State
+//
+// LoadFlora rebuilds a flora's current members, threshold, epoch, pending
+// join requests and latest HCS-17 state hash by replaying its profile and
+// topics from the mirror node. Any member key can submit to the flora
+// topics, so HCS-16 messages only count when they were paid for by the
+// account in their operator_id. The senders that take a member signer, and
+// the coordinator, make a member other than the client operator pay for its
+// own messages, so the member's signer must hold its account key.
+// SendFloraCreated and SendTransaction are always paid for by the client
+// operator; use SendFloraCreatedWithSigner and SendTransactionWithSigner to
+// publish them for another member.
+//
+// # Membership Changes
+//
+// FloraJoinCoordinator tallies votes against the flora threshold for join
+// requests, member removals and threshold changes. Once a change is
+// approved it schedules the account key and topic key rotations and collects
+// member signatures. Once the schedules have executed, Accept posts the
+// acceptance with the next epoch on the state topic. Members sign through shared.Signer values, so each process
+// only needs the signers of the members it runs for.
+//
+// # Pending Transactions
+//
+// ListPendingTransactions reads the transaction topic, fetches each proposed
+// schedule from the mirror node and summarizes the scheduled body, listing
+// which members have signed, rejected or have yet to act. Members whose
+// accounts hold key lists count as signed once their list is satisfied.
+// Members respond with ApproveTransaction, which signs the schedule, or
+// RejectTransaction, which posts to the flora's transaction topic from the
+// member's own account. Proposals and rejections whose payer is not the
+// member they name are ignored.
+//
+// # State Hashes
+//
+// CalculateFloraStateHash derives the flora's HCS-17 composite state hash
+// from each member's latest state hash on the state topic, the running
+// hashes of the communication and transaction topics and the fingerprint of
+// the flora's threshold key. A member's state hash only counts when the
+// member paid for it from its own account. ComputeFloraStateHash also
+// publishes it to the state topic, so any member can produce the agreed hash
+// with one call.
+//
 // # Specification
 //
 // Full specification: https://hol.org/docs/standards/hcs-16
@@ -0,0 +1,443 @@
+package hcs16
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+// defaultRotationDelay is how long members have to sign a key rotation
+// before its schedules execute.
+const defaultRotationDelay = 30 * time.Minute
+
+// ErrKeyRotationPending is returned by Accept while the mirror node does not
+// yet report every schedule of the rotation as executed.
+var ErrKeyRotationPending = errors.New("flora key rotation has not executed yet")
+
+// FloraJoinCoordinator drives membership changes from vote tally to
+// acceptance: join requests, member removals and threshold changes. Once a
+// change is approved it rotates the flora account key and every flora
+// topic's admin and submit keys to key lists built from a single membership
+// snapshot. The four updates are scheduled to wait for one shared
+// expiration time, so they execute together rather than as each collects
+// its signatures. A schedule still short of signatures at that time expires
+// without executing while the others apply; members who sign manually must
+// therefore sign every schedule of the rotation. The change is announced on
+// the state topic by Accept only after all four schedules have executed.
+type FloraJoinCoordinator struct {
+	client         *Client
+	floraAccountID string
+	memberSigners  map[string]shared.Signer
+	rotationDelay  time.Duration
+}
+
+// NewFloraJoinCoordinator creates a join coordinator for one flora.
+func NewFloraJoinCoordinator(config FloraJoinCoordinatorConfig) (*FloraJoinCoordinator, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if config.Client.executionMode == shared.ExecutionModeEnvelope {
+		return nil, fmt.Errorf("flora join coordination is not supported in envelope mode")
+	}
+	floraAccountID := strings.TrimSpace(config.FloraAccountID)
+	if floraAccountID == "" {
+		return nil, fmt.Errorf("flora account ID is required")
+	}
+
+	memberSigners := make(map[string]shared.Signer, len(config.MemberSigners))
+	for accountID, signer := range config.MemberSigners {
+		if signer == nil {
+			return nil, fmt.Errorf("signer for member %s is nil", accountID)
+		}
+		memberSigners[strings.TrimSpace(accountID)] = signer
+	}
+
+	if config.RotationDelay < 0 {
+		return nil, fmt.Errorf("rotation delay must not be negative")
+	}
+	rotationDelay := config.RotationDelay
+	if rotationDelay == 0 {
+		rotationDelay = defaultRotationDelay
+	}
+
+	return &FloraJoinCoordinator{
+		client:         config.Client,
+		floraAccountID: floraAccountID,
+		memberSigners:  memberSigners,
+		rotationDelay:  rotationDelay,
+	}, nil
+}
+
+// Tally counts the current members' votes on accountID's pending join request.
+func (coordinator *FloraJoinCoordinator) Tally(ctx context.Context, accountID string) (FloraJoinTally, error) {
+	state, err := coordinator.client.LoadFlora(ctx, coordinator.floraAccountID)
+	if err != nil {
+		return FloraJoinTally{}, err
+	}
+	return tallyJoinRequest(state, accountID)
+}
+
+// Vote publishes a join vote from memberAccountID, signed by that member's signer.
+func (coordinator *FloraJoinCoordinator) Vote(
+	ctx context.Context,
+	accountID string,
+	memberAccountID string,
+	approve bool,
+) (FloraTransactionResult, error) {
+	state, memberID, memberSigner, err := coordinator.loadForMember(ctx, memberAccountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	request, err := findJoinRequest(state, accountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+
+	transaction, err := BuildFloraJoinVoteTx(
+		state.Topics.Communication,
+		memberID,
+		request.AccountID,
+		approve,
+		request.ConnectionRequestID,
+		request.ConnectionSequence,
+	)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return coordinator.client.executeMemberMessage(ctx, transaction, memberID, []shared.Signer{memberSigner})
+}
+
+// Finalize starts an approved join. It schedules the account key update and
+// the three topic key updates and signs each schedule with the configured
+// member signers, up to RequiredSignatures. Members not configured here sign
+// each schedule with SignSchedule or SignScheduleWithSigner before its
+// ExpirationTime, when the new keys take effect. The join is only recorded
+// once the caller passes the returned rotation to Accept after that time.
+func (coordinator *FloraJoinCoordinator) Finalize(ctx context.Context, accountID string) (FloraKeyRotation, error) {
+	state, err := coordinator.client.LoadFlora(ctx, coordinator.floraAccountID)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	tally, err := tallyJoinRequest(state, accountID)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	if !tally.Approved {
+		return FloraKeyRotation{}, fmt.Errorf(
+			"join request for %s has %d of %d required approvals",
+			tally.Request.AccountID,
+			len(tally.Approvals),
+			tally.Threshold,
+		)
+	}
+
+	members := append(state.MemberAccountIDs(), tally.Request.AccountID)
+	rotation, err := coordinator.prepareKeyRotation(ctx, state, members, state.Threshold)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	rotation.AccountID = tally.Request.AccountID
+	err = coordinator.scheduleKeyRotation(ctx, &rotation)
+	return rotation, err
+}
+
+// Accept publishes the outcome of a rotation once the mirror node reports
+// every one of its schedules as executed: flora_join_accepted for a join, or
+// flora_proposal_accepted for a removal or threshold change. Both go to the
+// state topic. Before then it returns an error wrapping
+// ErrKeyRotationPending, so the membership change is never announced for
+// keys that did not change; call it again after the rotation's
+// ExpirationTime.
+func (coordinator *FloraJoinCoordinator) Accept(ctx context.Context, rotation *FloraKeyRotation) error {
+	if rotation == nil {
+		return fmt.Errorf("key rotation is required")
+	}
+	if rotation.Accepted {
+		return nil
+	}
+	operatorID, operatorSigner, ok := coordinator.firstMemberSigner(rotation.signerOrder())
+	if !ok {
+		return fmt.Errorf("no signer configured for a current member of flora %s", coordinator.floraAccountID)
+	}
+	if err := coordinator.requireRotationExecuted(ctx, *rotation); err != nil {
+		return err
+	}
+
+	epoch := rotation.Epoch
+	var transaction *hedera.TopicMessageSubmitTransaction
+	var err error
+	if rotation.ProposalSequence > 0 {
+		transaction, err = BuildFloraProposalAcceptedTx(
+			rotation.Topics.State,
+			operatorID,
+			rotation.ProposalSequence,
+			rotation.Members,
+			rotation.Threshold,
+			&epoch,
+		)
+	} else {
+		transaction, err = BuildFloraJoinAcceptedTx(rotation.Topics.State, operatorID, rotation.Members, &epoch)
+	}
+	if err != nil {
+		return err
+	}
+	accepted, err := coordinator.client.executeMemberMessage(ctx, transaction, operatorID, []shared.Signer{operatorSigner})
+	if err != nil {
+		return fmt.Errorf("failed to publish membership change: %w", err)
+	}
+	rotation.Accepted = true
+	rotation.AcceptedReceipt = accepted.Receipt
+	return nil
+}
+
+// prepareKeyRotation builds the account and topic key lists for the new
+// membership and threshold. Both lists are assembled once and shared by
+// every schedule.
+func (coordinator *FloraJoinCoordinator) prepareKeyRotation(
+	ctx context.Context,
+	state FloraState,
+	members []string,
+	threshold int,
+) (FloraKeyRotation, error) {
+	if threshold <= 0 || threshold > len(members) {
+		return FloraKeyRotation{}, fmt.Errorf("threshold %d is invalid for %d members", threshold, len(members))
+	}
+
+	keyList, err := coordinator.client.AssembleKeyList(ctx, members, threshold)
+	if err != nil {
+		return FloraKeyRotation{}, fmt.Errorf("failed to assemble flora key list: %w", err)
+	}
+	submitKeyList, err := coordinator.client.AssembleSubmitKeyList(ctx, members)
+	if err != nil {
+		return FloraKeyRotation{}, fmt.Errorf("failed to assemble flora submit key list: %w", err)
+	}
+
+	return FloraKeyRotation{
+		FloraAccountID:     state.FloraAccountID,
+		PreviousMembers:    state.MemberAccountIDs(),
+		PreviousThreshold:  state.Threshold,
+		Members:            members,
+		Threshold:          threshold,
+		Epoch:              state.Epoch + 1,
+		Topics:             state.Topics,
+		KeyList:            keyList,
+		SubmitKeyList:      submitKeyList,
+		TopicScheduleIDs:   map[FloraTopicType]string{},
+		ScheduleSignatures: map[string][]string{},
+	}, nil
+}
+
+// requireRotationExecuted checks that the account schedule and the three
+// topic schedules of rotation have all executed.
+func (coordinator *FloraJoinCoordinator) requireRotationExecuted(ctx context.Context, rotation FloraKeyRotation) error {
+	scheduleIDs := rotation.ScheduleIDs()
+	if len(scheduleIDs) != 4 {
+		return fmt.Errorf("key rotation for flora %s has %d of 4 schedules", rotation.FloraAccountID, len(scheduleIDs))
+	}
+	for _, scheduleID := range scheduleIDs {
+		schedule, err := coordinator.client.mirrorClient.GetSchedule(ctx, scheduleID)
+		if err != nil {
+			return fmt.Errorf("failed to load schedule %s: %w", scheduleID, err)
+		}
+		if schedule.Deleted {
+			return fmt.Errorf("key rotation schedule %s was deleted", scheduleID)
+		}
+		if strings.TrimSpace(schedule.ExecutedTimestamp) == "" {
+			return fmt.Errorf("%w: schedule %s expires at %s", ErrKeyRotationPending, scheduleID, schedule.ExpirationTime)
+		}
+	}
+	return nil
+}
+
+// scheduleKeyRotation creates the account and topic schedules with one
+// expiration time that they all wait for, then signs them with the
+// configured member signers.
+func (coordinator *FloraJoinCoordinator) scheduleKeyRotation(ctx context.Context, rotation *FloraKeyRotation) error {
+	rotation.ExpirationTime = time.Now().Add(coordinator.rotationDelay).Truncate(time.Second)
+
+	accountSchedule, err := BuildScheduleAccountKeyUpdateTx(rotation.FloraAccountID, rotation.KeyList, "")
+	if err != nil {
+		return err
+	}
+	accountSchedule.SetWaitForExpiry(true).SetExpirationTime(rotation.ExpirationTime)
+	rotation.AccountScheduleID, err = coordinator.client.executeScheduleCreate(ctx, accountSchedule)
+	if err != nil {
+		return fmt.Errorf("failed to schedule flora account key update: %w", err)
+	}
+
+	for _, topic := range []struct {
+		topicID   string
+		topicType FloraTopicType
+	}{
+		{rotation.Topics.Communication, FloraTopicTypeCommunication},
+		{rotation.Topics.Transaction, FloraTopicTypeTransaction},
+		{rotation.Topics.State, FloraTopicTypeState},
+	} {
+		topicSchedule, err := BuildScheduleTopicKeyUpdateTx(topic.topicID, rotation.KeyList, rotation.SubmitKeyList, "")
+		if err != nil {
+			return err
+		}
+		topicSchedule.SetWaitForExpiry(true).SetExpirationTime(rotation.ExpirationTime)
+		scheduleID, err := coordinator.client.executeScheduleCreate(ctx, topicSchedule)
+		if err != nil {
+			return fmt.Errorf("failed to schedule key update for topic %s: %w", topic.topicID, err)
+		}
+		rotation.TopicScheduleIDs[topic.topicType] = scheduleID
+	}
+
+	scheduleIDs := rotation.ScheduleIDs()
+	for _, memberAccountID := range rotation.signerOrder() {
+		if len(rotation.Signers) >= rotation.RequiredSignatures() {
+			break
+		}
+		memberSigner, ok := coordinator.memberSigners[memberAccountID]
+		if !ok {
+			continue
+		}
+		for _, scheduleID := range scheduleIDs {
+			if _, err := coordinator.client.SignScheduleWithSigner(ctx, scheduleID, memberSigner); err != nil {
+				return fmt.Errorf("member %s failed to sign schedule %s: %w", memberAccountID, scheduleID, err)
+			}
+			rotation.ScheduleSignatures[scheduleID] = append(rotation.ScheduleSignatures[scheduleID], memberAccountID)
+		}
+		rotation.Signers = append(rotation.Signers, memberAccountID)
+	}
+	return nil
+}
+
+func (coordinator *FloraJoinCoordinator) firstMemberSigner(members []string) (string, shared.Signer, bool) {
+	for _, memberAccountID := range members {
+		if memberSigner, ok := coordinator.memberSigners[memberAccountID]; ok {
+			return memberAccountID, memberSigner, true
+		}
+	}
+	return "", nil, false
+}
+
+// RequiredSignatures returns how many member signatures each schedule needs.
+// Rekeying must be authorised by both the current and the new key lists,
+// so it is the larger of the two thresholds.
+func (rotation FloraKeyRotation) RequiredSignatures() int {
+	if rotation.PreviousThreshold > rotation.Threshold {
+		return rotation.PreviousThreshold
+	}
+	return rotation.Threshold
+}
+
+// signerOrder lists current members that stay in the flora first, since
+// their signatures count towards both the current and the new key lists.
+func (rotation FloraKeyRotation) signerOrder() []string {
+	staying := map[string]bool{}
+	for _, accountID := range rotation.Members {
+		staying[accountID] = true
+	}
+	ordered := make([]string, 0, len(rotation.PreviousMembers))
+	for _, accountID := range rotation.PreviousMembers {
+		if staying[accountID] {
+			ordered = append(ordered, accountID)
+		}
+	}
+	for _, accountID := range rotation.PreviousMembers {
+		if !staying[accountID] {
+			ordered = append(ordered, accountID)
+		}
+	}
+	return ordered
+}
+
+// ScheduleIDs returns the account schedule followed by the communication,
+// transaction and state topic schedules, skipping any not yet created.
+func (rotation FloraKeyRotation) ScheduleIDs() []string {
+	scheduleIDs := make([]string, 0, 4)
+	if rotation.AccountScheduleID != "" {
+		scheduleIDs = append(scheduleIDs, rotation.AccountScheduleID)
+	}
+	for _, topicType := range []FloraTopicType{
+		FloraTopicTypeCommunication,
+		FloraTopicTypeTransaction,
+		FloraTopicTypeState,
+	} {
+		if scheduleID := rotation.TopicScheduleIDs[topicType]; scheduleID != "" {
+			scheduleIDs = append(scheduleIDs, scheduleID)
+		}
+	}
+	return scheduleIDs
+}
+
+func tallyJoinRequest(state FloraState, accountID string) (FloraJoinTally, error) {
+	request, err := findJoinRequest(state, accountID)
+	if err != nil {
+		return FloraJoinTally{}, err
+	}
+	approvals, rejections, approved, rejected, err := tallyVotes(state, request.Votes)
+	if err != nil {
+		return FloraJoinTally{}, err
+	}
+	return FloraJoinTally{
+		Request:    request,
+		Approvals:  approvals,
+		Rejections: rejections,
+		Threshold:  state.Threshold,
+		Approved:   approved,
+		Rejected:   rejected,
+	}, nil
+}
+
+// tallyVotes counts the votes of current members against the flora threshold.
+func tallyVotes(state FloraState, votes map[string]bool) ([]string, []string, bool, bool, error) {
+	if state.Threshold <= 0 {
+		return nil, nil, false, false, fmt.Errorf("flora %s has no threshold in its profile", state.FloraAccountID)
+	}
+	approvals := make([]string, 0)
+	for _, voter := range votersWith(votes, true) {
+		if state.IsMember(voter) {
+			approvals = append(approvals, voter)
+		}
+	}
+	rejections := make([]string, 0)
+	for _, voter := range votersWith(votes, false) {
+		if state.IsMember(voter) {
+			rejections = append(rejections, voter)
+		}
+	}
+	approved := len(approvals) >= state.Threshold
+	rejected := len(state.Members)-len(rejections) < state.Threshold
+	return approvals, rejections, approved, rejected, nil
+}
+
+func findJoinRequest(state FloraState, accountID string) (FloraJoinRequest, error) {
+	accountID = strings.TrimSpace(accountID)
+	if accountID == "" {
+		return FloraJoinRequest{}, fmt.Errorf("account ID is required")
+	}
+	for _, request := range state.PendingJoinRequests {
+		if request.AccountID == accountID {
+			return request, nil
+		}
+	}
+	if state.IsMember(accountID) {
+		return FloraJoinRequest{}, fmt.Errorf("account %s is already a member of flora %s", accountID, state.FloraAccountID)
+	}
+	return FloraJoinRequest{}, fmt.Errorf("no pending join request for %s on flora %s", accountID, state.FloraAccountID)
+}
+
+func (c *Client) executeScheduleCreate(
+	ctx context.Context,
+	transaction *hedera.ScheduleCreateTransaction,
+) (string, error) {
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute schedule create transaction: %w", err)
+	}
+	receipt := executed.Receipt
+	if receipt.ScheduleID == nil {
+		return "", fmt.Errorf("schedule create receipt did not include a schedule ID")
+	}
+	return receipt.ScheduleID.String(), nil
+}
@@ -0,0 +1,233 @@
+package hcs16
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func joinCommunication() []any {
+	return []any{
+		map[string]any{"p": "hcs-16", "op": "flora_created", "operator_id": "0.0.801", "flora_account_id": "0.0.900"},
+		map[string]any{"p": "hcs-16", "op": "flora_join_request", "operator_id": "0.0.803", "account_id": "0.0.803", "connection_request_id": 7, "connection_topic_id": "0.0.700", "connection_seq": 3},
+		map[string]any{"p": "hcs-16", "op": "flora_join_request", "operator_id": "0.0.804", "account_id": "0.0.804", "connection_request_id": 8, "connection_topic_id": "0.0.701", "connection_seq": 4},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.801", "account_id": "0.0.803", "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.801", "account_id": "0.0.804", "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.802", "account_id": "0.0.804", "approve": false},
+	}
+}
+
+func memberAccountResponses(t *testing.T, accountIDs ...string) map[string]any {
+	t.Helper()
+	responses := map[string]any{}
+	for _, accountID := range accountIDs {
+		privateKey, err := hedera.PrivateKeyGenerateEd25519()
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		responses["/api/v1/accounts/"+accountID] = map[string]any{
+			"account": accountID,
+			"key":     map[string]any{"key": privateKey.PublicKey().String()},
+		}
+	}
+	return responses
+}
+
+func TestNewFloraJoinCoordinatorValidation(t *testing.T) {
+	if _, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{FloraAccountID: "0.0.900"}); err == nil {
+		t.Fatalf("expected error for missing client")
+	}
+	client := newFloraStateClient(t, "http://127.0.0.1:1")
+	if _, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{Client: client}); err == nil {
+		t.Fatalf("expected error for missing flora account ID")
+	}
+	if _, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{
+		Client:         client,
+		FloraAccountID: "0.0.900",
+		MemberSigners:  map[string]shared.Signer{"0.0.801": nil},
+	}); err == nil {
+		t.Fatalf("expected error for a nil member signer")
+	}
+	if _, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{
+		Client:         client,
+		FloraAccountID: "0.0.900",
+		RotationDelay:  -time.Minute,
+	}); err == nil {
+		t.Fatalf("expected error for a negative rotation delay")
+	}
+	client.executionMode = shared.ExecutionModeEnvelope
+	if _, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{Client: client, FloraAccountID: "0.0.900"}); err == nil {
+		t.Fatalf("expected error in envelope mode")
+	}
+}
+
+func TestFloraJoinCoordinatorTally(t *testing.T) {
+	server := newFloraMirror(t, defaultFloraProfile(), joinCommunication(), nil, defaultFloraMemos(), nil)
+	defer server.Close()
+
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	coordinator, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{
+		Client:         newFloraStateClient(t, server.URL),
+		FloraAccountID: "0.0.900",
+		MemberSigners:  map[string]shared.Signer{" 0.0.801 ": shared.NewLocalSigner(memberKey)},
+	})
+	if err != nil {
+		t.Fatalf("NewFloraJoinCoordinator failed: %v", err)
+	}
+
+	tally, err := coordinator.Tally(context.Background(), "0.0.803")
+	if err != nil {
+		t.Fatalf("Tally failed: %v", err)
+	}
+	if tally.Approved || tally.Rejected || len(tally.Approvals) != 1 || tally.Threshold != 2 {
+		t.Fatalf("unexpected tally %+v", tally)
+	}
+
+	tally, err = coordinator.Tally(context.Background(), "0.0.804")
+	if err != nil {
+		t.Fatalf("Tally failed: %v", err)
+	}
+	if tally.Approved || !tally.Rejected {
+		t.Fatalf("expected request to be rejected, got %+v", tally)
+	}
+
+	if _, err := coordinator.Tally(context.Background(), "0.0.801"); err == nil || !strings.Contains(err.Error(), "already a member") {
+		t.Fatalf("expected already-a-member error, got %v", err)
+	}
+	if _, err := coordinator.Tally(context.Background(), "0.0.805"); err == nil {
+		t.Fatalf("expected error for unknown join request")
+	}
+	if _, err := coordinator.Finalize(context.Background(), "0.0.803"); err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Fatalf("expected finalize to require approval, got %v", err)
+	}
+	if _, err := coordinator.Vote(context.Background(), "0.0.803", "0.0.802", true); err == nil {
+		t.Fatalf("expected error voting without the member's key")
+	}
+}
+
+func TestFloraJoinCoordinatorPrepareKeyRotation(t *testing.T) {
+	server := newFloraMirror(
+		t,
+		defaultFloraProfile(),
+		nil,
+		nil,
+		defaultFloraMemos(),
+		memberAccountResponses(t, "0.0.801", "0.0.802", "0.0.803"),
+	)
+	defer server.Close()
+
+	client := newFloraStateClient(t, server.URL)
+	coordinator, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{Client: client, FloraAccountID: "0.0.900"})
+	if err != nil {
+		t.Fatalf("NewFloraJoinCoordinator failed: %v", err)
+	}
+	state, err := client.LoadFlora(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("LoadFlora failed: %v", err)
+	}
+	state.Epoch = 4
+
+	members := append(state.MemberAccountIDs(), "0.0.803")
+	rotation, err := coordinator.prepareKeyRotation(context.Background(), state, members, state.Threshold)
+	if err != nil {
+		t.Fatalf("prepareKeyRotation failed: %v", err)
+	}
+	if strings.Join(rotation.Members, ",") != "0.0.801,0.0.802,0.0.803" {
+		t.Fatalf("unexpected members %v", rotation.Members)
+	}
+	if strings.Join(rotation.PreviousMembers, ",") != "0.0.801,0.0.802" {
+		t.Fatalf("unexpected previous members %v", rotation.PreviousMembers)
+	}
+	if rotation.Epoch != 5 || rotation.Threshold != 2 || rotation.Topics.State != "0.0.903" {
+		t.Fatalf("unexpected rotation %+v", rotation)
+	}
+	if rotation.KeyList == nil || rotation.SubmitKeyList == nil {
+		t.Fatalf("expected key lists to be assembled")
+	}
+	if rotation.RequiredSignatures() != 2 {
+		t.Fatalf("unexpected required signatures %d", rotation.RequiredSignatures())
+	}
+	if len(rotation.ScheduleIDs()) != 0 {
+		t.Fatalf("expected no schedules before submission")
+	}
+
+	rotation.AccountScheduleID = "0.0.1"
+	rotation.TopicScheduleIDs[FloraTopicTypeState] = "0.0.4"
+	rotation.TopicScheduleIDs[FloraTopicTypeCommunication] = "0.0.2"
+	if got := strings.Join(rotation.ScheduleIDs(), ","); got != "0.0.1,0.0.2,0.0.4" {
+		t.Fatalf("unexpected schedule order %s", got)
+	}
+
+	if err := coordinator.Accept(context.Background(), &rotation); err == nil {
+		t.Fatalf("expected accept to require a member signer")
+	}
+	if err := coordinator.Accept(context.Background(), nil); err == nil {
+		t.Fatalf("expected error for nil rotation")
+	}
+}
+
+func TestFloraJoinCoordinatorAcceptWaitsForSchedules(t *testing.T) {
+	memberKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	extra := map[string]any{
+		"/api/v1/schedules/0.0.7101": map[string]any{"schedule_id": "0.0.7101", "executed_timestamp": "1700000500.000000000"},
+		"/api/v1/schedules/0.0.7102": map[string]any{"schedule_id": "0.0.7102", "executed_timestamp": "1700000500.000000000"},
+		"/api/v1/schedules/0.0.7103": map[string]any{
+			"schedule_id":        "0.0.7103",
+			"executed_timestamp": nil,
+			"expiration_time":    "1700001800.000000000",
+		},
+		"/api/v1/schedules/0.0.7104": map[string]any{"schedule_id": "0.0.7104", "executed_timestamp": "1700000500.000000000"},
+		"/api/v1/schedules/0.0.7105": map[string]any{"schedule_id": "0.0.7105", "deleted": true},
+	}
+	server := newFloraMirror(t, defaultFloraProfile(), nil, nil, defaultFloraMemos(), extra)
+	defer server.Close()
+
+	coordinator, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{
+		Client:         newFloraStateClient(t, server.URL),
+		FloraAccountID: "0.0.900",
+		MemberSigners:  map[string]shared.Signer{"0.0.801": shared.NewLocalSigner(memberKey)},
+	})
+	if err != nil {
+		t.Fatalf("NewFloraJoinCoordinator failed: %v", err)
+	}
+
+	rotation := FloraKeyRotation{
+		FloraAccountID:    "0.0.900",
+		PreviousMembers:   []string{"0.0.801", "0.0.802"},
+		Members:           []string{"0.0.801", "0.0.802", "0.0.803"},
+		Threshold:         2,
+		Epoch:             5,
+		AccountScheduleID: "0.0.7101",
+		TopicScheduleIDs: map[FloraTopicType]string{
+			FloraTopicTypeCommunication: "0.0.7102",
+			FloraTopicTypeTransaction:   "0.0.7103",
+		},
+	}
+	if err := coordinator.Accept(context.Background(), &rotation); err == nil || !strings.Contains(err.Error(), "3 of 4") {
+		t.Fatalf("expected error for a missing schedule, got %v", err)
+	}
+
+	rotation.TopicScheduleIDs[FloraTopicTypeState] = "0.0.7104"
+	err = coordinator.Accept(context.Background(), &rotation)
+	if !errors.Is(err, ErrKeyRotationPending) || !strings.Contains(err.Error(), "0.0.7103") {
+		t.Fatalf("expected pending rotation error, got %v", err)
+	}
+	if rotation.Accepted {
+		t.Fatalf("expected rotation to stay unaccepted")
+	}
+
+	rotation.TopicScheduleIDs[FloraTopicTypeTransaction] = "0.0.7105"
+	err = coordinator.Accept(context.Background(), &rotation)
+	if err == nil || errors.Is(err, ErrKeyRotationPending) || !strings.Contains(err.Error(), "deleted") {
+		t.Fatalf("expected deleted schedule error, got %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+package hcs16
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+// ProposeRemoval publishes a proposal from proposerAccountID to remove
+// accountID. Proposing one's own removal is a voluntary leave. The flora
+// must still be able to meet its threshold without the member, so lower the
+// threshold first when it cannot.
+func (coordinator *FloraJoinCoordinator) ProposeRemoval(
+	ctx context.Context,
+	accountID string,
+	proposerAccountID string,
+	reason string,
+) (FloraTransactionResult, error) {
+	state, proposerID, proposerSigner, err := coordinator.loadForMember(ctx, proposerAccountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	accountID = strings.TrimSpace(accountID)
+	if !state.IsMember(accountID) {
+		return FloraTransactionResult{}, fmt.Errorf("account %s is not a member of flora %s", accountID, state.FloraAccountID)
+	}
+	if len(state.Members)-1 < state.Threshold {
+		return FloraTransactionResult{}, fmt.Errorf(
+			"removing %s would leave %d members below the threshold of %d",
+			accountID,
+			len(state.Members)-1,
+			state.Threshold,
+		)
+	}
+
+	transaction, err := BuildFloraRemoveProposalTx(state.Topics.Communication, proposerID, accountID, reason)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return coordinator.client.executeMemberMessage(ctx, transaction, proposerID, []shared.Signer{proposerSigner})
+}
+
+// ProposeThreshold publishes a proposal from proposerAccountID to change the
+// flora threshold.
+func (coordinator *FloraJoinCoordinator) ProposeThreshold(
+	ctx context.Context,
+	threshold int,
+	proposerAccountID string,
+	reason string,
+) (FloraTransactionResult, error) {
+	state, proposerID, proposerSigner, err := coordinator.loadForMember(ctx, proposerAccountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	if threshold <= 0 || threshold > len(state.Members) {
+		return FloraTransactionResult{}, fmt.Errorf("threshold %d is invalid for %d members", threshold, len(state.Members))
+	}
+	if threshold == state.Threshold {
+		return FloraTransactionResult{}, fmt.Errorf("flora %s already has threshold %d", state.FloraAccountID, threshold)
+	}
+
+	transaction, err := BuildFloraThresholdProposalTx(state.Topics.Communication, proposerID, threshold, reason)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return coordinator.client.executeMemberMessage(ctx, transaction, proposerID, []shared.Signer{proposerSigner})
+}
+
+// VoteProposal publishes a vote from memberAccountID on the proposal
+// published at proposalSequence.
+func (coordinator *FloraJoinCoordinator) VoteProposal(
+	ctx context.Context,
+	proposalSequence int64,
+	memberAccountID string,
+	approve bool,
+) (FloraTransactionResult, error) {
+	state, memberID, memberSigner, err := coordinator.loadForMember(ctx, memberAccountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	if _, err := findProposal(state, proposalSequence); err != nil {
+		return FloraTransactionResult{}, err
+	}
+
+	transaction, err := BuildFloraProposalVoteTx(state.Topics.Communication, memberID, proposalSequence, approve)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return coordinator.client.executeMemberMessage(ctx, transaction, memberID, []shared.Signer{memberSigner})
+}
+
+// TallyProposal counts the current members' votes on an open proposal. As
+// in LoadFlora, a vote only counts when the voting member paid for it.
+func (coordinator *FloraJoinCoordinator) TallyProposal(
+	ctx context.Context,
+	proposalSequence int64,
+) (FloraProposalTally, error) {
+	state, err := coordinator.client.LoadFlora(ctx, coordinator.floraAccountID)
+	if err != nil {
+		return FloraProposalTally{}, err
+	}
+	return tallyProposal(state, proposalSequence)
+}
+
+// FinalizeProposal starts an approved proposal, tallied like
+// TallyProposal. It schedules the rekeying of the flora account and topics
+// to the resulting membership and threshold and signs the schedules with the
+// configured member signers. As with Finalize, the caller passes the
+// returned rotation to Accept once the schedules have executed, which posts
+// flora_proposal_accepted with the next epoch.
+func (coordinator *FloraJoinCoordinator) FinalizeProposal(
+	ctx context.Context,
+	proposalSequence int64,
+) (FloraKeyRotation, error) {
+	state, err := coordinator.client.LoadFlora(ctx, coordinator.floraAccountID)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	tally, err := tallyProposal(state, proposalSequence)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	if !tally.Approved {
+		return FloraKeyRotation{}, fmt.Errorf(
+			"proposal %d has %d of %d required approvals",
+			proposalSequence,
+			len(tally.Approvals),
+			tally.Threshold,
+		)
+	}
+
+	members, threshold := proposalOutcome(state, tally.Proposal)
+	rotation, err := coordinator.prepareKeyRotation(ctx, state, members, threshold)
+	if err != nil {
+		return FloraKeyRotation{}, err
+	}
+	rotation.AccountID = tally.Proposal.AccountID
+	rotation.ProposalSequence = proposalSequence
+	err = coordinator.scheduleKeyRotation(ctx, &rotation)
+	return rotation, err
+}
+
+func (coordinator *FloraJoinCoordinator) loadForMember(
+	ctx context.Context,
+	memberAccountID string,
+) (FloraState, string, shared.Signer, error) {
+	memberAccountID = strings.TrimSpace(memberAccountID)
+	memberSigner, ok := coordinator.memberSigners[memberAccountID]
+	if !ok {
+		return FloraState{}, "", nil, fmt.Errorf("no signer configured for member %s", memberAccountID)
+	}
+	state, err := coordinator.client.LoadFlora(ctx, coordinator.floraAccountID)
+	if err != nil {
+		return FloraState{}, "", nil, err
+	}
+	if !state.IsMember(memberAccountID) {
+		return FloraState{}, "", nil, fmt.Errorf(
+			"account %s is not a member of flora %s",
+			memberAccountID,
+			state.FloraAccountID,
+		)
+	}
+	return state, memberAccountID, memberSigner, nil
+}
+
+// proposalOutcome returns the membership and threshold that result from
+// applying proposal to state.
+func proposalOutcome(state FloraState, proposal FloraProposal) ([]string, int) {
+	members := state.MemberAccountIDs()
+	switch proposal.Type {
+	case FloraProposalTypeRemoveMember:
+		remaining := make([]string, 0, len(members))
+		for _, accountID := range members {
+			if accountID != proposal.AccountID {
+				remaining = append(remaining, accountID)
+			}
+		}
+		return remaining, state.Threshold
+	case FloraProposalTypeThreshold:
+		return members, proposal.Threshold
+	default:
+		return members, state.Threshold
+	}
+}
+
+func tallyProposal(state FloraState, proposalSequence int64) (FloraProposalTally, error) {
+	proposal, err := findProposal(state, proposalSequence)
+	if err != nil {
+		return FloraProposalTally{}, err
+	}
+	approvals, rejections, approved, rejected, err := tallyVotes(state, proposal.Votes)
+	if err != nil {
+		return FloraProposalTally{}, err
+	}
+	return FloraProposalTally{
+		Proposal:   proposal,
+		Approvals:  approvals,
+		Rejections: rejections,
+		Threshold:  state.Threshold,
+		Approved:   approved,
+		Rejected:   rejected,
+	}, nil
+}
+
+func findProposal(state FloraState, proposalSequence int64) (FloraProposal, error) {
+	for _, proposal := range state.PendingProposals {
+		if proposal.SequenceNumber == proposalSequence {
+			return proposal, nil
+		}
+	}
+	return FloraProposal{}, fmt.Errorf("no open proposal %d on flora %s", proposalSequence, state.FloraAccountID)
+}
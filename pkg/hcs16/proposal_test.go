@@ -0,0 +1,211 @@
+package hcs16
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func threeMemberFloraProfile() map[string]any {
+	profile := defaultFloraProfile()
+	profile["members"] = []map[string]any{
+		{"accountId": "0.0.801"},
+		{"accountId": "0.0.802"},
+		{"accountId": "0.0.803"},
+	}
+	return profile
+}
+
+func proposalCommunication() []any {
+	return []any{
+		map[string]any{"p": "hcs-16", "op": "flora_created", "operator_id": "0.0.801", "flora_account_id": "0.0.900"},
+		map[string]any{"p": "hcs-16", "op": "flora_remove_proposal", "operator_id": "0.0.801", "account_id": "0.0.803", "reason": "inactive"},
+		map[string]any{"p": "hcs-16", "op": "flora_threshold_proposal", "operator_id": "0.0.802", "threshold": 3},
+		map[string]any{"p": "hcs-16", "op": "flora_proposal_vote", "operator_id": "0.0.801", "proposal_seq": 2, "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_proposal_vote", "operator_id": "0.0.802", "proposal_seq": 2, "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_proposal_vote", "operator_id": "0.0.803", "proposal_seq": 3, "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_proposal_vote", "operator_id": "0.0.999", "proposal_seq": 3, "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_remove_proposal", "operator_id": "0.0.999", "account_id": "0.0.801"},
+		// 0.0.801 forges 0.0.802's approval of the threshold change.
+		paidPayload{payer: "0.0.801", body: map[string]any{
+			"p": "hcs-16", "op": "flora_proposal_vote", "operator_id": "0.0.802", "proposal_seq": 3, "approve": true,
+		}},
+	}
+}
+
+func TestLoadFloraAppliesAcceptedProposals(t *testing.T) {
+	state := []any{
+		// Proposal 3 has a single approval, so its acceptance is ignored.
+		timedPayload{seconds: 1700000090, body: map[string]any{
+			"p": "hcs-16", "op": "flora_proposal_accepted", "operator_id": "0.0.802",
+			"proposal_seq": 3, "members": []string{"0.0.801", "0.0.802", "0.0.803"}, "threshold": 3, "epoch": 1,
+		}},
+		// The listed membership and threshold are ignored in favour of the
+		// outcome of the approved removal.
+		timedPayload{seconds: 1700000100, body: map[string]any{
+			"p": "hcs-16", "op": "flora_proposal_accepted", "operator_id": "0.0.801",
+			"proposal_seq": 2, "members": []string{"0.0.801", "0.0.802", "0.0.999"}, "threshold": 1, "epoch": 1,
+		}},
+	}
+	server := newFloraMirror(t, threeMemberFloraProfile(), proposalCommunication(), state, defaultFloraMemos(), nil)
+	defer server.Close()
+
+	flora, err := newFloraStateClient(t, server.URL).LoadFlora(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("LoadFlora failed: %v", err)
+	}
+	if got := strings.Join(flora.MemberAccountIDs(), ","); got != "0.0.801,0.0.802" || flora.Epoch != 1 {
+		t.Fatalf("unexpected membership %s at epoch %d", got, flora.Epoch)
+	}
+	if flora.Threshold != 2 {
+		t.Fatalf("expected threshold 2, got %d", flora.Threshold)
+	}
+	if len(flora.PendingProposals) != 1 {
+		t.Fatalf("expected one open proposal, got %d", len(flora.PendingProposals))
+	}
+	open := flora.PendingProposals[0]
+	if open.Type != FloraProposalTypeThreshold || open.Threshold != 3 || open.SequenceNumber != 3 {
+		t.Fatalf("unexpected open proposal %+v", open)
+	}
+
+	// 0.0.803's vote was cast before it was removed and no longer counts.
+	tally, err := tallyProposal(flora, 3)
+	if err != nil {
+		t.Fatalf("tallyProposal failed: %v", err)
+	}
+	if len(tally.Approvals) != 0 || tally.Approved {
+		t.Fatalf("expected removed member's vote to be ignored, got %+v", tally)
+	}
+	if flora.LastStateSequence != 2 || flora.LastCommunicationSequence != 8 {
+		t.Fatalf("unexpected sequences %d, %d", flora.LastCommunicationSequence, flora.LastStateSequence)
+	}
+}
+
+func TestFloraJoinCoordinatorProposals(t *testing.T) {
+	server := newFloraMirror(
+		t,
+		threeMemberFloraProfile(),
+		proposalCommunication(),
+		nil,
+		defaultFloraMemos(),
+		memberAccountResponses(t, "0.0.801", "0.0.802", "0.0.803"),
+	)
+	defer server.Close()
+
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	client := newFloraStateClient(t, server.URL)
+	coordinator, err := NewFloraJoinCoordinator(FloraJoinCoordinatorConfig{
+		Client:         client,
+		FloraAccountID: "0.0.900",
+		MemberSigners:  map[string]shared.Signer{"0.0.801": shared.NewLocalSigner(memberKey)},
+	})
+	if err != nil {
+		t.Fatalf("NewFloraJoinCoordinator failed: %v", err)
+	}
+	ctx := context.Background()
+
+	removal, err := coordinator.TallyProposal(ctx, 2)
+	if err != nil {
+		t.Fatalf("TallyProposal failed: %v", err)
+	}
+	if !removal.Approved || removal.Proposal.AccountID != "0.0.803" || removal.Proposal.Reason != "inactive" {
+		t.Fatalf("unexpected removal tally %+v", removal)
+	}
+	threshold, err := coordinator.TallyProposal(ctx, 3)
+	if err != nil {
+		t.Fatalf("TallyProposal failed: %v", err)
+	}
+	if threshold.Approved || len(threshold.Approvals) != 1 {
+		t.Fatalf("unexpected threshold tally %+v", threshold)
+	}
+	if _, err := coordinator.FinalizeProposal(ctx, 3); err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Fatalf("expected finalize to require approval, got %v", err)
+	}
+	if _, err := coordinator.TallyProposal(ctx, 8); err == nil {
+		t.Fatalf("expected non-member proposal to be ignored")
+	}
+
+	if _, err := coordinator.ProposeThreshold(ctx, 4, "0.0.801", ""); err == nil {
+		t.Fatalf("expected error for threshold above member count")
+	}
+	if _, err := coordinator.ProposeThreshold(ctx, 2, "0.0.801", ""); err == nil {
+		t.Fatalf("expected error for unchanged threshold")
+	}
+	if _, err := coordinator.ProposeRemoval(ctx, "0.0.804", "0.0.801", ""); err == nil {
+		t.Fatalf("expected error removing a non-member")
+	}
+	if _, err := coordinator.ProposeRemoval(ctx, "0.0.803", "0.0.802", ""); err == nil {
+		t.Fatalf("expected error proposing without the member's key")
+	}
+	if _, err := coordinator.VoteProposal(ctx, 99, "0.0.801", true); err == nil {
+		t.Fatalf("expected error voting on an unknown proposal")
+	}
+
+	flora, err := client.LoadFlora(ctx, "0.0.900")
+	if err != nil {
+		t.Fatalf("LoadFlora failed: %v", err)
+	}
+	members, newThreshold := proposalOutcome(flora, removal.Proposal)
+	rotation, err := coordinator.prepareKeyRotation(ctx, flora, members, newThreshold)
+	if err != nil {
+		t.Fatalf("prepareKeyRotation failed: %v", err)
+	}
+	if strings.Join(rotation.Members, ",") != "0.0.801,0.0.802" || rotation.Threshold != 2 {
+		t.Fatalf("unexpected removal rotation %+v", rotation)
+	}
+	if got := strings.Join(rotation.signerOrder(), ","); got != "0.0.801,0.0.802,0.0.803" {
+		t.Fatalf("unexpected signer order %s", got)
+	}
+
+	members, newThreshold = proposalOutcome(flora, threshold.Proposal)
+	rotation, err = coordinator.prepareKeyRotation(ctx, flora, members, newThreshold)
+	if err != nil {
+		t.Fatalf("prepareKeyRotation failed: %v", err)
+	}
+	if rotation.PreviousThreshold != 2 || rotation.RequiredSignatures() != 3 {
+		t.Fatalf("expected raised threshold to require 3 signatures, got %d", rotation.RequiredSignatures())
+	}
+	if _, err := coordinator.prepareKeyRotation(ctx, flora, []string{"0.0.801"}, 2); err == nil {
+		t.Fatalf("expected error for threshold above member count")
+	}
+}
+
+func TestBuildFloraProposalTxs(t *testing.T) {
+	transaction, err := BuildFloraRemoveProposalTx("0.0.901", "0.0.801", "0.0.803", " inactive ")
+	if err != nil {
+		t.Fatalf("BuildFloraRemoveProposalTx failed: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(transaction.GetMessage(), &payload); err != nil {
+		t.Fatalf("failed to decode message payload: %v", err)
+	}
+	if payload["op"] != string(FloraOperationRemoveProposal) || payload["reason"] != "inactive" {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+
+	accepted, err := BuildFloraProposalAcceptedTx("0.0.903", "0.0.801", 2, []string{"0.0.801", "0.0.802"}, 2, nil)
+	if err != nil {
+		t.Fatalf("BuildFloraProposalAcceptedTx failed: %v", err)
+	}
+	if memo := accepted.GetTransactionMemo(); memo != "hcs-16:op:9:2" {
+		t.Fatalf("unexpected transaction memo %s", memo)
+	}
+
+	if _, err := BuildFloraRemoveProposalTx("0.0.901", "0.0.801", " ", ""); err == nil {
+		t.Fatalf("expected error for missing account ID")
+	}
+	if _, err := BuildFloraThresholdProposalTx("0.0.901", "0.0.801", 0, ""); err == nil {
+		t.Fatalf("expected error for non-positive threshold")
+	}
+	if _, err := BuildFloraProposalVoteTx("0.0.901", "0.0.801", 0, true); err == nil {
+		t.Fatalf("expected error for missing proposal sequence")
+	}
+	if _, err := BuildFloraProposalAcceptedTx("0.0.903", "0.0.801", 2, []string{"0.0.801"}, 2, nil); err == nil {
+		t.Fatalf("expected error for threshold above member count")
+	}
+}
@@ -0,0 +1,495 @@
+package hcs16
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs11"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+// LoadFlora reconstructs a flora's current state. It resolves the HCS-11
+// profile referenced by the flora account memo, checks that the profile
+// topics carry this flora's HCS-16 memos, then replays the communication and
+// state topics: creation, join requests, membership and threshold proposals,
+// votes, acceptances and HCS-17 state hashes. A join acceptance only adds
+// accounts whose request current members approved up to the threshold, and
+// a proposal acceptance applies the outcome of the approved proposal rather
+// than the membership or threshold the message lists.
+//
+// Any member key can submit to the flora topics, so an HCS-16 message only
+// counts when it was paid for by the account named in its operator_id; a
+// member cannot vote, propose or accept on behalf of another. Likewise an
+// HCS-17 state hash must be paid for by a member or the account it is for,
+// and only counts as that account's state hash when the account paid for it.
+func (c *Client) LoadFlora(ctx context.Context, floraAccountID string) (FloraState, error) {
+	floraAccountID = strings.TrimSpace(floraAccountID)
+	if floraAccountID == "" {
+		return FloraState{}, fmt.Errorf("flora account ID is required")
+	}
+
+	account, err := c.mirrorClient.GetAccount(ctx, floraAccountID)
+	if err != nil {
+		return FloraState{}, fmt.Errorf("failed to load flora account %s: %w", floraAccountID, err)
+	}
+	profileTopicID, err := parseFloraProfileMemo(account.Memo)
+	if err != nil {
+		return FloraState{}, fmt.Errorf("flora account %s: %w", floraAccountID, err)
+	}
+	profile, err := c.fetchFloraProfile(ctx, profileTopicID)
+	if err != nil {
+		return FloraState{}, err
+	}
+	if profile.Type != hcs11.ProfileTypeFlora {
+		return FloraState{}, fmt.Errorf("profile %s is not a flora profile", profileTopicID)
+	}
+	if profile.Topics == nil {
+		return FloraState{}, fmt.Errorf("flora profile %s does not list its topics", profileTopicID)
+	}
+
+	state := FloraState{
+		FloraAccountID: floraAccountID,
+		ProfileTopicID: profileTopicID,
+		DisplayName:    profile.DisplayName,
+		Topics: FloraTopics{
+			Communication: strings.TrimSpace(profile.Topics.Communication),
+			Transaction:   strings.TrimSpace(profile.Topics.Transaction),
+			State:         strings.TrimSpace(profile.Topics.State),
+		},
+		Threshold: profile.Threshold,
+	}
+	for _, member := range profile.Members {
+		state.Members = append(state.Members, FloraMember{
+			AccountID: strings.TrimSpace(member.AccountID),
+			PublicKey: member.PublicKey,
+			Weight:    member.Weight,
+		})
+	}
+
+	for _, topic := range []struct {
+		topicID   string
+		topicType FloraTopicType
+	}{
+		{state.Topics.Communication, FloraTopicTypeCommunication},
+		{state.Topics.Transaction, FloraTopicTypeTransaction},
+		{state.Topics.State, FloraTopicTypeState},
+	} {
+		if err := c.verifyFloraTopic(ctx, floraAccountID, topic.topicID, topic.topicType); err != nil {
+			return FloraState{}, err
+		}
+	}
+
+	communication, err := c.mirrorClient.GetTopicMessages(ctx, state.Topics.Communication, mirror.MessageQueryOptions{
+		Order: "asc",
+	})
+	if err != nil {
+		return FloraState{}, fmt.Errorf("failed to read flora communication topic: %w", err)
+	}
+	stateMessages, err := c.mirrorClient.GetTopicMessages(ctx, state.Topics.State, mirror.MessageQueryOptions{
+		Order: "asc",
+	})
+	if err != nil {
+		return FloraState{}, fmt.Errorf("failed to read flora state topic: %w", err)
+	}
+	replayFloraTopics(&state, communication, stateMessages)
+
+	return state, nil
+}
+
+// IsMember reports whether accountID is a current flora member.
+func (state FloraState) IsMember(accountID string) bool {
+	accountID = strings.TrimSpace(accountID)
+	for _, member := range state.Members {
+		if member.AccountID == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberAccountIDs returns the current member account IDs in order.
+func (state FloraState) MemberAccountIDs() []string {
+	accountIDs := make([]string, 0, len(state.Members))
+	for _, member := range state.Members {
+		accountIDs = append(accountIDs, member.AccountID)
+	}
+	return accountIDs
+}
+
+// Approvals returns the members that voted to approve the request, sorted.
+func (request FloraJoinRequest) Approvals() []string {
+	return votersWith(request.Votes, true)
+}
+
+// Rejections returns the members that voted to reject the request, sorted.
+func (request FloraJoinRequest) Rejections() []string {
+	return votersWith(request.Votes, false)
+}
+
+// Approvals returns the members that voted to approve the proposal, sorted.
+func (proposal FloraProposal) Approvals() []string {
+	return votersWith(proposal.Votes, true)
+}
+
+// Rejections returns the members that voted to reject the proposal, sorted.
+func (proposal FloraProposal) Rejections() []string {
+	return votersWith(proposal.Votes, false)
+}
+
+func votersWith(votes map[string]bool, approve bool) []string {
+	voters := make([]string, 0, len(votes))
+	for voter, vote := range votes {
+		if vote == approve {
+			voters = append(voters, voter)
+		}
+	}
+	sort.Strings(voters)
+	return voters
+}
+
+// floraWireMessage covers the HCS-16 messages and the HCS-17 state hash
+// messages that appear on flora topics.
+type floraWireMessage struct {
+	Protocol            string          `json:"p"`
+	Operation           string          `json:"op"`
+	OperatorID          string          `json:"operator_id"`
+	AccountID           string          `json:"account_id"`
+	Approve             *bool           `json:"approve"`
+	ConnectionRequestID int64           `json:"connection_request_id"`
+	ConnectionTopicID   string          `json:"connection_topic_id"`
+	ConnectionSequence  int64           `json:"connection_seq"`
+	Members             []string        `json:"members"`
+	Epoch               *int64          `json:"epoch"`
+	Threshold           *int            `json:"threshold"`
+	ProposalSequence    int64           `json:"proposal_seq"`
+	Reason              string          `json:"reason"`
+	StateHash           string          `json:"state_hash"`
+	Topics              json.RawMessage `json:"topics"`
+	Timestamp           string          `json:"timestamp"`
+	Memo                string          `json:"m"`
+}
+
+type floraReplayItem struct {
+	message   mirror.TopicMessage
+	fromState bool
+}
+
+type floraReplay struct {
+	state         *FloraState
+	joinRequests  map[string]*FloraJoinRequest
+	joinOrder     []string
+	proposals     map[int64]*FloraProposal
+	proposalOrder []int64
+}
+
+// replayFloraTopics applies the communication and state topic messages in
+// consensus order. Acceptance messages are honoured on either topic.
+func replayFloraTopics(state *FloraState, communication []mirror.TopicMessage, stateMessages []mirror.TopicMessage) {
+	items := make([]floraReplayItem, 0, len(communication)+len(stateMessages))
+	for _, item := range communication {
+		items = append(items, floraReplayItem{message: item})
+	}
+	for _, item := range stateMessages {
+		items = append(items, floraReplayItem{message: item, fromState: true})
+	}
+	sort.SliceStable(items, func(left, right int) bool {
+		return consensusTimestampBefore(items[left].message.ConsensusTimestamp, items[right].message.ConsensusTimestamp)
+	})
+
+	replay := &floraReplay{
+		state:        state,
+		joinRequests: map[string]*FloraJoinRequest{},
+		proposals:    map[int64]*FloraProposal{},
+	}
+	for _, item := range items {
+		var message floraWireMessage
+		if err := mirror.DecodeMessageJSON(item.message, &message); err != nil {
+			continue
+		}
+		switch {
+		case message.Protocol == "hcs-16" && paidByOperator(item.message, message):
+			replay.applyFloraMessage(item.message, message)
+		case message.Protocol == "hcs-17" && item.fromState && replay.paidByMemberOrAccount(item.message, message):
+			replay.applyStateHash(item.message, message)
+		default:
+			continue
+		}
+		if item.fromState {
+			state.LastStateSequence = item.message.SequenceNumber
+		} else {
+			state.LastCommunicationSequence = item.message.SequenceNumber
+		}
+	}
+
+	state.PendingJoinRequests = nil
+	for _, accountID := range replay.joinOrder {
+		if request, exists := replay.joinRequests[accountID]; exists {
+			state.PendingJoinRequests = append(state.PendingJoinRequests, *request)
+		}
+	}
+	state.PendingProposals = nil
+	for _, sequence := range replay.proposalOrder {
+		if proposal, exists := replay.proposals[sequence]; exists {
+			state.PendingProposals = append(state.PendingProposals, *proposal)
+		}
+	}
+}
+
+// paidByOperator reports whether message was paid for by the account in its
+// operator_id.
+func paidByOperator(item mirror.TopicMessage, message floraWireMessage) bool {
+	operatorID := strings.TrimSpace(message.OperatorID)
+	return operatorID != "" && operatorID == strings.TrimSpace(item.PayerAccountID)
+}
+
+func (replay *floraReplay) applyFloraMessage(item mirror.TopicMessage, message floraWireMessage) {
+	state := replay.state
+	operatorID := strings.TrimSpace(message.OperatorID)
+
+	switch FloraOperation(message.Operation) {
+	case FloraOperationFloraCreated:
+		if state.CreatedBy == "" {
+			state.CreatedBy = operatorID
+			state.CreatedAt = item.ConsensusTimestamp
+		}
+	case FloraOperationJoinRequest:
+		accountID := strings.TrimSpace(message.AccountID)
+		if accountID == "" || state.IsMember(accountID) {
+			return
+		}
+		if _, exists := replay.joinRequests[accountID]; !exists {
+			replay.joinOrder = append(replay.joinOrder, accountID)
+		}
+		replay.joinRequests[accountID] = &FloraJoinRequest{
+			AccountID:           accountID,
+			RequestedBy:         operatorID,
+			ConnectionRequestID: message.ConnectionRequestID,
+			ConnectionTopicID:   strings.TrimSpace(message.ConnectionTopicID),
+			ConnectionSequence:  message.ConnectionSequence,
+			SequenceNumber:      item.SequenceNumber,
+			ConsensusTimestamp:  item.ConsensusTimestamp,
+			Votes:               map[string]bool{},
+		}
+	case FloraOperationJoinVote:
+		request, exists := replay.joinRequests[strings.TrimSpace(message.AccountID)]
+		if !exists || message.Approve == nil || !state.IsMember(operatorID) {
+			return
+		}
+		request.Votes[operatorID] = *message.Approve
+	case FloraOperationJoinAccepted:
+		if !state.IsMember(operatorID) {
+			return
+		}
+		accepted := replay.approvedJoins(message.Members)
+		if len(accepted) == 0 {
+			return
+		}
+		for _, accountID := range accepted {
+			state.Members = append(state.Members, FloraMember{AccountID: accountID})
+			delete(replay.joinRequests, accountID)
+		}
+		if message.Epoch != nil {
+			state.Epoch = *message.Epoch
+		}
+	case FloraOperationRemoveProposal:
+		accountID := strings.TrimSpace(message.AccountID)
+		if !state.IsMember(operatorID) || !state.IsMember(accountID) {
+			return
+		}
+		replay.addProposal(item, operatorID, FloraProposal{
+			Type:      FloraProposalTypeRemoveMember,
+			AccountID: accountID,
+			Reason:    message.Reason,
+		})
+	case FloraOperationThresholdProposal:
+		if !state.IsMember(operatorID) || message.Threshold == nil || *message.Threshold <= 0 {
+			return
+		}
+		replay.addProposal(item, operatorID, FloraProposal{
+			Type:      FloraProposalTypeThreshold,
+			Threshold: *message.Threshold,
+			Reason:    message.Reason,
+		})
+	case FloraOperationProposalVote:
+		proposal, exists := replay.proposals[message.ProposalSequence]
+		if !exists || message.Approve == nil || !state.IsMember(operatorID) {
+			return
+		}
+		proposal.Votes[operatorID] = *message.Approve
+	case FloraOperationProposalAccepted:
+		proposal, exists := replay.proposals[message.ProposalSequence]
+		if !exists || !state.IsMember(operatorID) {
+			return
+		}
+		if _, _, passed, _, err := tallyVotes(*state, proposal.Votes); err != nil || !passed {
+			return
+		}
+		members, threshold := proposalOutcome(*state, *proposal)
+		state.Members = mergeFloraMembers(state.Members, members)
+		state.Threshold = threshold
+		if message.Epoch != nil {
+			state.Epoch = *message.Epoch
+		}
+		delete(replay.proposals, message.ProposalSequence)
+	}
+}
+
+// approvedJoins returns the accounts listed in an acceptance that have a
+// pending join request approved by the threshold. The rest of the listed
+// membership is ignored, so an acceptance can only add voted-in accounts.
+func (replay *floraReplay) approvedJoins(members []string) []string {
+	approved := make([]string, 0, 1)
+	for _, accountID := range members {
+		request, exists := replay.joinRequests[strings.TrimSpace(accountID)]
+		if !exists || slices.Contains(approved, request.AccountID) {
+			continue
+		}
+		if _, _, passed, _, err := tallyVotes(*replay.state, request.Votes); err == nil && passed {
+			approved = append(approved, request.AccountID)
+		}
+	}
+	return approved
+}
+
+func (replay *floraReplay) addProposal(item mirror.TopicMessage, operatorID string, proposal FloraProposal) {
+	proposal.ProposedBy = operatorID
+	proposal.SequenceNumber = item.SequenceNumber
+	proposal.ConsensusTimestamp = item.ConsensusTimestamp
+	proposal.Votes = map[string]bool{}
+	replay.proposals[item.SequenceNumber] = &proposal
+	replay.proposalOrder = append(replay.proposalOrder, item.SequenceNumber)
+}
+
+// paidByMemberOrAccount reports whether a state hash message was paid for by
+// a current member or by the account it is for.
+func (replay *floraReplay) paidByMemberOrAccount(item mirror.TopicMessage, message floraWireMessage) bool {
+	payer := strings.TrimSpace(item.PayerAccountID)
+	return payer != "" && (payer == strings.TrimSpace(message.AccountID) || replay.state.IsMember(payer))
+}
+
+// applyStateHash records a state hash. Only an account's own messages count
+// as its state hash, so a member cannot publish another member's
+// contribution to the composite.
+func (replay *floraReplay) applyStateHash(item mirror.TopicMessage, message floraWireMessage) {
+	if message.Operation != "state_hash" || strings.TrimSpace(message.StateHash) == "" {
+		return
+	}
+	var topics []string
+	if len(message.Topics) > 0 {
+		_ = json.Unmarshal(message.Topics, &topics)
+	}
+	update := FloraStateHash{
+		StateHash:          strings.TrimSpace(message.StateHash),
+		AccountID:          strings.TrimSpace(message.AccountID),
+		Topics:             topics,
+		Memo:               message.Memo,
+		Timestamp:          message.Timestamp,
+		Payer:              item.PayerAccountID,
+		SequenceNumber:     item.SequenceNumber,
+		ConsensusTimestamp: item.ConsensusTimestamp,
+	}
+	if message.Epoch != nil {
+		update.Epoch = *message.Epoch
+	}
+	replay.state.LatestStateHash = &update
+	if update.AccountID != "" && update.AccountID == strings.TrimSpace(item.PayerAccountID) {
+		if replay.state.StateHashes == nil {
+			replay.state.StateHashes = map[string]FloraStateHash{}
+		}
+		replay.state.StateHashes[update.AccountID] = update
+	}
+}
+
+// consensusTimestampBefore orders mirror node "seconds.nanos" timestamps.
+func consensusTimestampBefore(left string, right string) bool {
+	leftSeconds, leftNanos := splitConsensusTimestamp(left)
+	rightSeconds, rightNanos := splitConsensusTimestamp(right)
+	if leftSeconds != rightSeconds {
+		return leftSeconds < rightSeconds
+	}
+	return leftNanos < rightNanos
+}
+
+func splitConsensusTimestamp(value string) (int64, int64) {
+	secondsPart, nanosPart, _ := strings.Cut(strings.TrimSpace(value), ".")
+	seconds, _ := strconv.ParseInt(secondsPart, 10, 64)
+	nanos, _ := strconv.ParseInt(nanosPart, 10, 64)
+	return seconds, nanos
+}
+
+// mergeFloraMembers returns the accepted membership, keeping the profile
+// details of members that were already known.
+func mergeFloraMembers(current []FloraMember, accepted []string) []FloraMember {
+	known := make(map[string]FloraMember, len(current))
+	for _, member := range current {
+		known[member.AccountID] = member
+	}
+	merged := make([]FloraMember, 0, len(accepted))
+	seen := map[string]bool{}
+	for _, accountID := range accepted {
+		accountID = strings.TrimSpace(accountID)
+		if accountID == "" || seen[accountID] {
+			continue
+		}
+		seen[accountID] = true
+		if member, exists := known[accountID]; exists {
+			merged = append(merged, member)
+			continue
+		}
+		merged = append(merged, FloraMember{AccountID: accountID})
+	}
+	return merged
+}
+
+func parseFloraProfileMemo(memo string) (string, error) {
+	trimmed := strings.TrimSpace(memo)
+	if !strings.HasPrefix(trimmed, "hcs-11:") {
+		return "", fmt.Errorf("account memo %q is not an HCS-11 profile reference", trimmed)
+	}
+	reference := strings.TrimPrefix(trimmed, "hcs-11:")
+	if !strings.HasPrefix(reference, "hcs://") {
+		return "", fmt.Errorf("unsupported flora profile reference %q", reference)
+	}
+	parts := strings.Split(strings.TrimPrefix(reference, "hcs://"), "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return "", fmt.Errorf("invalid flora profile reference %q", reference)
+	}
+	return strings.TrimSpace(parts[1]), nil
+}
+
+func (c *Client) fetchFloraProfile(ctx context.Context, profileTopicID string) (hcs11.HCS11Profile, error) {
+	content, err := c.mirrorClient.GetHCS1File(ctx, profileTopicID)
+	if err != nil {
+		return hcs11.HCS11Profile{}, fmt.Errorf("failed to read flora profile %s: %w", profileTopicID, err)
+	}
+	var profile hcs11.HCS11Profile
+	if err := json.Unmarshal(content, &profile); err != nil {
+		return hcs11.HCS11Profile{}, fmt.Errorf("failed to decode flora profile %s: %w", profileTopicID, err)
+	}
+	return profile, nil
+}
+
+func (c *Client) verifyFloraTopic(
+	ctx context.Context,
+	floraAccountID string,
+	topicID string,
+	topicType FloraTopicType,
+) error {
+	if topicID == "" {
+		return fmt.Errorf("flora profile is missing topic type %d", topicType)
+	}
+	info, err := c.mirrorClient.GetTopicInfo(ctx, topicID)
+	if err != nil {
+		return fmt.Errorf("failed to load flora topic %s: %w", topicID, err)
+	}
+	parsed := c.ParseTopicMemo(info.Memo)
+	if parsed == nil || parsed.FloraAccountID != floraAccountID || parsed.TopicType != topicType {
+		return fmt.Errorf("topic %s memo %q does not belong to flora %s as type %d", topicID, info.Memo, floraAccountID, topicType)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package hcs16
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// timedPayload pins a mirror message to a consensus second so tests can
+// interleave communication and state topic messages.
+type timedPayload struct {
+	seconds int64
+	body    any
+}
+
+// paidPayload sets the payer of a mirror message. Unwrapped payloads are paid
+// for by their operator_id, or their account_id without one, or by 0.0.500.
+type paidPayload struct {
+	payer string
+	body  any
+}
+
+func encodeMirrorMessages(t *testing.T, payloads ...any) []map[string]any {
+	t.Helper()
+	messages := make([]map[string]any, 0, len(payloads))
+	for index, payload := range payloads {
+		seconds := int64(1700000000 + index)
+		if timed, ok := payload.(timedPayload); ok {
+			seconds = timed.seconds
+			payload = timed.body
+		}
+		payer := "0.0.500"
+		if paid, ok := payload.(paidPayload); ok {
+			payer = paid.payer
+			payload = paid.body
+		} else if fields, ok := payload.(map[string]any); ok {
+			if operatorID, ok := fields["operator_id"].(string); ok {
+				payer = operatorID
+			} else if accountID, ok := fields["account_id"].(string); ok {
+				payer = accountID
+			}
+		}
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("failed to marshal payload: %v", err)
+		}
+		messages = append(messages, map[string]any{
+			"consensus_timestamp": fmt.Sprintf("%d.000000000", seconds),
+			"message":             base64.StdEncoding.EncodeToString(raw),
+			"payer_account_id":    payer,
+			"sequence_number":     index + 1,
+		})
+	}
+	return messages
+}
+
+func newFloraMirror(
+	t *testing.T,
+	profile map[string]any,
+	communication []any,
+	state []any,
+	memos map[string]string,
+	extra map[string]any,
+) *httptest.Server {
+	t.Helper()
+
+	profileBytes, _ := json.Marshal(profile)
+	var compressed bytes.Buffer
+	writer := brotli.NewWriter(&compressed)
+	if _, err := writer.Write(profileBytes); err != nil {
+		t.Fatalf("failed to compress profile: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to finalize profile: %v", err)
+	}
+	profileChunk := map[string]any{
+		"o": 0,
+		"c": "data:application/json;base64," + base64.StdEncoding.EncodeToString(compressed.Bytes()),
+	}
+
+	responses := map[string]any{
+		"/api/v1/accounts/0.0.900":        map[string]any{"account": "0.0.900", "memo": "hcs-11:hcs://1/0.0.950"},
+		"/api/v1/topics/0.0.950/messages": map[string]any{"messages": encodeMirrorMessages(t, profileChunk)},
+		"/api/v1/topics/0.0.901/messages": map[string]any{"messages": encodeMirrorMessages(t, communication...)},
+		"/api/v1/topics/0.0.903/messages": map[string]any{"messages": encodeMirrorMessages(t, state...)},
+	}
+	for topicID, memo := range memos {
+		responses["/api/v1/topics/"+topicID] = map[string]any{"topic_id": topicID, "memo": memo}
+	}
+	for path, response := range extra {
+		responses[path] = response
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func newFloraStateClient(t *testing.T, mirrorURL string) *Client {
+	t.Helper()
+	operatorKey, _ := hedera.PrivateKeyGenerateEd25519()
+	client, err := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  "0.0.801",
+		OperatorPrivateKey: operatorKey.String(),
+		MirrorBaseURL:      mirrorURL,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	return client
+}
+
+func defaultFloraProfile() map[string]any {
+	return map[string]any{
+		"version":      "1.0",
+		"type":         3,
+		"display_name": "Test Flora",
+		"threshold":    2,
+		"members": []map[string]any{
+			{"accountId": "0.0.801", "publicKey": "302a-key-1"},
+			{"accountId": "0.0.802", "publicKey": "302a-key-2"},
+		},
+		"topics": map[string]any{
+			"communication": "0.0.901",
+			"transaction":   "0.0.902",
+			"state":         "0.0.903",
+		},
+	}
+}
+
+func defaultFloraMemos() map[string]string {
+	return map[string]string{
+		"0.0.901": "hcs-16:0.0.900:0",
+		"0.0.902": "hcs-16:0.0.900:1",
+		"0.0.903": "hcs-16:0.0.900:2",
+	}
+}
+
+func TestLoadFloraReplaysTopics(t *testing.T) {
+	communication := []any{
+		map[string]any{"p": "hcs-16", "op": "flora_created", "operator_id": "0.0.801", "flora_account_id": "0.0.900"},
+		map[string]any{"p": "hcs-16", "op": "flora_join_request", "operator_id": "0.0.803", "account_id": "0.0.803", "connection_request_id": 7, "connection_topic_id": "0.0.700", "connection_seq": 3},
+		map[string]any{"p": "hcs-16", "op": "flora_join_request", "operator_id": "0.0.804", "account_id": "0.0.804", "connection_request_id": 8, "connection_topic_id": "0.0.701", "connection_seq": 4},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.801", "account_id": "0.0.803", "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.999", "account_id": "0.0.804", "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.802", "account_id": "0.0.804", "approve": true},
+		// One approval is below the threshold, and members cannot drop others.
+		map[string]any{"p": "hcs-16", "op": "flora_join_accepted", "operator_id": "0.0.802", "members": []string{"0.0.802", "0.0.804"}, "epoch": 5},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.802", "account_id": "0.0.804", "approve": false},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.802", "account_id": "0.0.803", "approve": true},
+		map[string]any{"p": "hcs-16", "op": "flora_join_accepted", "operator_id": "0.0.801", "members": []string{"0.0.801", "0.0.802", "0.0.803"}, "epoch": 1},
+		map[string]any{"p": "other", "op": "flora_join_accepted", "operator_id": "0.0.801", "members": []string{"0.0.801"}},
+	}
+	state := []any{
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "abc", "account_id": "0.0.900", "epoch": 0},
+		map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "def", "account_id": "0.0.900", "topics": []string{"0.0.901"}, "epoch": 1, "m": "rotated"},
+		paidPayload{payer: "0.0.999", body: map[string]any{"p": "hcs-17", "op": "state_hash", "state_hash": "outsider", "account_id": "0.0.900"}},
+	}
+
+	server := newFloraMirror(t, defaultFloraProfile(), communication, state, defaultFloraMemos(), nil)
+	defer server.Close()
+
+	client := newFloraStateClient(t, server.URL)
+
+	flora, err := client.LoadFlora(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("LoadFlora failed: %v", err)
+	}
+	if flora.DisplayName != "Test Flora" || flora.Threshold != 2 || flora.ProfileTopicID != "0.0.950" {
+		t.Fatalf("unexpected profile fields: %+v", flora)
+	}
+	if flora.CreatedBy != "0.0.801" || flora.Epoch != 1 {
+		t.Fatalf("unexpected creation or epoch: %s, %d", flora.CreatedBy, flora.Epoch)
+	}
+	if got := strings.Join(flora.MemberAccountIDs(), ","); got != "0.0.801,0.0.802,0.0.803" {
+		t.Fatalf("unexpected members %s", got)
+	}
+	if flora.Members[0].PublicKey != "302a-key-1" {
+		t.Fatalf("expected existing member details to be kept")
+	}
+	if !flora.IsMember("0.0.803") || flora.IsMember("0.0.804") {
+		t.Fatalf("unexpected membership result")
+	}
+	if len(flora.PendingJoinRequests) != 1 {
+		t.Fatalf("expected one pending join request, got %d", len(flora.PendingJoinRequests))
+	}
+	pending := flora.PendingJoinRequests[0]
+	if pending.AccountID != "0.0.804" || pending.ConnectionTopicID != "0.0.701" || pending.ConnectionSequence != 4 {
+		t.Fatalf("unexpected pending request %+v", pending)
+	}
+	if len(pending.Approvals()) != 0 || strings.Join(pending.Rejections(), ",") != "0.0.802" {
+		t.Fatalf("expected the latest member vote to win, got %+v", pending.Votes)
+	}
+	if flora.LatestStateHash == nil || flora.LatestStateHash.StateHash != "def" || flora.LatestStateHash.Epoch != 1 {
+		t.Fatalf("unexpected state hash %+v", flora.LatestStateHash)
+	}
+	if flora.LastCommunicationSequence != 10 || flora.LastStateSequence != 2 {
+		t.Fatalf("unexpected sequences %d, %d", flora.LastCommunicationSequence, flora.LastStateSequence)
+	}
+}
+
+func TestLoadFloraIgnoresMessagesNotPaidByOperator(t *testing.T) {
+	communication := []any{
+		map[string]any{"p": "hcs-16", "op": "flora_join_request", "operator_id": "0.0.803", "account_id": "0.0.803"},
+		map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.801", "account_id": "0.0.803", "approve": true},
+		// 0.0.801 forges the second approval and a removal proposal for 0.0.802.
+		paidPayload{payer: "0.0.801", body: map[string]any{"p": "hcs-16", "op": "flora_join_vote", "operator_id": "0.0.802", "account_id": "0.0.803", "approve": true}},
+		paidPayload{payer: "0.0.801", body: map[string]any{"p": "hcs-16", "op": "flora_remove_proposal", "operator_id": "0.0.802", "account_id": "0.0.802"}},
+		map[string]any{"p": "hcs-16", "op": "flora_join_accepted", "operator_id": "0.0.801", "members": []string{"0.0.801", "0.0.802", "0.0.803"}, "epoch": 1},
+	}
+
+	server := newFloraMirror(t, defaultFloraProfile(), communication, nil, defaultFloraMemos(), nil)
+	defer server.Close()
+
+	flora, err := newFloraStateClient(t, server.URL).LoadFlora(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("LoadFlora failed: %v", err)
+	}
+	if flora.IsMember("0.0.803") || flora.Epoch != 0 {
+		t.Fatalf("expected the forged vote not to admit 0.0.803, got %v at epoch %d", flora.MemberAccountIDs(), flora.Epoch)
+	}
+	if len(flora.PendingJoinRequests) != 1 || len(flora.PendingJoinRequests[0].Votes) != 1 {
+		t.Fatalf("expected one counted vote, got %+v", flora.PendingJoinRequests)
+	}
+	if len(flora.PendingProposals) != 0 {
+		t.Fatalf("expected the forged proposal to be ignored, got %+v", flora.PendingProposals)
+	}
+}
+
+func TestLoadFloraRejectsMismatchedTopics(t *testing.T) {
+	memos := defaultFloraMemos()
+	memos["0.0.902"] = "hcs-16:0.0.111:1"
+	server := newFloraMirror(t, defaultFloraProfile(), nil, nil, memos, nil)
+	defer server.Close()
+
+	client := newFloraStateClient(t, server.URL)
+	if _, err := client.LoadFlora(context.Background(), "0.0.900"); err == nil || !strings.Contains(err.Error(), "0.0.902") {
+		t.Fatalf("expected topic memo mismatch error, got %v", err)
+	}
+
+	profile := defaultFloraProfile()
+	profile["type"] = 1
+	server = newFloraMirror(t, profile, nil, nil, defaultFloraMemos(), nil)
+	defer server.Close()
+	client = newFloraStateClient(t, server.URL)
+	if _, err := client.LoadFlora(context.Background(), "0.0.900"); err == nil {
+		t.Fatalf("expected error for non-flora profile")
+	}
+	if _, err := client.LoadFlora(context.Background(), " "); err == nil {
+		t.Fatalf("expected error for empty account ID")
+	}
+
+	for _, memo := range []string{"plain memo", "hcs-11:ipfs://x", "hcs-11:hcs://1/"} {
+		if _, err := parseFloraProfileMemo(memo); err == nil {
+			t.Fatalf("expected error for memo %q", memo)
+		}
+	}
+}
@@ -0,0 +1,390 @@
+package hcs16
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	protobufservices "github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+// ListPendingTransactions returns the scheduled transactions proposed on the
+// flora transaction topic that have not yet executed, expired or been
+// deleted. Each entry decodes the scheduled body into a readable summary and
+// reports which members have signed or rejected it. Proposals and rejections
+// only count when the message was paid for by the member named in
+// operator_id. A member whose account has a key list counts as signed once
+// the list's threshold of keys, or every key when it has none, appears among
+// the schedule signatures.
+func (c *Client) ListPendingTransactions(ctx context.Context, floraAccountID string) ([]FloraPendingTransaction, error) {
+	state, err := c.LoadFlora(ctx, floraAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.mirrorClient.GetTopicMessages(ctx, state.Topics.Transaction, mirror.MessageQueryOptions{
+		Order: "asc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read flora transaction topic: %w", err)
+	}
+
+	proposals := make([]FloraPendingTransaction, 0)
+	indexBySchedule := map[string]int{}
+	rejections := map[string]map[string]string{}
+	for _, item := range items {
+		var message floraTransactionWireMessage
+		if err := mirror.DecodeMessageJSON(item, &message); err != nil {
+			continue
+		}
+		operatorID := strings.TrimSpace(message.OperatorID)
+		scheduleID := strings.TrimSpace(message.ScheduleID)
+		if message.Protocol != "hcs-16" || scheduleID == "" || !state.IsMember(operatorID) {
+			continue
+		}
+		if operatorID != strings.TrimSpace(item.PayerAccountID) {
+			continue
+		}
+
+		switch FloraOperation(message.Operation) {
+		case FloraOperationTransaction:
+			if _, exists := indexBySchedule[scheduleID]; exists {
+				continue
+			}
+			indexBySchedule[scheduleID] = len(proposals)
+			proposals = append(proposals, FloraPendingTransaction{
+				ScheduleID:         scheduleID,
+				ProposedBy:         operatorID,
+				Description:        message.Data,
+				SequenceNumber:     item.SequenceNumber,
+				ConsensusTimestamp: item.ConsensusTimestamp,
+				Threshold:          state.Threshold,
+			})
+		case FloraOperationTransactionRejected:
+			if rejections[scheduleID] == nil {
+				rejections[scheduleID] = map[string]string{}
+			}
+			rejections[scheduleID][operatorID] = message.Reason
+		}
+	}
+	if len(proposals) == 0 {
+		return nil, nil
+	}
+
+	memberKeys := make(map[string]hedera.Key, len(state.Members))
+	for _, member := range state.Members {
+		key, err := c.fetchAccountKey(ctx, member.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve key for member %s: %w", member.AccountID, err)
+		}
+		memberKeys[member.AccountID] = key
+	}
+
+	now := time.Now()
+	nowTimestamp := fmt.Sprintf("%d.%09d", now.Unix(), now.Nanosecond())
+	pending := make([]FloraPendingTransaction, 0, len(proposals))
+	for _, proposal := range proposals {
+		schedule, err := c.mirrorClient.GetSchedule(ctx, proposal.ScheduleID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load schedule %s: %w", proposal.ScheduleID, err)
+		}
+		if schedule.Deleted || strings.TrimSpace(schedule.ExecutedTimestamp) != "" {
+			continue
+		}
+		expiration := strings.TrimSpace(schedule.ExpirationTime)
+		if expiration != "" && consensusTimestampBefore(expiration, nowTimestamp) {
+			continue
+		}
+
+		bodyBytes, err := base64.StdEncoding.DecodeString(schedule.TransactionBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode schedule %s body: %w", proposal.ScheduleID, err)
+		}
+		proposal.Summary, err = SummarizeScheduledTransaction(bodyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to summarize schedule %s: %w", proposal.ScheduleID, err)
+		}
+		proposal.PayerAccountID = schedule.PayerAccountID
+		proposal.CreatorAccountID = schedule.CreatorAccountID
+		proposal.ExpirationTime = schedule.ExpirationTime
+		proposal.ScheduleMemo = schedule.Memo
+
+		signed := scheduleSigners(schedule.Signatures, state.MemberAccountIDs(), memberKeys)
+		proposal.RejectedBy = map[string]string{}
+		for _, memberAccountID := range state.MemberAccountIDs() {
+			if signed[memberAccountID] {
+				proposal.SignedBy = append(proposal.SignedBy, memberAccountID)
+				continue
+			}
+			if reason, rejected := rejections[proposal.ScheduleID][memberAccountID]; rejected {
+				proposal.RejectedBy[memberAccountID] = reason
+				continue
+			}
+			proposal.AwaitingSignatures = append(proposal.AwaitingSignatures, memberAccountID)
+		}
+		proposal.Rejected = len(state.Members)-len(proposal.RejectedBy) < state.Threshold
+		pending = append(pending, proposal)
+	}
+	return pending, nil
+}
+
+// ApproveTransaction signs a pending flora schedule with a member's signer.
+func (c *Client) ApproveTransaction(
+	ctx context.Context,
+	scheduleID string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	return c.SignScheduleWithSigner(ctx, scheduleID, signer)
+}
+
+// RejectTransaction publishes a member's rejection of a pending flora
+// schedule on the transaction topic of floraAccountID. The message is paid
+// for by operatorID, so signer must hold that member's account key.
+func (c *Client) RejectTransaction(
+	ctx context.Context,
+	floraAccountID string,
+	operatorID string,
+	scheduleID string,
+	reason string,
+	signer shared.Signer,
+) (FloraTransactionResult, error) {
+	if signer == nil {
+		return FloraTransactionResult{}, fmt.Errorf("signer is required")
+	}
+	state, err := c.LoadFlora(ctx, floraAccountID)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	if !state.IsMember(strings.TrimSpace(operatorID)) {
+		return FloraTransactionResult{}, fmt.Errorf("account %s is not a member of flora %s", operatorID, state.FloraAccountID)
+	}
+	transaction, err := BuildTransactionRejectedTx(state.Topics.Transaction, operatorID, scheduleID, reason)
+	if err != nil {
+		return FloraTransactionResult{}, err
+	}
+	return c.executeMemberMessage(ctx, transaction, operatorID, []shared.Signer{signer})
+}
+
+// SummarizeScheduledTransaction decodes a SchedulableTransactionBody, as
+// returned in a mirror node schedule, into a readable summary. Transfers,
+// token supply and association changes, and account and topic updates are
+// described in detail; other transaction types are reported by name.
+func SummarizeScheduledTransaction(bodyBytes []byte) (FloraTransactionSummary, error) {
+	var body protobufservices.SchedulableTransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return FloraTransactionSummary{}, fmt.Errorf("failed to decode scheduled transaction body: %w", err)
+	}
+
+	summary := FloraTransactionSummary{
+		Memo:           body.GetMemo(),
+		MaxFeeTinybars: int64(body.GetTransactionFee()),
+	}
+	dataField := body.ProtoReflect().WhichOneof(body.ProtoReflect().Descriptor().Oneofs().ByName("data"))
+	if dataField == nil {
+		summary.Type = "unknown"
+		return summary, nil
+	}
+	summary.Type = string(dataField.Name())
+
+	switch data := body.GetData().(type) {
+	case *protobufservices.SchedulableTransactionBody_CryptoTransfer:
+		for _, transfer := range data.CryptoTransfer.GetTransfers().GetAccountAmounts() {
+			accountID := protobufAccountIDString(transfer.GetAccountID())
+			summary.HbarTransfers = append(summary.HbarTransfers, FloraHbarTransfer{
+				AccountID: accountID,
+				Tinybars:  transfer.GetAmount(),
+			})
+			summary.Details = append(summary.Details, fmt.Sprintf(
+				"%s %s",
+				accountID,
+				hedera.HbarFromTinybar(transfer.GetAmount()).String(),
+			))
+		}
+		for _, tokenTransfers := range data.CryptoTransfer.GetTokenTransfers() {
+			tokenID := protobufTokenIDString(tokenTransfers.GetToken())
+			for _, transfer := range tokenTransfers.GetTransfers() {
+				accountID := protobufAccountIDString(transfer.GetAccountID())
+				summary.TokenTransfers = append(summary.TokenTransfers, FloraTokenTransfer{
+					TokenID:   tokenID,
+					AccountID: accountID,
+					Amount:    transfer.GetAmount(),
+				})
+				summary.Details = append(summary.Details, fmt.Sprintf("%s %d of token %s", accountID, transfer.GetAmount(), tokenID))
+			}
+			for _, transfer := range tokenTransfers.GetNftTransfers() {
+				sender := protobufAccountIDString(transfer.GetSenderAccountID())
+				receiver := protobufAccountIDString(transfer.GetReceiverAccountID())
+				summary.TokenTransfers = append(summary.TokenTransfers, FloraTokenTransfer{
+					TokenID:           tokenID,
+					AccountID:         receiver,
+					SenderAccountID:   sender,
+					SerialNumber:      transfer.GetSerialNumber(),
+					IsNonFungibleItem: true,
+				})
+				summary.Details = append(summary.Details, fmt.Sprintf(
+					"NFT %s serial %d from %s to %s",
+					tokenID,
+					transfer.GetSerialNumber(),
+					sender,
+					receiver,
+				))
+			}
+		}
+	case *protobufservices.SchedulableTransactionBody_TokenMint:
+		tokenID := protobufTokenIDString(data.TokenMint.GetToken())
+		if metadata := data.TokenMint.GetMetadata(); len(metadata) > 0 {
+			summary.Details = append(summary.Details, fmt.Sprintf("mint %d NFTs of token %s", len(metadata), tokenID))
+		} else {
+			summary.Details = append(summary.Details, fmt.Sprintf("mint %d of token %s", data.TokenMint.GetAmount(), tokenID))
+		}
+	case *protobufservices.SchedulableTransactionBody_TokenBurn:
+		tokenID := protobufTokenIDString(data.TokenBurn.GetToken())
+		if serials := data.TokenBurn.GetSerialNumbers(); len(serials) > 0 {
+			summary.Details = append(summary.Details, fmt.Sprintf("burn NFT serials %v of token %s", serials, tokenID))
+		} else {
+			summary.Details = append(summary.Details, fmt.Sprintf("burn %d of token %s", data.TokenBurn.GetAmount(), tokenID))
+		}
+	case *protobufservices.SchedulableTransactionBody_TokenAssociate:
+		summary.Details = append(summary.Details, fmt.Sprintf(
+			"associate %s with tokens %s",
+			protobufAccountIDString(data.TokenAssociate.GetAccount()),
+			protobufTokenIDList(data.TokenAssociate.GetTokens()),
+		))
+	case *protobufservices.SchedulableTransactionBody_TokenDissociate:
+		summary.Details = append(summary.Details, fmt.Sprintf(
+			"dissociate %s from tokens %s",
+			protobufAccountIDString(data.TokenDissociate.GetAccount()),
+			protobufTokenIDList(data.TokenDissociate.GetTokens()),
+		))
+	case *protobufservices.SchedulableTransactionBody_CryptoUpdateAccount:
+		update := data.CryptoUpdateAccount
+		summary.Details = append(summary.Details, fmt.Sprintf("update account %s", protobufAccountIDString(update.GetAccountIDToUpdate())))
+		if update.GetKey() != nil {
+			summary.Details = append(summary.Details, "replace account key")
+		}
+		if update.GetMemo() != nil {
+			summary.Details = append(summary.Details, fmt.Sprintf("set memo to %q", update.GetMemo().GetValue()))
+		}
+	case *protobufservices.SchedulableTransactionBody_ConsensusUpdateTopic:
+		update := data.ConsensusUpdateTopic
+		summary.Details = append(summary.Details, fmt.Sprintf("update topic %s", protobufTopicIDString(update.GetTopicID())))
+		if update.GetAdminKey() != nil {
+			summary.Details = append(summary.Details, "replace admin key")
+		}
+		if update.GetSubmitKey() != nil {
+			summary.Details = append(summary.Details, "replace submit key")
+		}
+		if update.GetMemo() != nil {
+			summary.Details = append(summary.Details, fmt.Sprintf("set memo to %q", update.GetMemo().GetValue()))
+		}
+	case *protobufservices.SchedulableTransactionBody_ConsensusSubmitMessage:
+		summary.Details = append(summary.Details, fmt.Sprintf(
+			"submit %d-byte message to topic %s",
+			len(data.ConsensusSubmitMessage.GetMessage()),
+			protobufTopicIDString(data.ConsensusSubmitMessage.GetTopicID()),
+		))
+	}
+	return summary, nil
+}
+
+type floraTransactionWireMessage struct {
+	Protocol   string `json:"p"`
+	Operation  string `json:"op"`
+	OperatorID string `json:"operator_id"`
+	ScheduleID string `json:"schedule_id"`
+	Data       string `json:"data"`
+	Reason     string `json:"reason"`
+}
+
+// scheduleSigners reports which members' keys are satisfied by the
+// schedule's signatures. The mirror node reports each signer by public key
+// prefix.
+func scheduleSigners(
+	signatures []mirror.ScheduleSignature,
+	members []string,
+	memberKeys map[string]hedera.Key,
+) map[string]bool {
+	prefixes := make([][]byte, 0, len(signatures))
+	for _, signature := range signatures {
+		prefix, err := base64.StdEncoding.DecodeString(signature.PublicKeyPrefix)
+		if err == nil && len(prefix) > 0 {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	signed := map[string]bool{}
+	for _, memberAccountID := range members {
+		if key, ok := memberKeys[memberAccountID]; ok && keySigned(key, prefixes) {
+			signed[memberAccountID] = true
+		}
+	}
+	return signed
+}
+
+// keySigned reports whether prefixes satisfy key. Key lists need their
+// threshold of nested keys, or all of them without one.
+func keySigned(key hedera.Key, prefixes [][]byte) bool {
+	switch typed := key.(type) {
+	case hedera.PublicKey:
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(typed.BytesRaw(), prefix) {
+				return true
+			}
+		}
+		return false
+	case *hedera.KeyList:
+		keys := typed.GetKeys()
+		required := typed.GetThreshold()
+		if required <= 0 {
+			required = len(keys)
+		}
+		if required == 0 {
+			return false
+		}
+		satisfied := 0
+		for _, nested := range keys {
+			if keySigned(nested, prefixes) {
+				satisfied++
+			}
+		}
+		return satisfied >= required
+	default:
+		return false
+	}
+}
+
+func protobufAccountIDString(accountID *protobufservices.AccountID) string {
+	if accountID == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", accountID.GetShardNum(), accountID.GetRealmNum(), accountID.GetAccountNum())
+}
+
+func protobufTokenIDString(tokenID *protobufservices.TokenID) string {
+	if tokenID == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", tokenID.GetShardNum(), tokenID.GetRealmNum(), tokenID.GetTokenNum())
+}
+
+func protobufTopicIDString(topicID *protobufservices.TopicID) string {
+	if topicID == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", topicID.GetShardNum(), topicID.GetRealmNum(), topicID.GetTopicNum())
+}
+
+func protobufTokenIDList(tokenIDs []*protobufservices.TokenID) string {
+	formatted := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		formatted = append(formatted, protobufTokenIDString(tokenID))
+	}
+	return strings.Join(formatted, ", ")
+}
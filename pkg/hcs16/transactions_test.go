@@ -0,0 +1,279 @@
+package hcs16
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	protobufservices "github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func encodeSchedulableBody(t *testing.T, body *protobufservices.SchedulableTransactionBody) string {
+	t.Helper()
+	raw, err := protobuf.Marshal(body)
+	if err != nil {
+		t.Fatalf("failed to marshal schedulable body: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func TestListPendingTransactions(t *testing.T) {
+	firstKey, _ := hedera.PrivateKeyGenerateEd25519()
+	secondKey, _ := hedera.PrivateKeyGenerateEd25519()
+
+	transferBody := encodeSchedulableBody(t, &protobufservices.SchedulableTransactionBody{
+		TransactionFee: 200000000,
+		Memo:           "vendor payout",
+		Data: &protobufservices.SchedulableTransactionBody_CryptoTransfer{
+			CryptoTransfer: &protobufservices.CryptoTransferTransactionBody{
+				Transfers: &protobufservices.TransferList{
+					AccountAmounts: []*protobufservices.AccountAmount{
+						{AccountID: &protobufservices.AccountID{Account: &protobufservices.AccountID_AccountNum{AccountNum: 900}}, Amount: -500000000},
+						{AccountID: &protobufservices.AccountID{Account: &protobufservices.AccountID_AccountNum{AccountNum: 42}}, Amount: 500000000},
+					},
+				},
+			},
+		},
+	})
+
+	transactionMessages := []any{
+		map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.801", "schedule_id": "0.0.7001", "data": "pay vendor"},
+		map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.801", "schedule_id": "0.0.7002", "data": "already done"},
+		map[string]any{"p": "hcs-16", "op": "transaction_rejected", "operator_id": "0.0.802", "schedule_id": "0.0.7001", "reason": "too much"},
+		map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.999", "schedule_id": "0.0.7003"},
+		map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.801", "schedule_id": "0.0.7001", "data": "duplicate"},
+		paidPayload{payer: "0.0.999", body: map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.802", "schedule_id": "0.0.7004"}},
+	}
+	extra := map[string]any{
+		"/api/v1/accounts/0.0.801":        map[string]any{"account": "0.0.801", "key": map[string]any{"key": firstKey.PublicKey().String()}},
+		"/api/v1/accounts/0.0.802":        map[string]any{"account": "0.0.802", "key": map[string]any{"key": secondKey.PublicKey().String()}},
+		"/api/v1/topics/0.0.902/messages": map[string]any{"messages": encodeMirrorMessages(t, transactionMessages...)},
+		"/api/v1/schedules/0.0.7001": map[string]any{
+			"schedule_id":        "0.0.7001",
+			"creator_account_id": "0.0.801",
+			"payer_account_id":   "0.0.900",
+			"executed_timestamp": nil,
+			"transaction_body":   transferBody,
+			"signatures": []map[string]any{
+				{"public_key_prefix": base64.StdEncoding.EncodeToString(firstKey.PublicKey().BytesRaw()), "type": "ED25519"},
+			},
+		},
+		"/api/v1/schedules/0.0.7002": map[string]any{
+			"schedule_id":        "0.0.7002",
+			"executed_timestamp": "1700000500.000000000",
+			"transaction_body":   transferBody,
+		},
+	}
+	server := newFloraMirror(t, defaultFloraProfile(), nil, nil, defaultFloraMemos(), extra)
+	defer server.Close()
+
+	pending, err := newFloraStateClient(t, server.URL).ListPendingTransactions(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("ListPendingTransactions failed: %v", err)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected one pending transaction, got %d", len(pending))
+	}
+	transaction := pending[0]
+	if transaction.ScheduleID != "0.0.7001" || transaction.Description != "pay vendor" || transaction.ProposedBy != "0.0.801" {
+		t.Fatalf("unexpected pending transaction %+v", transaction)
+	}
+	if strings.Join(transaction.SignedBy, ",") != "0.0.801" || transaction.RejectedBy["0.0.802"] != "too much" {
+		t.Fatalf("unexpected signers %v / rejections %v", transaction.SignedBy, transaction.RejectedBy)
+	}
+	if len(transaction.AwaitingSignatures) != 0 || !transaction.Rejected {
+		t.Fatalf("expected the rejection to make the threshold unreachable, got %+v", transaction)
+	}
+	summary := transaction.Summary
+	if summary.Type != "cryptoTransfer" || summary.Memo != "vendor payout" || summary.MaxFeeTinybars != 200000000 {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if len(summary.HbarTransfers) != 2 || summary.HbarTransfers[1].AccountID != "0.0.42" || summary.HbarTransfers[1].Tinybars != 500000000 {
+		t.Fatalf("unexpected hbar transfers %+v", summary.HbarTransfers)
+	}
+	if !strings.Contains(summary.Details[0], "0.0.900") || !strings.Contains(summary.Details[0], "-5") {
+		t.Fatalf("unexpected details %v", summary.Details)
+	}
+}
+
+func TestListPendingTransactionsSkipsExpiredSchedules(t *testing.T) {
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	transferBody := encodeSchedulableBody(t, &protobufservices.SchedulableTransactionBody{
+		Data: &protobufservices.SchedulableTransactionBody_CryptoTransfer{
+			CryptoTransfer: &protobufservices.CryptoTransferTransactionBody{},
+		},
+	})
+	now := time.Now()
+	extra := map[string]any{
+		"/api/v1/accounts/0.0.801": map[string]any{"account": "0.0.801", "key": map[string]any{"key": memberKey.PublicKey().String()}},
+		"/api/v1/accounts/0.0.802": map[string]any{"account": "0.0.802", "key": map[string]any{"key": memberKey.PublicKey().String()}},
+		"/api/v1/topics/0.0.902/messages": map[string]any{"messages": encodeMirrorMessages(t,
+			map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.801", "schedule_id": "0.0.7001"},
+			map[string]any{"p": "hcs-16", "op": "transaction", "operator_id": "0.0.801", "schedule_id": "0.0.7002"},
+		)},
+		"/api/v1/schedules/0.0.7001": map[string]any{
+			"schedule_id":      "0.0.7001",
+			"expiration_time":  fmt.Sprintf("%d.000000000", now.Add(-time.Minute).Unix()),
+			"transaction_body": transferBody,
+		},
+		"/api/v1/schedules/0.0.7002": map[string]any{
+			"schedule_id":      "0.0.7002",
+			"expiration_time":  fmt.Sprintf("%d.000000000", now.Add(time.Hour).Unix()),
+			"transaction_body": transferBody,
+		},
+	}
+	server := newFloraMirror(t, defaultFloraProfile(), nil, nil, defaultFloraMemos(), extra)
+	defer server.Close()
+
+	pending, err := newFloraStateClient(t, server.URL).ListPendingTransactions(context.Background(), "0.0.900")
+	if err != nil {
+		t.Fatalf("ListPendingTransactions failed: %v", err)
+	}
+	if len(pending) != 1 || pending[0].ScheduleID != "0.0.7002" {
+		t.Fatalf("expected only the unexpired schedule, got %+v", pending)
+	}
+}
+
+func TestSummarizeScheduledTransaction(t *testing.T) {
+	topicID := &protobufservices.TopicID{TopicNum: 901}
+	raw, _ := protobuf.Marshal(&protobufservices.SchedulableTransactionBody{
+		Data: &protobufservices.SchedulableTransactionBody_ConsensusUpdateTopic{
+			ConsensusUpdateTopic: &protobufservices.ConsensusUpdateTopicTransactionBody{
+				TopicID:   topicID,
+				Memo:      wrapperspb.String("rotated"),
+				SubmitKey: &protobufservices.Key{},
+			},
+		},
+	})
+	summary, err := SummarizeScheduledTransaction(raw)
+	if err != nil {
+		t.Fatalf("SummarizeScheduledTransaction failed: %v", err)
+	}
+	if summary.Type != "consensusUpdateTopic" {
+		t.Fatalf("unexpected type %s", summary.Type)
+	}
+	if got := strings.Join(summary.Details, "; "); got != `update topic 0.0.901; replace submit key; set memo to "rotated"` {
+		t.Fatalf("unexpected details %s", got)
+	}
+
+	raw, _ = protobuf.Marshal(&protobufservices.SchedulableTransactionBody{
+		Data: &protobufservices.SchedulableTransactionBody_TokenMint{
+			TokenMint: &protobufservices.TokenMintTransactionBody{
+				Token:    &protobufservices.TokenID{TokenNum: 5000},
+				Metadata: [][]byte{[]byte("a"), []byte("b")},
+			},
+		},
+	})
+	summary, _ = SummarizeScheduledTransaction(raw)
+	if len(summary.Details) != 1 || summary.Details[0] != "mint 2 NFTs of token 0.0.5000" {
+		t.Fatalf("unexpected mint details %v", summary.Details)
+	}
+
+	summary, _ = SummarizeScheduledTransaction(nil)
+	if summary.Type != "unknown" {
+		t.Fatalf("expected unknown type for an empty body, got %s", summary.Type)
+	}
+	if _, err := SummarizeScheduledTransaction([]byte{0xff, 0xff}); err == nil {
+		t.Fatalf("expected error for invalid body")
+	}
+}
+
+func TestScheduleSignersWithKeyLists(t *testing.T) {
+	keys := make([]hedera.PublicKey, 3)
+	prefixes := make([]mirror.ScheduleSignature, 3)
+	for index := range keys {
+		privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+		keys[index] = privateKey.PublicKey()
+		prefixes[index] = mirror.ScheduleSignature{PublicKeyPrefix: base64.StdEncoding.EncodeToString(keys[index].BytesRaw())}
+	}
+	thresholdKey := hedera.KeyListWithThreshold(2).Add(keys[0]).Add(keys[1]).Add(keys[2])
+	allKeys := hedera.NewKeyList().Add(keys[0]).Add(keys[1])
+	memberKeys := map[string]hedera.Key{"0.0.801": thresholdKey, "0.0.802": allKeys, "0.0.803": keys[2]}
+	members := []string{"0.0.801", "0.0.802", "0.0.803"}
+
+	signed := scheduleSigners(prefixes[:1], members, memberKeys)
+	if signed["0.0.801"] || signed["0.0.802"] || signed["0.0.803"] {
+		t.Fatalf("expected one signature to satisfy no member, got %v", signed)
+	}
+	signed = scheduleSigners(prefixes[1:], members, memberKeys)
+	if !signed["0.0.801"] || signed["0.0.802"] || !signed["0.0.803"] {
+		t.Fatalf("expected the threshold list and single key to be signed, got %v", signed)
+	}
+	signed = scheduleSigners(prefixes[:2], members, memberKeys)
+	if !signed["0.0.802"] {
+		t.Fatalf("expected the full key list to be signed, got %v", signed)
+	}
+}
+
+func TestRejectTransactionUsesFloraTransactionTopic(t *testing.T) {
+	server := newFloraMirror(t, defaultFloraProfile(), nil, nil, defaultFloraMemos(), nil)
+	defer server.Close()
+
+	operatorKey, _ := hedera.PrivateKeyGenerateEd25519()
+	hederaClient := hedera.ClientForNetwork(map[string]hedera.AccountID{"127.0.0.1:50211": {Account: 3}})
+	hederaClient.SetOperator(hedera.AccountID{Account: 802}, operatorKey)
+	client, err := NewClient(ClientConfig{
+		Network:       "testnet",
+		HederaClient:  hederaClient,
+		MirrorBaseURL: server.URL,
+		ExecutionMode: shared.ExecutionModeEnvelope,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	signer := shared.NewLocalSigner(operatorKey)
+	if _, err := client.RejectTransaction(context.Background(), "0.0.900", "0.0.802", "0.0.7001", "", nil); err == nil {
+		t.Fatalf("expected error without a signer")
+	}
+	if _, err := client.RejectTransaction(context.Background(), "0.0.900", "0.0.999", "0.0.7001", "", signer); err == nil {
+		t.Fatalf("expected error for a non-member")
+	}
+	result, err := client.RejectTransaction(context.Background(), "0.0.900", "0.0.802", "0.0.7001", "too much", signer)
+	if err != nil || result.Envelope == nil {
+		t.Fatalf("expected an envelope result, got %+v, %v", result, err)
+	}
+	transaction, ok := result.Envelope.Transaction().(hedera.TopicMessageSubmitTransaction)
+	if !ok || transaction.GetTopicID().String() != "0.0.902" {
+		t.Fatalf("expected a message to the transaction topic, got %T", result.Envelope.Transaction())
+	}
+
+	memberKey, _ := hedera.PrivateKeyGenerateEd25519()
+	result, err = client.RejectTransaction(context.Background(), "0.0.900", "0.0.801", "0.0.7001", "", shared.NewLocalSigner(memberKey))
+	if err != nil || result.Envelope == nil {
+		t.Fatalf("expected an envelope result, got %+v, %v", result, err)
+	}
+	transactionID, err := result.Envelope.TransactionID()
+	if err != nil || transactionID.AccountID == nil || transactionID.AccountID.String() != "0.0.801" {
+		t.Fatalf("expected the rejecting member to pay for its message, got %v, %v", transactionID, err)
+	}
+}
+
+func TestBuildTransactionRejectedTx(t *testing.T) {
+	transaction, err := BuildTransactionRejectedTx("0.0.902", "0.0.802", "0.0.7001", "too much")
+	if err != nil {
+		t.Fatalf("BuildTransactionRejectedTx failed: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(transaction.GetMessage(), &payload); err != nil {
+		t.Fatalf("failed to decode message payload: %v", err)
+	}
+	if payload["op"] != string(FloraOperationTransactionRejected) || payload["schedule_id"] != "0.0.7001" {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+	if memo := transaction.GetTransactionMemo(); memo != "hcs-16:op:10:1" {
+		t.Fatalf("unexpected transaction memo %s", memo)
+	}
+	if _, err := BuildTransactionRejectedTx("0.0.902", "0.0.802", "", ""); err == nil {
+		t.Fatalf("expected error for missing schedule ID")
+	}
+}
@@ -16,6 +16,13 @@ var hcs16OperationEnumByOperation = map[FloraOperation]int{
 	FloraOperationJoinRequest:  3,
 	FloraOperationJoinVote:     4,
 	FloraOperationJoinAccepted: 5,
+
+	FloraOperationRemoveProposal:    6,
+	FloraOperationThresholdProposal: 7,
+	FloraOperationProposalVote:      8,
+	FloraOperationProposalAccepted:  9,
+
+	FloraOperationTransactionRejected: 10,
 }
 
 var hcs16TopicTypeByOperation = map[FloraOperation]FloraTopicType{
@@ -25,6 +32,13 @@ var hcs16TopicTypeByOperation = map[FloraOperation]FloraTopicType{
 	FloraOperationJoinRequest:  FloraTopicTypeCommunication,
 	FloraOperationJoinVote:     FloraTopicTypeCommunication,
 	FloraOperationJoinAccepted: FloraTopicTypeState,
+
+	FloraOperationRemoveProposal:    FloraTopicTypeCommunication,
+	FloraOperationThresholdProposal: FloraTopicTypeCommunication,
+	FloraOperationProposalVote:      FloraTopicTypeCommunication,
+	FloraOperationProposalAccepted:  FloraTopicTypeState,
+
+	FloraOperationTransactionRejected: FloraTopicTypeTransaction,
 }
 
 // BuildCreateFloraTopicTx builds and returns the configured value.
@@ -246,6 +260,25 @@ func BuildTransactionTx(
 	}, "")
 }
 
+// BuildTransactionRejectedTx builds a member's rejection of a proposed schedule.
+func BuildTransactionRejectedTx(
+	topicID string,
+	operatorID string,
+	scheduleID string,
+	reason string,
+) (*hedera.TopicMessageSubmitTransaction, error) {
+	if strings.TrimSpace(scheduleID) == "" {
+		return nil, fmt.Errorf("schedule ID is required")
+	}
+	body := map[string]any{
+		"schedule_id": strings.TrimSpace(scheduleID),
+	}
+	if strings.TrimSpace(reason) != "" {
+		body["reason"] = strings.TrimSpace(reason)
+	}
+	return BuildMessageTx(topicID, operatorID, FloraOperationTransactionRejected, body, "")
+}
+
 // BuildStateUpdateTx builds and returns the configured value.
 func BuildStateUpdateTx(
 	topicID string,
@@ -349,6 +382,91 @@ func BuildFloraJoinAcceptedTx(
 	return BuildMessageTx(topicID, operatorID, FloraOperationJoinAccepted, body, "")
 }
 
+// BuildFloraRemoveProposalTx builds a proposal to remove accountID from the
+// flora. A member proposing its own removal is leaving the flora.
+func BuildFloraRemoveProposalTx(
+	topicID string,
+	operatorID string,
+	accountID string,
+	reason string,
+) (*hedera.TopicMessageSubmitTransaction, error) {
+	if strings.TrimSpace(accountID) == "" {
+		return nil, fmt.Errorf("account ID is required")
+	}
+	body := map[string]any{
+		"account_id": strings.TrimSpace(accountID),
+	}
+	if strings.TrimSpace(reason) != "" {
+		body["reason"] = strings.TrimSpace(reason)
+	}
+	return BuildMessageTx(topicID, operatorID, FloraOperationRemoveProposal, body, "")
+}
+
+// BuildFloraThresholdProposalTx builds a proposal to change the flora threshold.
+func BuildFloraThresholdProposalTx(
+	topicID string,
+	operatorID string,
+	threshold int,
+	reason string,
+) (*hedera.TopicMessageSubmitTransaction, error) {
+	if threshold <= 0 {
+		return nil, fmt.Errorf("threshold must be positive")
+	}
+	body := map[string]any{
+		"threshold": threshold,
+	}
+	if strings.TrimSpace(reason) != "" {
+		body["reason"] = strings.TrimSpace(reason)
+	}
+	return BuildMessageTx(topicID, operatorID, FloraOperationThresholdProposal, body, "")
+}
+
+// BuildFloraProposalVoteTx builds a vote on the proposal published at proposalSequence.
+func BuildFloraProposalVoteTx(
+	topicID string,
+	operatorID string,
+	proposalSequence int64,
+	approve bool,
+) (*hedera.TopicMessageSubmitTransaction, error) {
+	if proposalSequence <= 0 {
+		return nil, fmt.Errorf("proposal sequence number is required")
+	}
+	return BuildMessageTx(topicID, operatorID, FloraOperationProposalVote, map[string]any{
+		"proposal_seq": proposalSequence,
+		"approve":      approve,
+	}, "")
+}
+
+// BuildFloraProposalAcceptedTx builds the message recording an accepted
+// proposal and the membership, threshold and epoch that result from it.
+func BuildFloraProposalAcceptedTx(
+	topicID string,
+	operatorID string,
+	proposalSequence int64,
+	members []string,
+	threshold int,
+	epoch *int64,
+) (*hedera.TopicMessageSubmitTransaction, error) {
+	if proposalSequence <= 0 {
+		return nil, fmt.Errorf("proposal sequence number is required")
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("members are required")
+	}
+	if threshold <= 0 || threshold > len(members) {
+		return nil, fmt.Errorf("threshold %d is invalid for %d members", threshold, len(members))
+	}
+	body := map[string]any{
+		"proposal_seq": proposalSequence,
+		"members":      members,
+		"threshold":    threshold,
+	}
+	if epoch != nil {
+		body["epoch"] = *epoch
+	}
+	return BuildMessageTx(topicID, operatorID, FloraOperationProposalAccepted, body, "")
+}
+
 func normalizeMemo(value string, fallback string) string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
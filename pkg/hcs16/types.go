@@ -1,6 +1,13 @@
 package hcs16
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs17"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type FloraTopicType int
 
@@ -19,11 +26,26 @@ const (
 	FloraOperationJoinRequest  FloraOperation = "flora_join_request"
 	FloraOperationJoinVote     FloraOperation = "flora_join_vote"
 	FloraOperationJoinAccepted FloraOperation = "flora_join_accepted"
+
+	FloraOperationRemoveProposal    FloraOperation = "flora_remove_proposal"
+	FloraOperationThresholdProposal FloraOperation = "flora_threshold_proposal"
+	FloraOperationProposalVote      FloraOperation = "flora_proposal_vote"
+	FloraOperationProposalAccepted  FloraOperation = "flora_proposal_accepted"
+
+	FloraOperationTransactionRejected FloraOperation = "transaction_rejected"
+)
+
+type FloraProposalType string
+
+const (
+	FloraProposalTypeRemoveMember FloraProposalType = "remove_member"
+	FloraProposalTypeThreshold    FloraProposalType = "threshold"
 )
 
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	KeyType            string
 	MirrorBaseURL      string
@@ -31,6 +53,14 @@ type ClientConfig struct {
 	InscriberAuthURL   string
 	InscriberAPIURL    string
 	HederaClient       *hedera.Client
+	// ExecutionMode set to shared.ExecutionModeEnvelope makes transaction
+	// methods freeze and sign with the keys they are given, then return the
+	// unsubmitted transaction in the Envelope field of their result. Methods
+	// that only return a receipt or an ID reject envelope mode; freeze their
+	// Build*Tx transaction with Client.BuildEnvelope instead.
+	ExecutionMode shared.ExecutionMode
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type FloraMember struct {
@@ -61,7 +91,10 @@ type CreateFloraTopicOptions struct {
 	SubmitKey        hedera.Key
 	AutoRenewAccount string
 	SignerKeys       []hedera.PrivateKey
-	TransactionMemo  string
+	// Signers sign alongside SignerKeys, for member keys held in a KMS or
+	// HSM.
+	Signers         []shared.Signer
+	TransactionMemo string
 }
 
 type CreateFloraAccountOptions struct {
@@ -116,11 +149,207 @@ type ParseTopicMemoResult struct {
 	TopicType      FloraTopicType
 }
 
+// FloraTransactionResult reports a submitted HCS-16 transaction. In envelope
+// mode Receipt is empty and Envelope holds the frozen, unsubmitted
+// transaction.
+type FloraTransactionResult struct {
+	TransactionID string
+	Receipt       hedera.TransactionReceipt
+	Envelope      *shared.TxEnvelope
+}
+
 type CreateFloraAccountWithTopicsResult struct {
 	FloraAccountID string
 	Topics         FloraTopics
 }
 
+// FloraState is a flora's state as reconstructed by LoadFlora.
+type FloraState struct {
+	FloraAccountID      string
+	ProfileTopicID      string
+	DisplayName         string
+	Topics              FloraTopics
+	Members             []FloraMember
+	Threshold           int
+	Epoch               int64
+	CreatedBy           string
+	CreatedAt           string
+	PendingJoinRequests []FloraJoinRequest
+	PendingProposals    []FloraProposal
+	LatestStateHash     *FloraStateHash
+	// StateHashes holds the latest state hash each account published on the
+	// state topic, keyed by account ID. Only messages paid for by the
+	// account itself count.
+	StateHashes               map[string]FloraStateHash
+	LastCommunicationSequence int64
+	LastStateSequence         int64
+}
+
+// FloraJoinRequest is a join request that has not yet been accepted. Votes
+// maps member account IDs to their latest vote.
+type FloraJoinRequest struct {
+	AccountID           string
+	RequestedBy         string
+	ConnectionRequestID int64
+	ConnectionTopicID   string
+	ConnectionSequence  int64
+	SequenceNumber      int64
+	ConsensusTimestamp  string
+	Votes               map[string]bool
+}
+
+// FloraProposal is an open removal or threshold-change proposal. It is
+// identified by the sequence number of the proposal message on the
+// communication topic. A removal proposed by the member being removed is a
+// voluntary leave.
+type FloraProposal struct {
+	Type               FloraProposalType
+	ProposedBy         string
+	AccountID          string
+	Threshold          int
+	Reason             string
+	SequenceNumber     int64
+	ConsensusTimestamp string
+	Votes              map[string]bool
+}
+
+// FloraPendingTransaction is a schedule proposed on the flora transaction
+// topic that has neither executed nor been deleted. Members that have not
+// signed or rejected it are listed in AwaitingSignatures; RejectedBy maps
+// rejecting members to their reason. Rejected is set once the members that
+// have not rejected can no longer reach Threshold.
+type FloraPendingTransaction struct {
+	ScheduleID         string
+	ProposedBy         string
+	Description        string
+	SequenceNumber     int64
+	ConsensusTimestamp string
+	Summary            FloraTransactionSummary
+	SignedBy           []string
+	AwaitingSignatures []string
+	RejectedBy         map[string]string
+	Threshold          int
+	Rejected           bool
+	PayerAccountID     string
+	CreatorAccountID   string
+	ExpirationTime     string
+	ScheduleMemo       string
+}
+
+// FloraTransactionSummary describes a scheduled transaction body. Type is
+// the protobuf field name of the transaction, such as "cryptoTransfer".
+type FloraTransactionSummary struct {
+	Type           string
+	Memo           string
+	MaxFeeTinybars int64
+	Details        []string
+	HbarTransfers  []FloraHbarTransfer
+	TokenTransfers []FloraTokenTransfer
+}
+
+type FloraHbarTransfer struct {
+	AccountID string
+	Tinybars  int64
+}
+
+// FloraTokenTransfer is a fungible transfer, or an NFT transfer from
+// SenderAccountID to AccountID when IsNonFungibleItem is set.
+type FloraTokenTransfer struct {
+	TokenID           string
+	AccountID         string
+	SenderAccountID   string
+	Amount            int64
+	SerialNumber      int64
+	IsNonFungibleItem bool
+}
+
+// FloraProposalTally is the vote count on an open proposal.
+type FloraProposalTally struct {
+	Proposal   FloraProposal
+	Approvals  []string
+	Rejections []string
+	Threshold  int
+	Approved   bool
+	Rejected   bool
+}
+
+// FloraStateHash is the latest HCS-17 state hash on the flora state topic.
+type FloraStateHash struct {
+	StateHash          string
+	Epoch              int64
+	AccountID          string
+	Topics             []string
+	Memo               string
+	Timestamp          string
+	Payer              string
+	SequenceNumber     int64
+	ConsensusTimestamp string
+}
+
+// FloraCompositeState is a flora's HCS-17 composite state hash and the
+// inputs it was computed from. Receipt is set once the hash is published, or
+// Envelope in envelope mode.
+type FloraCompositeState struct {
+	StateHash      string
+	FloraAccountID string
+	Epoch          int64
+	MemberStates   []hcs17.CompositeMemberState
+	Topics         []hcs17.TopicState
+	KeyFingerprint string
+	Receipt        hedera.TransactionReceipt
+	Envelope       *shared.TxEnvelope
+}
+
+type FloraJoinCoordinatorConfig struct {
+	Client         *Client
+	FloraAccountID string
+	// MemberSigners holds the signers of the flora members this process
+	// votes and signs for, keyed by member account ID. Members in other
+	// processes sign schedules with SignScheduleWithSigner.
+	MemberSigners map[string]shared.Signer
+	// RotationDelay is how long after scheduling a key rotation executes,
+	// which is also how long members have to sign it. Defaults to 30
+	// minutes.
+	RotationDelay time.Duration
+}
+
+// FloraJoinTally is the vote count on a pending join request. Rejected is
+// set once the members that have not rejected can no longer reach Threshold.
+type FloraJoinTally struct {
+	Request    FloraJoinRequest
+	Approvals  []string
+	Rejections []string
+	Threshold  int
+	Approved   bool
+	Rejected   bool
+}
+
+// FloraKeyRotation records the schedules created to apply a membership or
+// threshold change. AccountID is the joining or departing member, and
+// ProposalSequence is set for removal and threshold proposals.
+// ScheduleSignatures maps each schedule ID to the members that signed it.
+type FloraKeyRotation struct {
+	FloraAccountID    string
+	AccountID         string
+	ProposalSequence  int64
+	PreviousMembers   []string
+	PreviousThreshold int
+	Members           []string
+	Threshold         int
+	Epoch             int64
+	Topics            FloraTopics
+	KeyList           *hedera.KeyList
+	SubmitKeyList     *hedera.KeyList
+	AccountScheduleID string
+	TopicScheduleIDs  map[FloraTopicType]string
+	// ExpirationTime is when every schedule of the rotation executes.
+	ExpirationTime     time.Time
+	Signers            []string
+	ScheduleSignatures map[string][]string
+	Accepted           bool
+	AcceptedReceipt    hedera.TransactionReceipt
+}
+
 const (
 	HCS16FloraAccountCreateTransactionMemo = "hcs-16:op:0:0"
 	HCS16AccountKeyUpdateTransactionMemo   = "hcs-16:op:1:1"
@@ -0,0 +1,237 @@
+package hcs17
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+const defaultMaxClaimedTimestampAge = 2 * time.Minute
+
+// Auditor replays the state hash messages published to a state topic and
+// recomputes each one from the running hashes its topics had when it was
+// published.
+type Auditor struct {
+	client        *Client
+	publicKeys    map[string]any
+	maxClaimedAge time.Duration
+}
+
+// NewAuditor creates an auditor that reads through config.Client's mirror
+// node.
+func NewAuditor(config AuditorConfig) (*Auditor, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if config.MaxClaimedTimestampAge < 0 {
+		return nil, fmt.Errorf("max claimed timestamp age must not be negative")
+	}
+	maxClaimedAge := config.MaxClaimedTimestampAge
+	if maxClaimedAge == 0 {
+		maxClaimedAge = defaultMaxClaimedTimestampAge
+	}
+
+	publicKeys := make(map[string]any, len(config.PublicKeys))
+	for accountID, publicKey := range config.PublicKeys {
+		if _, err := normalizePublicKeyValue(publicKey); err != nil {
+			return nil, fmt.Errorf("invalid public key for account %s: %w", accountID, err)
+		}
+		publicKeys[strings.TrimSpace(accountID)] = publicKey
+	}
+
+	return &Auditor{
+		client:        config.Client,
+		publicKeys:    publicKeys,
+		maxClaimedAge: maxClaimedAge,
+	}, nil
+}
+
+// Audit replays every valid state hash message on stateTopicID in
+// consensus order. Messages whose hash cannot be reproduced are reported as
+// divergences rather than errors; an error means the audit is incomplete.
+func (auditor *Auditor) Audit(ctx context.Context, stateTopicID string) (AuditReport, error) {
+	items, err := auditor.client.mirrorClient.GetTopicMessages(ctx, stateTopicID, mirror.MessageQueryOptions{
+		Order: "asc",
+	})
+	if err != nil {
+		return AuditReport{}, fmt.Errorf("failed to read state topic %s: %w", stateTopicID, err)
+	}
+
+	report := AuditReport{
+		StateTopicID: stateTopicID,
+		Entries:      make([]StateHashAudit, 0, len(items)),
+	}
+	for _, item := range items {
+		record, ok := decodeMessageRecord(item)
+		if !ok {
+			continue
+		}
+		entry, err := auditor.auditRecord(ctx, record)
+		if err != nil {
+			return AuditReport{}, err
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	return report, nil
+}
+
+// AuditMessage recomputes a single published state hash.
+func (auditor *Auditor) AuditMessage(ctx context.Context, record MessageRecord) (StateHashAudit, error) {
+	return auditor.auditRecord(ctx, record)
+}
+
+// auditRecord recomputes record as of its consensus timestamp. Messages the
+// named account did not pay for are divergences without recomputation, since
+// anyone can publish a hash in another account's name. Because the
+// publisher reads running hashes before submitting, a mismatch is retried as
+// of the timestamp stamped on the message, but only when that is no later
+// than consensus and no older than the auditor's maxClaimedAge. The account
+// key is the one it held at consensus, so earlier messages still verify
+// after the account rotates its key.
+func (auditor *Auditor) auditRecord(
+	ctx context.Context,
+	record MessageRecord,
+) (StateHashAudit, error) {
+	entry := StateHashAudit{Record: record}
+	if payer := strings.TrimSpace(record.Payer); payer != record.Message.AccountID {
+		entry.Reason = fmt.Sprintf("message was paid for by %s, not account %s", payer, record.Message.AccountID)
+		return entry, nil
+	}
+
+	consensus := strings.TrimSpace(record.ConsensusTimestamp)
+	consensusTime, err := parseMirrorTimestamp(consensus)
+	if err != nil {
+		entry.Reason = "message has no consensus timestamp to recompute the state hash at"
+		return entry, nil
+	}
+
+	publicKey, err := auditor.resolvePublicKey(ctx, record.Message.AccountID, formatMirrorTimestamp(consensusTime))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return StateHashAudit{}, ctxErr
+		}
+		entry.Reason = err.Error()
+		return entry, nil
+	}
+
+	type candidate struct {
+		asOf   string
+		source AuditTimestamp
+	}
+	candidates := []candidate{{asOf: formatMirrorTimestamp(consensusTime), source: AuditTimestampConsensus}}
+	var ignoredClaim string
+	if claimed, parseErr := time.Parse(time.RFC3339Nano, record.Message.Timestamp); parseErr == nil {
+		age := consensusTime.Sub(claimed)
+		switch {
+		case age < 0 || age > auditor.maxClaimedAge:
+			ignoredClaim = fmt.Sprintf(
+				"; claimed timestamp %s is not within %s before consensus",
+				record.Message.Timestamp,
+				auditor.maxClaimedAge,
+			)
+		case age > 0:
+			candidates = append(candidates, candidate{asOf: formatMirrorTimestamp(claimed), source: AuditTimestampClaimed})
+		}
+	}
+
+	for index, candidate := range candidates {
+		calculated, err := auditor.client.computeAccountState(
+			ctx,
+			record.Message.AccountID,
+			publicKey,
+			record.Message.Topics,
+			candidate.asOf,
+		)
+		if err != nil {
+			return StateHashAudit{}, fmt.Errorf(
+				"failed to recompute state hash from sequence %d: %w",
+				record.SequenceNumber,
+				err,
+			)
+		}
+		if index == 0 {
+			entry.ComputedHash = calculated.StateHash
+			entry.AsOf = candidate.asOf
+			entry.AsOfSource = candidate.source
+		}
+		if calculated.StateHash == record.Message.StateHash {
+			entry.ComputedHash = calculated.StateHash
+			entry.AsOf = candidate.asOf
+			entry.AsOfSource = candidate.source
+			entry.Valid = true
+			return entry, nil
+		}
+	}
+
+	entry.Reason = fmt.Sprintf("state hash does not match topic running hashes as of %s%s", entry.AsOf, ignoredClaim)
+	return entry, nil
+}
+
+// resolvePublicKey returns the configured key for accountID, or the key the
+// mirror node reports it held at asOf. Threshold and key list keys cannot be
+// hashed as a single key and must be configured.
+func (auditor *Auditor) resolvePublicKey(
+	ctx context.Context,
+	accountID string,
+	asOf string,
+) (any, error) {
+	if publicKey, ok := auditor.publicKeys[accountID]; ok {
+		return publicKey, nil
+	}
+
+	info, err := auditor.client.mirrorClient.GetAccountAt(ctx, accountID, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account %s: %w", accountID, err)
+	}
+	keyType, _ := info.Key["_type"].(string)
+	rawKey, _ := info.Key["key"].(string)
+	rawKey = strings.TrimSpace(rawKey)
+	if rawKey == "" {
+		return nil, fmt.Errorf("mirror node did not return a public key for account %s", accountID)
+	}
+
+	var publicKey hedera.PublicKey
+	switch keyType {
+	case "ECDSA_SECP256K1":
+		publicKey, err = hedera.PublicKeyFromStringECDSA(rawKey)
+	case "ED25519":
+		publicKey, err = hedera.PublicKeyFromStringEd25519(rawKey)
+	case "ProtobufEncoded":
+		err = fmt.Errorf("account %s has a key list; configure its public key on the auditor", accountID)
+	default:
+		publicKey, err = hedera.PublicKeyFromString(rawKey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key for account %s: %w", accountID, err)
+	}
+	return publicKey, nil
+}
+
+// formatMirrorTimestamp formats t as a mirror node consensus timestamp.
+func formatMirrorTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+// parseMirrorTimestamp parses a mirror node consensus timestamp.
+func parseMirrorTimestamp(value string) (time.Time, error) {
+	seconds, nanos, _ := strings.Cut(strings.TrimSpace(value), ".")
+	unixSeconds, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid consensus timestamp %q", value)
+	}
+	var unixNanos int64
+	if nanos != "" {
+		nanos = (nanos + "000000000")[:9]
+		if unixNanos, err = strconv.ParseInt(nanos, 10, 64); err != nil {
+			return time.Time{}, fmt.Errorf("invalid consensus timestamp %q", value)
+		}
+	}
+	return time.Unix(unixSeconds, unixNanos).UTC(), nil
+}
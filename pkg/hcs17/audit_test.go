@@ -0,0 +1,282 @@
+package hcs17
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+// fakeStateMirror serves topic messages with timestamp=lte: and lt:
+// filtering and a single ECDSA account.
+type fakeStateMirror struct {
+	topics    map[string][]mirror.TopicMessage
+	publicKey hedera.PublicKey
+	queries   []string
+	// rotatedKey replaces publicKey for account queries at or after
+	// rotatedAt.
+	rotatedKey *hedera.PublicKey
+	rotatedAt  string
+}
+
+func (f *fakeStateMirror) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/api/v1/accounts/0.0.2" {
+		publicKey := f.publicKey
+		if f.rotatedKey != nil {
+			asOf, err := parseMirrorTimestamp(strings.TrimPrefix(r.URL.Query().Get("timestamp"), "lte:"))
+			rotatedAt, _ := parseMirrorTimestamp(f.rotatedAt)
+			if err != nil || !asOf.Before(rotatedAt) {
+				publicKey = *f.rotatedKey
+			}
+		}
+		json.NewEncoder(w).Encode(mirror.AccountInfo{
+			Account: "0.0.2",
+			Key:     map[string]any{"_type": "ECDSA_SECP256K1", "key": publicKey.StringRaw()},
+		})
+		return
+	}
+	topicID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/topics/"), "/messages")
+	messages, ok := f.topics[topicID]
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	f.queries = append(f.queries, topicID+"?"+r.URL.RawQuery)
+
+	filtered := make([]mirror.TopicMessage, 0, len(messages))
+	operator, bound, _ := strings.Cut(r.URL.Query().Get("timestamp"), ":")
+	for _, message := range messages {
+		if bound != "" {
+			at, _ := parseMirrorTimestamp(message.ConsensusTimestamp)
+			limit, _ := parseMirrorTimestamp(bound)
+			if at.After(limit) || (operator == "lt" && at.Equal(limit)) {
+				continue
+			}
+		}
+		filtered = append(filtered, message)
+	}
+	if r.URL.Query().Get("order") == "desc" {
+		for left, right := 0, len(filtered)-1; left < right; left, right = left+1, right-1 {
+			filtered[left], filtered[right] = filtered[right], filtered[left]
+		}
+	}
+	if limit, _ := strconv.Atoi(r.URL.Query().Get("limit")); limit > 0 && len(filtered) > limit {
+		filtered = filtered[:limit]
+	}
+	json.NewEncoder(w).Encode(map[string]any{"messages": filtered})
+}
+
+func newFakeStateMirror(t *testing.T) (*fakeStateMirror, *Client, func()) {
+	t.Helper()
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	fake := &fakeStateMirror{
+		publicKey: privateKey.PublicKey(),
+		topics: map[string][]mirror.TopicMessage{
+			"0.0.1": {
+				{ConsensusTimestamp: "1000.000000000", RunningHash: "a1"},
+				{ConsensusTimestamp: "2000.000000000", RunningHash: "a2"},
+			},
+			"0.0.3": {
+				{ConsensusTimestamp: "1500.000000000", RunningHash: "b1"},
+			},
+			"0.0.100": {},
+		},
+	}
+	server := httptest.NewServer(fake)
+	operatorKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	client, err := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  "0.0.999",
+		OperatorPrivateKey: operatorKey.String(),
+		MirrorBaseURL:      server.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	return fake, client, server.Close
+}
+
+func (f *fakeStateMirror) publish(t *testing.T, client *Client, consensus string, created time.Time, hash string) {
+	t.Helper()
+	message := client.CreateStateHashMessage(hash, "0.0.2", []string{"0.0.1", "0.0.3"}, "", nil)
+	message.Timestamp = created.UTC().Format(time.RFC3339Nano)
+	payload, _ := json.Marshal(message)
+	f.topics["0.0.100"] = append(f.topics["0.0.100"], mirror.TopicMessage{
+		ConsensusTimestamp: consensus,
+		SequenceNumber:     int64(len(f.topics["0.0.100"]) + 1),
+		PayerAccountID:     "0.0.2",
+		Message:            base64.StdEncoding.EncodeToString(payload),
+	})
+}
+
+func expectedStateHash(t *testing.T, client *Client, publicKey hedera.PublicKey, runningHashes ...string) string {
+	t.Helper()
+	calculated, err := client.CalculateAccountStateHash(AccountStateInput{
+		AccountID: "0.0.2",
+		PublicKey: publicKey,
+		Topics: []TopicState{
+			{TopicID: "0.0.1", LatestRunningHash: runningHashes[0]},
+			{TopicID: "0.0.3", LatestRunningHash: runningHashes[1]},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CalculateAccountStateHash failed: %v", err)
+	}
+	return calculated.StateHash
+}
+
+func TestAuditorReplaysStateTopic(t *testing.T) {
+	fake, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	// Computed at 1600 from a1/b1 and reaching consensus right after.
+	fake.publish(t, client, "1600.000000001", time.Unix(1600, 0), expectedStateHash(t, client, fake.publicKey, "a1", "b1"))
+	// Stamped before topic 0.0.1 moved on but computed from a2.
+	fake.publish(t, client, "2000.500000000", time.Unix(1999, 0), expectedStateHash(t, client, fake.publicKey, "a2", "b1"))
+	// Computed from a1/b1 just before topic 0.0.1 moved on, so only the
+	// claimed timestamp reproduces it.
+	fake.publish(t, client, "2000.600000000", time.Unix(1999, 0), expectedStateHash(t, client, fake.publicKey, "a1", "b1"))
+	// Published with a hash no history supports.
+	fake.publish(t, client, "2100.000000000", time.Unix(2100, 0), "bogus")
+	// Claims a timestamp too long before consensus.
+	fake.publish(t, client, "2200.000000000", time.Unix(1600, 0), expectedStateHash(t, client, fake.publicKey, "a1", "b1"))
+	// Claims a timestamp after consensus, when topic 0.0.1 had moved on.
+	fake.topics["0.0.1"] = append(fake.topics["0.0.1"], mirror.TopicMessage{ConsensusTimestamp: "3000.000000000", RunningHash: "a3"})
+	fake.publish(t, client, "2900.000000000", time.Unix(3000, 0), expectedStateHash(t, client, fake.publicKey, "a3", "b1"))
+
+	auditor, err := NewAuditor(AuditorConfig{Client: client})
+	if err != nil {
+		t.Fatalf("NewAuditor failed: %v", err)
+	}
+	report, err := auditor.Audit(context.Background(), "0.0.100")
+	if err != nil {
+		t.Fatalf("Audit failed: %v", err)
+	}
+	if len(report.Entries) != 6 {
+		t.Fatalf("expected 6 entries, got %d", len(report.Entries))
+	}
+	for index, expected := range []struct {
+		asOf   string
+		source AuditTimestamp
+	}{
+		{"1600.000000001", AuditTimestampConsensus},
+		{"2000.500000000", AuditTimestampConsensus},
+		{"1999.000000000", AuditTimestampClaimed},
+	} {
+		entry := report.Entries[index]
+		if !entry.Valid || entry.AsOf != expected.asOf || entry.AsOfSource != expected.source {
+			t.Fatalf("unexpected entry %d: %+v", index, entry)
+		}
+	}
+	divergences := report.Divergences()
+	if len(divergences) != 3 || divergences[0].Record.SequenceNumber != 4 || divergences[0].ComputedHash == "" ||
+		divergences[0].AsOf != "2100.000000000" || divergences[0].Reason == "" {
+		t.Fatalf("unexpected divergences %+v", divergences)
+	}
+	for _, divergence := range divergences[1:] {
+		if divergence.AsOfSource != AuditTimestampConsensus || !strings.Contains(divergence.Reason, "claimed timestamp") {
+			t.Fatalf("expected the claimed timestamp to be ignored, got %+v", divergence)
+		}
+	}
+
+	lenient, _ := NewAuditor(AuditorConfig{Client: client, MaxClaimedTimestampAge: time.Hour})
+	entry, err := lenient.AuditMessage(context.Background(), report.Entries[4].Record)
+	if err != nil || !entry.Valid || entry.AsOfSource != AuditTimestampClaimed {
+		t.Fatalf("expected a wider window to accept the claimed timestamp, got %+v, %v", entry, err)
+	}
+	for _, query := range fake.queries[1:] {
+		if !strings.Contains(query, "timestamp=lte") {
+			t.Fatalf("expected historical queries, got %s", query)
+		}
+	}
+
+	override, _ := hedera.PrivateKeyGenerateEcdsa()
+	overridden, _ := NewAuditor(AuditorConfig{
+		Client:     client,
+		PublicKeys: map[string]any{"0.0.2": override.PublicKey()},
+	})
+	entry, err = overridden.AuditMessage(context.Background(), report.Entries[0].Record)
+	if err != nil || entry.Valid {
+		t.Fatalf("expected the overriding key to diverge, got %+v, %v", entry, err)
+	}
+}
+
+func TestAuditorUsesKeyHeldAtConsensus(t *testing.T) {
+	fake, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	fake.publish(t, client, "1600.000000000", time.Unix(1600, 0), expectedStateHash(t, client, fake.publicKey, "a1", "b1"))
+	rotated, _ := hedera.PrivateKeyGenerateEcdsa()
+	rotatedKey := rotated.PublicKey()
+	fake.rotatedKey = &rotatedKey
+	fake.rotatedAt = "1700.000000000"
+	fake.publish(t, client, "1800.000000000", time.Unix(1800, 0), expectedStateHash(t, client, rotatedKey, "a1", "b1"))
+
+	auditor, _ := NewAuditor(AuditorConfig{Client: client})
+	report, err := auditor.Audit(context.Background(), "0.0.100")
+	if err != nil {
+		t.Fatalf("Audit failed: %v", err)
+	}
+	if len(report.Entries) != 2 || len(report.Divergences()) != 0 {
+		t.Fatalf("expected both sides of the key rotation to verify, got %+v", report.Entries)
+	}
+}
+
+func TestAuditorRejectsMessagesNotPaidByAccount(t *testing.T) {
+	fake, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	fake.publish(t, client, "1600.000000000", time.Unix(1600, 0), expectedStateHash(t, client, fake.publicKey, "a1", "b1"))
+	fake.topics["0.0.100"][0].PayerAccountID = "0.0.7"
+
+	auditor, _ := NewAuditor(AuditorConfig{Client: client})
+	report, err := auditor.Audit(context.Background(), "0.0.100")
+	if err != nil {
+		t.Fatalf("Audit failed: %v", err)
+	}
+	divergences := report.Divergences()
+	if len(divergences) != 1 || !strings.Contains(divergences[0].Reason, "paid for by 0.0.7") {
+		t.Fatalf("expected a message paid by another account to diverge, got %+v", report.Entries)
+	}
+}
+
+func TestAuditorValidation(t *testing.T) {
+	if _, err := NewAuditor(AuditorConfig{}); err == nil {
+		t.Fatal("expected error for missing client")
+	}
+	_, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+	if _, err := NewAuditor(AuditorConfig{Client: client, PublicKeys: map[string]any{"0.0.2": 42}}); err == nil {
+		t.Fatal("expected error for invalid public key override")
+	}
+	if _, err := NewAuditor(AuditorConfig{Client: client, MaxClaimedTimestampAge: -time.Second}); err == nil {
+		t.Fatal("expected error for a negative claimed timestamp age")
+	}
+	auditor, _ := NewAuditor(AuditorConfig{Client: client})
+	if _, err := auditor.Audit(context.Background(), "0.0.404"); err == nil {
+		t.Fatal("expected error for unreadable state topic")
+	}
+}
+
+func TestMirrorTimestamps(t *testing.T) {
+	parsed, err := parseMirrorTimestamp("1700000000.5")
+	if err != nil || parsed.UnixNano() != 1700000000500000000 {
+		t.Fatalf("unexpected parse %v, %v", parsed, err)
+	}
+	if formatted := formatMirrorTimestamp(parsed); formatted != "1700000000.500000000" {
+		t.Fatalf("unexpected format %s", formatted)
+	}
+	if _, err := parseMirrorTimestamp("invalid"); err == nil {
+		t.Fatal("expected error for invalid timestamp")
+	}
+}
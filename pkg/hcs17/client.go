@@ -19,6 +19,7 @@ import (
 
 type Client struct {
 	hederaClient *hedera.Client
+	executor     *shared.Executor
 	mirrorClient *mirror.Client
 	operatorID   hedera.AccountID
 	operatorKey  hedera.PrivateKey
@@ -31,11 +32,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -52,6 +54,7 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient: hederaClient,
+		executor:     config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient: mirrorClient,
 		operatorID:   operator.AccountID,
 		operatorKey:  operator.PrivateKey,
@@ -73,17 +76,12 @@ func (c *Client) CreateStateTopic(
 	ctx context.Context,
 	options CreateTopicOptions,
 ) (string, error) {
-	_ = ctx
-
 	transaction := BuildCreateStateTopicTx(options)
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute state topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", fmt.Errorf("failed to get state topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", fmt.Errorf("failed to create HCS-17 topic")
 	}
@@ -97,21 +95,15 @@ func (c *Client) SubmitMessage(
 	message StateHashMessage,
 	transactionMemo string,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction, err := BuildStateHashMessageTx(topicID, message, transactionMemo)
 	if err != nil {
 		return hedera.TransactionReceipt{}, err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, fmt.Errorf("failed to execute HCS-17 message transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to get HCS-17 message receipt: %w", err)
-	}
-	return receipt, nil
+	return executed.Receipt, nil
 }
 
 // ComputeAndPublish computes the requested state payload.
@@ -119,18 +111,31 @@ func (c *Client) ComputeAndPublish(
 	ctx context.Context,
 	options ComputeAndPublishOptions,
 ) (ComputeAndPublishResult, error) {
-	topicStates := make([]TopicState, 0, len(options.Topics))
-	for _, topicID := range options.Topics {
-		messages, err := c.mirrorClient.GetTopicMessages(ctx, topicID, mirror.MessageQueryOptions{
-			Limit: 1,
-			Order: "desc",
-		})
+	calculated, err := c.computeAccountState(ctx, options.AccountID, options.AccountPublicKey, options.Topics, "")
+	if err != nil {
+		return ComputeAndPublishResult{}, err
+	}
+	return c.publishState(ctx, options, calculated.StateHash)
+}
+
+// computeAccountState hashes the running hash of each topic as of the
+// mirror timestamp, or the latest running hashes when timestamp is empty.
+func (c *Client) computeAccountState(
+	ctx context.Context,
+	accountID string,
+	publicKey any,
+	topicIDs []string,
+	timestamp string,
+) (StateHashResult, error) {
+	topicStates := make([]TopicState, 0, len(topicIDs))
+	for _, topicID := range topicIDs {
+		message, err := c.mirrorClient.GetTopicMessageAt(ctx, topicID, timestamp)
 		if err != nil {
-			return ComputeAndPublishResult{}, err
+			return StateHashResult{}, err
 		}
 		runningHash := ""
-		if len(messages) > 0 {
-			runningHash = messages[0].RunningHash
+		if message != nil {
+			runningHash = message.RunningHash
 		}
 		topicStates = append(topicStates, TopicState{
 			TopicID:           topicID,
@@ -138,17 +143,20 @@ func (c *Client) ComputeAndPublish(
 		})
 	}
 
-	calculated, err := c.CalculateAccountStateHash(AccountStateInput{
-		AccountID: options.AccountID,
-		PublicKey: options.AccountPublicKey,
+	return c.CalculateAccountStateHash(AccountStateInput{
+		AccountID: accountID,
+		PublicKey: publicKey,
 		Topics:    topicStates,
 	})
-	if err != nil {
-		return ComputeAndPublishResult{}, err
-	}
+}
 
+func (c *Client) publishState(
+	ctx context.Context,
+	options ComputeAndPublishOptions,
+	stateHash string,
+) (ComputeAndPublishResult, error) {
 	message := c.CreateStateHashMessage(
-		calculated.StateHash,
+		stateHash,
 		options.AccountID,
 		options.Topics,
 		options.Memo,
@@ -160,7 +168,7 @@ func (c *Client) ComputeAndPublish(
 	}
 
 	return ComputeAndPublishResult{
-		StateHash: calculated.StateHash,
+		StateHash: stateHash,
 		Receipt:   receipt,
 	}, nil
 }
@@ -204,29 +212,37 @@ func (c *Client) GetRecentMessages(
 
 	results := make([]MessageRecord, 0, len(items))
 	for _, item := range items {
-		decoded, decodeErr := base64.StdEncoding.DecodeString(item.Message)
-		if decodeErr != nil {
-			continue
+		if record, ok := decodeMessageRecord(item); ok {
+			results = append(results, record)
 		}
-		var message StateHashMessage
-		if unmarshalErr := json.Unmarshal(decoded, &message); unmarshalErr != nil {
-			continue
-		}
-		if validationErrors := ValidateStateHashMessage(message); len(validationErrors) > 0 {
-			continue
-		}
-
-		results = append(results, MessageRecord{
-			Message:            message,
-			ConsensusTimestamp: item.ConsensusTimestamp,
-			SequenceNumber:     item.SequenceNumber,
-			Payer:              item.PayerAccountID,
-		})
 	}
 
 	return results, nil
 }
 
+// decodeMessageRecord decodes a mirror message into a record, reporting
+// false for payloads that are not valid HCS-17 state hash messages.
+func decodeMessageRecord(item mirror.TopicMessage) (MessageRecord, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(item.Message)
+	if err != nil {
+		return MessageRecord{}, false
+	}
+	var message StateHashMessage
+	if err := json.Unmarshal(decoded, &message); err != nil {
+		return MessageRecord{}, false
+	}
+	if validationErrors := ValidateStateHashMessage(message); len(validationErrors) > 0 {
+		return MessageRecord{}, false
+	}
+
+	return MessageRecord{
+		Message:            message,
+		ConsensusTimestamp: item.ConsensusTimestamp,
+		SequenceNumber:     item.SequenceNumber,
+		Payer:              item.PayerAccountID,
+	}, true
+}
+
 // GetLatestMessage returns the requested value.
 func (c *Client) GetLatestMessage(ctx context.Context, topicID string) (*MessageRecord, error) {
 	items, err := c.GetRecentMessages(ctx, topicID, 1, "desc")
@@ -274,6 +290,18 @@ func (c *Client) CalculateAccountStateHash(input AccountStateInput) (StateHashRe
 
 // CalculateCompositeStateHash calculates the requested value.
 func (c *Client) CalculateCompositeStateHash(input CompositeStateInput) (CompositeStateHashResult, error) {
+	return CalculateCompositeStateHash(input)
+}
+
+// CalculateKeyFingerprint calculates the requested value.
+func (c *Client) CalculateKeyFingerprint(keys []hedera.PublicKey, threshold int) (string, error) {
+	return CalculateKeyFingerprint(keys, threshold)
+}
+
+// CalculateCompositeStateHash hashes member state hashes, composite topic
+// running hashes and the composite key fingerprint. It needs no client, so
+// other standards can compute HCS-17 composite hashes directly.
+func CalculateCompositeStateHash(input CompositeStateInput) (CompositeStateHashResult, error) {
 	if strings.TrimSpace(input.CompositeAccountID) == "" {
 		return CompositeStateHashResult{}, fmt.Errorf("composite account ID is required")
 	}
@@ -312,8 +340,9 @@ func (c *Client) CalculateCompositeStateHash(input CompositeStateInput) (Composi
 	}, nil
 }
 
-// CalculateKeyFingerprint calculates the requested value.
-func (c *Client) CalculateKeyFingerprint(keys []hedera.PublicKey, threshold int) (string, error) {
+// CalculateKeyFingerprint hashes the sorted keys and threshold of a
+// threshold key.
+func CalculateKeyFingerprint(keys []hedera.PublicKey, threshold int) (string, error) {
 	if len(keys) == 0 {
 		return "", fmt.Errorf("keys are required")
 	}
@@ -7,6 +7,26 @@
 // deterministic state hashes, enabling applications to prove their state
 // integrity against an immutable, consensus-ordered record on HCS.
 //
+// # Auditing
+//
+// Auditor replays the state hash messages on a state topic and recomputes
+// each one from the running hashes its topics had at its consensus
+// timestamp, read from the mirror node with timestamp=lte: queries. The
+// account key is resolved as of the same timestamp, so a key rotation does
+// not turn earlier messages into divergences. The timestamp the publisher
+// stamped on a message is only tried when it falls shortly before
+// consensus, and each entry records which timestamp matched. Hashes that
+// cannot be reproduced, and messages not paid for by the account they name,
+// are reported as divergences.
+//
+// # Publishing
+//
+// Publisher republishes an account's state hash when the running hash of a
+// watched topic changes and, optionally, on a fixed interval. On its first
+// check it pages back through the state topic to the last hash the account
+// paid to publish. The state topic cannot be one of the hashed topics, since
+// every publication would change the hash it reports.
+//
 // # Specification
 //
 // Full specification: https://hol.org/docs/standards/hcs-17
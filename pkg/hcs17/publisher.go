@@ -0,0 +1,180 @@
+package hcs17
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+const (
+	defaultPublisherPollInterval = 30 * time.Second
+	// seedPageSize is the number of state topic messages read per request
+	// while looking for the account's last state hash.
+	seedPageSize = 100
+)
+
+// Publisher keeps an account's state hash current on a state topic. It
+// publishes when the running hash of a watched topic changes and, when an
+// interval is configured, at least that often. Its client's operator should
+// be the account: messages the account did not pay for are not read back.
+type Publisher struct {
+	client       *Client
+	options      ComputeAndPublishOptions
+	interval     time.Duration
+	pollInterval time.Duration
+	onPublish    func(ComputeAndPublishResult)
+	onError      func(error)
+
+	mu            sync.Mutex
+	seeded        bool
+	lastHash      string
+	lastPublished time.Time
+}
+
+// NewPublisher creates a publisher for config.Options.
+func NewPublisher(config PublisherConfig) (*Publisher, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	options := config.Options
+	options.AccountID = strings.TrimSpace(options.AccountID)
+	options.PublishTopicID = strings.TrimSpace(options.PublishTopicID)
+	if options.AccountID == "" {
+		return nil, fmt.Errorf("account ID is required")
+	}
+	if options.PublishTopicID == "" {
+		return nil, fmt.Errorf("publish topic ID is required")
+	}
+	if len(options.Topics) == 0 {
+		return nil, fmt.Errorf("at least one topic is required")
+	}
+	for _, topicID := range options.Topics {
+		if strings.TrimSpace(topicID) == options.PublishTopicID {
+			return nil, fmt.Errorf("publish topic %s must not be one of the hashed topics", options.PublishTopicID)
+		}
+	}
+	if _, err := normalizePublicKeyValue(options.AccountPublicKey); err != nil {
+		return nil, err
+	}
+	if config.Interval < 0 || config.PollInterval < 0 {
+		return nil, fmt.Errorf("intervals must not be negative")
+	}
+
+	pollInterval := config.PollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultPublisherPollInterval
+	}
+	if config.Interval > 0 && config.Interval < pollInterval {
+		pollInterval = config.Interval
+	}
+
+	return &Publisher{
+		client:       config.Client,
+		options:      options,
+		interval:     config.Interval,
+		pollInterval: pollInterval,
+		onPublish:    config.OnPublish,
+		onError:      config.OnError,
+	}, nil
+}
+
+// PublishIfChanged recomputes the state hash and publishes it when it
+// differs from the last published hash or the interval has elapsed. On the
+// first call the account's last hash is read back from the state topic,
+// however far back it was published, so a restarted publisher does not
+// republish an unchanged state. It reports whether a message was submitted.
+func (publisher *Publisher) PublishIfChanged(ctx context.Context) (ComputeAndPublishResult, bool, error) {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if !publisher.seeded {
+		if err := publisher.seed(ctx); err != nil {
+			return ComputeAndPublishResult{}, false, err
+		}
+		publisher.seeded = true
+	}
+
+	calculated, err := publisher.client.computeAccountState(
+		ctx,
+		publisher.options.AccountID,
+		publisher.options.AccountPublicKey,
+		publisher.options.Topics,
+		"",
+	)
+	if err != nil {
+		return ComputeAndPublishResult{}, false, err
+	}
+
+	due := publisher.interval > 0 && time.Since(publisher.lastPublished) >= publisher.interval
+	if calculated.StateHash == publisher.lastHash && !due {
+		return ComputeAndPublishResult{StateHash: calculated.StateHash}, false, nil
+	}
+
+	result, err := publisher.client.publishState(ctx, publisher.options, calculated.StateHash)
+	if err != nil {
+		return ComputeAndPublishResult{}, false, err
+	}
+	publisher.lastHash = result.StateHash
+	publisher.lastPublished = time.Now()
+	return result, true, nil
+}
+
+// Run checks the watched topics every poll interval until ctx is done,
+// starting immediately. Failed checks are reported to OnError and retried
+// on the next tick. Run returns ctx's error.
+func (publisher *Publisher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(publisher.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, published, err := publisher.PublishIfChanged(ctx)
+		switch {
+		case err != nil:
+			if ctx.Err() == nil && publisher.onError != nil {
+				publisher.onError(err)
+			}
+		case published && publisher.onPublish != nil:
+			publisher.onPublish(result)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// seed loads the most recent state hash the account paid to publish on the
+// state topic, reading it backwards a page at a time until one is found.
+func (publisher *Publisher) seed(ctx context.Context) error {
+	options := mirror.MessageQueryOptions{Limit: seedPageSize, Order: "desc"}
+	for {
+		items, err := publisher.client.mirrorClient.GetTopicMessagesPage(ctx, publisher.options.PublishTopicID, options)
+		if err != nil {
+			return fmt.Errorf("failed to read state topic %s: %w", publisher.options.PublishTopicID, err)
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		for _, item := range items {
+			record, ok := decodeMessageRecord(item)
+			if !ok || record.Message.AccountID != publisher.options.AccountID ||
+				strings.TrimSpace(record.Payer) != publisher.options.AccountID {
+				continue
+			}
+			publishedAt, err := parseMirrorTimestamp(record.ConsensusTimestamp)
+			if err != nil {
+				return err
+			}
+			publisher.lastHash = record.Message.StateHash
+			publisher.lastPublished = publishedAt
+			return nil
+		}
+		options.Timestamp = "lt:" + items[len(items)-1].ConsensusTimestamp
+	}
+}
@@ -0,0 +1,172 @@
+package hcs17
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+func TestNewPublisherValidation(t *testing.T) {
+	_, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	valid := ComputeAndPublishOptions{
+		AccountID:        "0.0.2",
+		AccountPublicKey: "pubkey",
+		Topics:           []string{"0.0.1"},
+		PublishTopicID:   "0.0.100",
+	}
+	publisher, err := NewPublisher(PublisherConfig{Client: client, Options: valid, Interval: time.Second})
+	if err != nil {
+		t.Fatalf("NewPublisher failed: %v", err)
+	}
+	if publisher.pollInterval != time.Second {
+		t.Fatalf("expected the poll interval to be capped at the interval, got %s", publisher.pollInterval)
+	}
+
+	invalid := []PublisherConfig{
+		{Options: valid},
+		{Client: client, Options: ComputeAndPublishOptions{AccountPublicKey: "pubkey", Topics: []string{"0.0.1"}, PublishTopicID: "0.0.100"}},
+		{Client: client, Options: ComputeAndPublishOptions{AccountID: "0.0.2", AccountPublicKey: "pubkey", PublishTopicID: "0.0.100"}},
+		{Client: client, Options: ComputeAndPublishOptions{AccountID: "0.0.2", Topics: []string{"0.0.1"}, PublishTopicID: "0.0.100"}},
+		{Client: client, Options: valid, PollInterval: -time.Second},
+		{Client: client, Options: ComputeAndPublishOptions{AccountID: "0.0.2", AccountPublicKey: "pubkey", Topics: []string{"0.0.1", "0.0.100"}, PublishTopicID: "0.0.100"}},
+	}
+	for index, config := range invalid {
+		if _, err := NewPublisher(config); err == nil {
+			t.Fatalf("expected error for config %d", index)
+		}
+	}
+}
+
+func TestPublisherPublishIfChanged(t *testing.T) {
+	fake, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+	client.HederaClient().Close()
+
+	current := expectedStateHash(t, client, fake.publicKey, "a2", "b1")
+	fake.publish(t, client, "2000.500000000", time.Unix(2000, 0), current)
+
+	publisher, err := NewPublisher(PublisherConfig{
+		Client: client,
+		Options: ComputeAndPublishOptions{
+			AccountID:        "0.0.2",
+			AccountPublicKey: fake.publicKey,
+			Topics:           []string{"0.0.1", "0.0.3"},
+			PublishTopicID:   "0.0.100",
+		},
+		Interval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("NewPublisher failed: %v", err)
+	}
+
+	publisher.lastPublished = time.Now()
+	publisher.seeded = true
+	publisher.lastHash = current
+	result, published, err := publisher.PublishIfChanged(context.Background())
+	if err != nil || published || result.StateHash != current {
+		t.Fatalf("expected unchanged state to be skipped, got %+v, %t, %v", result, published, err)
+	}
+
+	publisher.seeded = false
+	if _, published, err := publisher.PublishIfChanged(context.Background()); err == nil || published {
+		t.Fatalf("expected the seeded interval to have elapsed and submission to fail, got %t, %v", published, err)
+	}
+	if publisher.lastHash != current || !publisher.lastPublished.Equal(time.Unix(2000, 500000000).UTC()) {
+		t.Fatalf("unexpected seeded state %s at %s", publisher.lastHash, publisher.lastPublished)
+	}
+
+	fake.topics["0.0.3"] = append(fake.topics["0.0.3"], fake.topics["0.0.1"][1])
+	publisher.lastPublished = time.Now()
+	if _, published, err := publisher.PublishIfChanged(context.Background()); err == nil || published {
+		t.Fatalf("expected a changed topic to trigger submission, got %t, %v", published, err)
+	}
+	if publisher.lastHash != current {
+		t.Fatal("expected a failed publication to keep the last published hash")
+	}
+}
+
+func TestPublisherSeedPagesBack(t *testing.T) {
+	fake, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	fake.publish(t, client, "1000.000000000", time.Unix(1000, 0), "seeded")
+	for index := range seedPageSize + 50 {
+		payload, _ := json.Marshal(client.CreateStateHashMessage("other", "0.0.7", []string{"0.0.1"}, "", nil))
+		fake.topics["0.0.100"] = append(fake.topics["0.0.100"], mirror.TopicMessage{
+			ConsensusTimestamp: fmt.Sprintf("%d.000000000", 2000+index),
+			SequenceNumber:     int64(index + 2),
+			Message:            base64.StdEncoding.EncodeToString(payload),
+		})
+	}
+	forged, _ := json.Marshal(client.CreateStateHashMessage("forged", "0.0.2", []string{"0.0.1"}, "", nil))
+	fake.topics["0.0.100"] = append(fake.topics["0.0.100"], mirror.TopicMessage{
+		ConsensusTimestamp: "9000.000000000",
+		SequenceNumber:     int64(len(fake.topics["0.0.100"]) + 1),
+		PayerAccountID:     "0.0.7",
+		Message:            base64.StdEncoding.EncodeToString(forged),
+	})
+
+	publisher, err := NewPublisher(PublisherConfig{
+		Client: client,
+		Options: ComputeAndPublishOptions{
+			AccountID:        "0.0.2",
+			AccountPublicKey: fake.publicKey,
+			Topics:           []string{"0.0.1"},
+			PublishTopicID:   "0.0.100",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewPublisher failed: %v", err)
+	}
+	if err := publisher.seed(context.Background()); err != nil {
+		t.Fatalf("seed failed: %v", err)
+	}
+	if publisher.lastHash != "seeded" || !publisher.lastPublished.Equal(time.Unix(1000, 0).UTC()) {
+		t.Fatalf("expected the older page to be read, got %s at %s", publisher.lastHash, publisher.lastPublished)
+	}
+	if len(fake.queries) != 2 || !strings.Contains(fake.queries[1], "timestamp=lt") {
+		t.Fatalf("expected two pages, got %v", fake.queries)
+	}
+}
+
+func TestPublisherRunReportsErrors(t *testing.T) {
+	_, client, closeServer := newFakeStateMirror(t)
+	defer closeServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var reported []error
+	publisher, err := NewPublisher(PublisherConfig{
+		Client: client,
+		Options: ComputeAndPublishOptions{
+			AccountID:        "0.0.2",
+			AccountPublicKey: "pubkey",
+			Topics:           []string{"0.0.404"},
+			PublishTopicID:   "0.0.100",
+		},
+		PollInterval: time.Millisecond,
+		OnError: func(err error) {
+			reported = append(reported, err)
+			if len(reported) == 2 {
+				cancel()
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewPublisher failed: %v", err)
+	}
+	if err := publisher.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected Run to stop on cancellation, got %v", err)
+	}
+	if len(reported) != 2 {
+		t.Fatalf("expected errors to be reported on each tick, got %v", reported)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 type TopicState struct {
@@ -61,10 +64,13 @@ type CompositeStateHashResult struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateTopicOptions struct {
@@ -160,3 +166,73 @@ func ValidateStateHashMessage(message StateHashMessage) []string {
 	}
 	return errors
 }
+
+type AuditorConfig struct {
+	Client *Client
+	// PublicKeys overrides the public key hashed for an account, keyed by
+	// account ID. Values are strings or hedera.PublicKey. An override is used
+	// for every message of the account, so leave out accounts that rotated
+	// their key; those use the key the mirror node reports they held at each
+	// message's consensus timestamp.
+	PublicKeys map[string]any
+	// MaxClaimedTimestampAge bounds how long before its consensus timestamp
+	// a message's own timestamp may be and still be audited against.
+	// Defaults to two minutes.
+	MaxClaimedTimestampAge time.Duration
+}
+
+// AuditTimestamp names the timestamp a state hash was recomputed at.
+type AuditTimestamp string
+
+const (
+	// AuditTimestampConsensus is the message's consensus timestamp.
+	AuditTimestampConsensus AuditTimestamp = "consensus"
+	// AuditTimestampClaimed is the timestamp the publisher stamped on the
+	// message, used when the hash does not match at consensus.
+	AuditTimestampClaimed AuditTimestamp = "claimed"
+)
+
+// StateHashAudit is the outcome of recomputing one published state hash.
+// AsOf is the mirror timestamp the running hashes were read at and
+// AsOfSource says which of the message's timestamps it is. ComputedHash is
+// empty when the hash could not be recomputed.
+type StateHashAudit struct {
+	Record       MessageRecord
+	ComputedHash string
+	AsOf         string
+	AsOfSource   AuditTimestamp
+	Valid        bool
+	Reason       string
+}
+
+// AuditReport lists every state hash message replayed from a state topic.
+type AuditReport struct {
+	StateTopicID string
+	Entries      []StateHashAudit
+}
+
+// Divergences returns the entries whose published hash did not match.
+func (report AuditReport) Divergences() []StateHashAudit {
+	divergences := make([]StateHashAudit, 0)
+	for _, entry := range report.Entries {
+		if !entry.Valid {
+			divergences = append(divergences, entry)
+		}
+	}
+	return divergences
+}
+
+type PublisherConfig struct {
+	Client  *Client
+	Options ComputeAndPublishOptions
+	// Interval republishes the state hash at least this often even when the
+	// watched topics are unchanged. Zero publishes only on change.
+	Interval time.Duration
+	// PollInterval is how often the watched topics are checked for new
+	// messages. Defaults to 30 seconds.
+	PollInterval time.Duration
+	// OnPublish and OnError are called from Run after each publication and
+	// each failed check.
+	OnPublish func(ComputeAndPublishResult)
+	OnError   func(error)
+}
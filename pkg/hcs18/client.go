@@ -15,6 +15,7 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
@@ -27,11 +28,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -48,6 +50,7 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
@@ -65,22 +68,17 @@ func (c *Client) CreateDiscoveryTopic(
 	ctx context.Context,
 	options CreateDiscoveryTopicOptions,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction := BuildCreateDiscoveryTopicTx(CreateDiscoveryTopicTxParams{
 		TTLSeconds:   options.TTLSeconds,
 		AdminKey:     c.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin),
 		SubmitKey:    c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 		MemoOverride: options.MemoOverride,
 	})
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute discovery topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get discovery topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to create discovery topic")
 	}
@@ -94,21 +92,15 @@ func (c *Client) SubmitMessage(
 	message DiscoveryMessage,
 	transactionMemo string,
 ) (hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction, err := BuildSubmitDiscoveryMessageTx(topicID, message, transactionMemo)
 	if err != nil {
 		return hedera.TransactionReceipt{}, err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, fmt.Errorf("failed to execute discovery message transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, fmt.Errorf("failed to get discovery message receipt: %w", err)
-	}
-	return receipt, nil
+	return executed.Receipt, nil
 }
 
 // Announce performs the requested operation.
@@ -155,28 +147,38 @@ func (c *Client) GetDiscoveryMessages(
 
 	records := make([]MessageRecord, 0, len(items))
 	for _, item := range items {
-		message, err := decodeDiscoveryMessage(item.Message)
-		if err != nil {
-			continue
+		if record, ok := decodeMessageRecord(item); ok {
+			records = append(records, record)
 		}
-		if err := ValidateMessage(message); err != nil {
-			continue
-		}
-		records = append(records, MessageRecord{
-			Message:            message,
-			ConsensusTimestamp: item.ConsensusTimestamp,
-			SequenceNumber:     item.SequenceNumber,
-			Payer:              item.PayerAccountID,
-		})
 	}
 	return records, nil
 }
 
-// IsProposalReady performs the requested operation.
+// decodeMessageRecord decodes a mirror message, reporting false for
+// payloads that are not valid HCS-18 messages.
+func decodeMessageRecord(item mirror.TopicMessage) (MessageRecord, bool) {
+	message, err := decodeDiscoveryMessage(item.Message)
+	if err != nil {
+		return MessageRecord{}, false
+	}
+	if err := ValidateMessage(message); err != nil {
+		return MessageRecord{}, false
+	}
+	return MessageRecord{
+		Message:            message,
+		ConsensusTimestamp: item.ConsensusTimestamp,
+		SequenceNumber:     item.SequenceNumber,
+		Payer:              item.PayerAccountID,
+	}, true
+}
+
+// IsProposalReady reports whether every member other than the proposer has
+// accepted. Responses from accounts outside the proposal are not counted.
 func (c *Client) IsProposalReady(proposal TrackedProposal) bool {
 	acceptances := 0
-	for _, response := range proposal.Responses {
-		if response.Decision == "accept" {
+	for accountID, response := range proposal.Responses {
+		if response.Decision == "accept" && accountID != proposal.Data.Proposer &&
+			proposalHasMember(proposal.Data, accountID) {
 			acceptances++
 		}
 	}
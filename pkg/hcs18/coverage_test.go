@@ -133,6 +133,21 @@ func TestIsProposalReady(t *testing.T) {
 	if client.IsProposalReady(proposal2) {
 		t.Fatal("expected not ready")
 	}
+
+	proposal3 := TrackedProposal{
+		Data: ProposeData{
+			Proposer: "a",
+			Members:  []ProposeMember{{Account: "a"}, {Account: "b"}, {Account: "c"}},
+		},
+		Responses: map[string]TrackedResponse{
+			"a": {Decision: "accept"},
+			"b": {Decision: "accept"},
+			"d": {Decision: "accept"},
+		},
+	}
+	if client.IsProposalReady(proposal3) {
+		t.Fatal("expected proposer and non-member acceptances not to count")
+	}
 }
 
 func TestDecodeDiscoveryMessage(t *testing.T) {
@@ -2,6 +2,24 @@
 // It supports discovery topic creation, announce/propose/respond/complete/
 // withdraw operations, transaction builders, and mirror-node reads.
 //
+// # Discovery State
+//
+// DiscoveryState replays a discovery topic into the announcements still in
+// force, open proposals with their member responses, and completed floras.
+// Sync applies only the messages published since the previous call.
+// Messages not paid for by the account they act for are ignored.
+//
+// # Participants
+//
+// Participant automates discovery for one petal. It keeps its announcement
+// in force, accepts proposals whose other members satisfy its MatchRules and
+// rejects the rest, and, with FloraSize set, proposes a flora with the
+// highest-priority matching petals. When its proposal is ready it creates
+// the flora through an hcs16.Client and publishes complete. When proposals
+// compete, the earliest by sequence number wins: a participant answers
+// later proposals only once the one it proposed or accepted is rejected.
+// Run returns the completed flora that includes the petal.
+//
 // # Specification
 //
 // Full specification: https://hol.org/docs/standards/hcs-18
@@ -0,0 +1,494 @@
+package hcs18
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs16"
+)
+
+const defaultParticipantPollInterval = 10 * time.Second
+
+// FloraCreator creates a flora account and its topics. *hcs16.Client
+// implements it.
+type FloraCreator interface {
+	CreateFloraAccountWithTopics(
+		ctx context.Context,
+		options hcs16.CreateFloraAccountWithTopicsOptions,
+	) (hcs16.CreateFloraAccountWithTopicsResult, error)
+}
+
+// Participant runs HCS-18 discovery for one petal. It keeps an announcement
+// in force, accepts proposals whose other members match its rules and
+// rejects the rest, and, when FloraSize is set, proposes a flora with the
+// best matching petals. Once its own proposal is ready it creates the flora
+// and publishes complete.
+//
+// A participant is bound to at most one live proposal, one nobody has
+// rejected, at a time: the earliest by sequence number among its own and
+// those it accepted. It holds off responding to later proposals, does not
+// propose while bound to another petal's proposal and only completes its
+// own proposal when that is the one it is bound to, so competing proposals
+// cannot form two floras with the same petal.
+type Participant struct {
+	client       *Client
+	floraClient  FloraCreator
+	state        *DiscoveryState
+	accountID    string
+	announcement AnnounceData
+	match        MatchRules
+	floraSize    int
+	threshold    int64
+	floraName    string
+	purpose      string
+	balance      float64
+	pollInterval time.Duration
+	onError      func(error)
+
+	// submit publishes a message and returns its topic sequence number.
+	submit func(ctx context.Context, message DiscoveryMessage) (int64, error)
+
+	announceSeq int64
+	proposalSeq int64
+	completeSeq int64
+	responded   map[int64]bool
+	accepted    map[int64]bool
+	created     map[int64]hcs16.CreateFloraAccountWithTopicsResult
+	excluded    map[string]bool
+}
+
+// NewParticipant creates a participant on config.TopicID.
+func NewParticipant(config ParticipantConfig) (*Participant, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	state, err := NewDiscoveryState(config.Client, config.TopicID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement := config.Announcement
+	announcement.Account = strings.TrimSpace(announcement.Account)
+	if announcement.Account == "" {
+		announcement.Account = config.Client.operatorID.String()
+	}
+	if announcement.Account != config.Client.operatorID.String() {
+		return nil, fmt.Errorf("announcement account must be the client operator, which pays for its messages")
+	}
+	if err := ValidateMessage(BuildAnnounceMessage(announcement)); err != nil {
+		return nil, err
+	}
+	if config.FloraSize < 0 || config.FloraSize == 1 {
+		return nil, fmt.Errorf("flora size must be zero or at least 2")
+	}
+	threshold := config.Threshold
+	if config.FloraSize > 0 {
+		if config.FloraClient == nil {
+			return nil, fmt.Errorf("flora client is required to propose floras")
+		}
+		if threshold == 0 {
+			threshold = int64(config.FloraSize/2 + 1)
+		}
+		if threshold < 1 || threshold > int64(config.FloraSize) {
+			return nil, fmt.Errorf("threshold must be between 1 and the flora size")
+		}
+	}
+	if config.PollInterval < 0 {
+		return nil, fmt.Errorf("poll interval must not be negative")
+	}
+	pollInterval := config.PollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultParticipantPollInterval
+	}
+	floraName := strings.TrimSpace(config.FloraName)
+	if floraName == "" {
+		floraName = announcement.Petal.Name + " flora"
+	}
+
+	participant := &Participant{
+		client:       config.Client,
+		floraClient:  config.FloraClient,
+		state:        state,
+		accountID:    announcement.Account,
+		announcement: announcement,
+		match:        config.Match,
+		floraSize:    config.FloraSize,
+		threshold:    threshold,
+		floraName:    floraName,
+		purpose:      config.Purpose,
+		balance:      config.InitialBalanceHbar,
+		pollInterval: pollInterval,
+		onError:      config.OnError,
+		responded:    map[int64]bool{},
+		accepted:     map[int64]bool{},
+		created:      map[int64]hcs16.CreateFloraAccountWithTopicsResult{},
+		excluded:     map[string]bool{},
+	}
+	participant.submit = participant.submitMessage
+	return participant, nil
+}
+
+// State returns the participant's view of the discovery topic.
+func (participant *Participant) State() *DiscoveryState {
+	return participant.state
+}
+
+// Run steps the participant every poll interval until a flora that includes
+// this petal is completed, and returns it. Failed steps are reported to
+// OnError and retried.
+func (participant *Participant) Run(ctx context.Context) (CompletedFlora, error) {
+	ticker := time.NewTicker(participant.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		flora, done, err := participant.Step(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return CompletedFlora{}, ctxErr
+			}
+			if participant.onError != nil {
+				participant.onError(err)
+			}
+		}
+		if done {
+			return flora, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return CompletedFlora{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step syncs the topic and takes the next actions: completing a ready
+// proposal of its own, responding to proposals, announcing and proposing.
+// It reports true with the flora once a flora including this petal has
+// been completed. Step is not safe for concurrent use.
+func (participant *Participant) Step(ctx context.Context) (CompletedFlora, bool, error) {
+	if _, err := participant.state.Sync(ctx); err != nil {
+		return CompletedFlora{}, false, err
+	}
+	for _, flora := range participant.state.CompletedFloras() {
+		if proposalHasMember(flora.Proposal.Data, participant.accountID) {
+			return flora, true, nil
+		}
+	}
+
+	if participant.proposalSeq == 0 {
+		for _, proposal := range participant.state.OpenProposals() {
+			if proposal.Data.Proposer == participant.accountID && len(rejectedBy(proposal)) == 0 {
+				participant.proposalSeq = proposal.SequenceNumber
+				break
+			}
+		}
+	}
+	if err := participant.completeOwnProposal(ctx); err != nil {
+		return CompletedFlora{}, false, err
+	}
+	if err := participant.respond(ctx); err != nil {
+		return CompletedFlora{}, false, err
+	}
+	if err := participant.announce(ctx); err != nil {
+		return CompletedFlora{}, false, err
+	}
+	if err := participant.propose(ctx); err != nil {
+		return CompletedFlora{}, false, err
+	}
+	return CompletedFlora{}, false, nil
+}
+
+// Matches reports whether candidate is compatible with own under rules.
+func (rules MatchRules) Matches(own AnnounceData, candidate AnnounceData) bool {
+	minOverlap := rules.MinProtocolOverlap
+	if minOverlap <= 0 {
+		minOverlap = 1
+	}
+	overlap := 0
+	for _, protocol := range candidate.Capabilities.Protocols {
+		if slices.Contains(own.Capabilities.Protocols, protocol) {
+			overlap++
+		}
+	}
+	if overlap < minOverlap {
+		return false
+	}
+	for _, protocol := range rules.RequiredProtocols {
+		if !slices.Contains(candidate.Capabilities.Protocols, protocol) {
+			return false
+		}
+	}
+	for name, value := range rules.RequiredResources {
+		offered, ok := candidate.Capabilities.Resources[name]
+		if !ok || (value != "" && offered != value) {
+			return false
+		}
+	}
+	if candidate.Petal.Priority < rules.MinPriority {
+		return false
+	}
+	return rules.Accept == nil || rules.Accept(candidate)
+}
+
+// pending reports whether a message published at sequence has not been
+// read back from the mirror node yet.
+func (participant *Participant) pending(sequence int64) bool {
+	return sequence > participant.state.LastSequence()
+}
+
+func (participant *Participant) announce(ctx context.Context) error {
+	for _, announcement := range participant.state.ActiveAnnouncements() {
+		if announcement.Data.Account == participant.accountID {
+			participant.announceSeq = announcement.SequenceNumber
+			return nil
+		}
+	}
+	if participant.pending(participant.announceSeq) {
+		return nil
+	}
+	sequence, err := participant.submit(ctx, BuildAnnounceMessage(participant.announcement))
+	if err != nil {
+		return fmt.Errorf("failed to announce: %w", err)
+	}
+	participant.announceSeq = sequence
+	return nil
+}
+
+// commitment returns the sequence number of the live proposal the
+// participant is bound to, or zero when it is free to accept or propose.
+func (participant *Participant) commitment() int64 {
+	for _, proposal := range participant.state.OpenProposals() {
+		if len(rejectedBy(proposal)) > 0 {
+			continue
+		}
+		if proposal.SequenceNumber == participant.proposalSeq ||
+			participant.accepted[proposal.SequenceNumber] ||
+			proposal.Responses[participant.accountID].Decision == "accept" {
+			return proposal.SequenceNumber
+		}
+	}
+	if participant.proposalSeq != 0 && participant.pending(participant.proposalSeq) {
+		return participant.proposalSeq
+	}
+	return 0
+}
+
+// respond answers proposals that include the participant in sequence
+// order. Proposals later than the one it is bound to wait until that one is
+// rejected.
+func (participant *Participant) respond(ctx context.Context) error {
+	committed := participant.commitment()
+	for _, proposal := range participant.state.OpenProposals() {
+		if proposal.Data.Proposer == participant.accountID ||
+			!proposalHasMember(proposal.Data, participant.accountID) ||
+			participant.responded[proposal.SequenceNumber] {
+			continue
+		}
+		if _, ok := proposal.Responses[participant.accountID]; ok {
+			participant.responded[proposal.SequenceNumber] = true
+			continue
+		}
+		if committed != 0 && committed < proposal.SequenceNumber {
+			continue
+		}
+
+		response := RespondData{
+			Responder:   participant.accountID,
+			ProposalSeq: proposal.SequenceNumber,
+			Decision:    "accept",
+		}
+		if reason := participant.evaluate(proposal.Data); reason != "" {
+			response.Decision = "reject"
+			response.Reason = reason
+		}
+		if _, err := participant.submit(ctx, BuildRespondMessage(response)); err != nil {
+			return fmt.Errorf("failed to respond to proposal %d: %w", proposal.SequenceNumber, err)
+		}
+		participant.responded[proposal.SequenceNumber] = true
+		if response.Decision == "accept" {
+			participant.accepted[proposal.SequenceNumber] = true
+			committed = proposal.SequenceNumber
+		}
+	}
+	return nil
+}
+
+// evaluate returns why the participant rejects a proposal, or "" to accept.
+func (participant *Participant) evaluate(data ProposeData) string {
+	if data.Config.Threshold > int64(len(data.Members)) {
+		return "threshold exceeds member count"
+	}
+	announcements := participant.state.ActiveAnnouncements()
+	for _, member := range data.Members {
+		if member.Account == participant.accountID {
+			continue
+		}
+		candidate, ok := findAnnouncement(announcements, member)
+		if !ok {
+			return fmt.Sprintf("member %s has no active announcement", member.Account)
+		}
+		if !participant.match.Matches(participant.announcement, candidate.Data) {
+			return fmt.Sprintf("member %s does not match", member.Account)
+		}
+	}
+	return ""
+}
+
+func (participant *Participant) propose(ctx context.Context) error {
+	if participant.floraSize == 0 || participant.announceSeq == 0 || participant.pending(participant.announceSeq) {
+		return nil
+	}
+	if participant.proposalSeq != 0 {
+		if participant.pending(participant.proposalSeq) {
+			return nil
+		}
+		if proposal, ok := participant.state.Proposal(participant.proposalSeq); ok {
+			rejecters := rejectedBy(proposal)
+			if len(rejecters) == 0 {
+				return nil
+			}
+			for _, accountID := range rejecters {
+				participant.excluded[accountID] = true
+			}
+		}
+		participant.proposalSeq = 0
+	}
+	if participant.commitment() != 0 {
+		return nil
+	}
+
+	candidates := make([]TrackedAnnouncement, 0)
+	seen := map[string]bool{participant.accountID: true}
+	for _, announcement := range participant.state.ActiveAnnouncements() {
+		account := announcement.Data.Account
+		if seen[account] || participant.excluded[account] ||
+			!participant.match.Matches(participant.announcement, announcement.Data) {
+			continue
+		}
+		seen[account] = true
+		candidates = append(candidates, announcement)
+	}
+	if len(candidates) < participant.floraSize-1 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(left, right int) bool {
+		return candidates[left].Data.Petal.Priority > candidates[right].Data.Petal.Priority
+	})
+
+	members := []ProposeMember{{
+		Account:     participant.accountID,
+		AnnounceSeq: participant.announceSeq,
+		Priority:    participant.announcement.Petal.Priority,
+	}}
+	for _, candidate := range candidates[:participant.floraSize-1] {
+		members = append(members, ProposeMember{
+			Account:     candidate.Data.Account,
+			AnnounceSeq: candidate.SequenceNumber,
+			Priority:    candidate.Data.Petal.Priority,
+		})
+	}
+	sequence, err := participant.submit(ctx, BuildProposeMessage(ProposeData{
+		Proposer: participant.accountID,
+		Members:  members,
+		Config: ProposeConfig{
+			Name:      participant.floraName,
+			Threshold: participant.threshold,
+			Purpose:   participant.purpose,
+		},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to propose flora: %w", err)
+	}
+	participant.proposalSeq = sequence
+	return nil
+}
+
+// completeOwnProposal creates the flora for a ready proposal and publishes
+// complete, unless the participant accepted an earlier proposal that is
+// still live. A flora created before a failed complete is reused on retry.
+func (participant *Participant) completeOwnProposal(ctx context.Context) error {
+	if participant.proposalSeq == 0 || participant.pending(participant.proposalSeq) ||
+		participant.pending(participant.completeSeq) || participant.commitment() != participant.proposalSeq {
+		return nil
+	}
+	proposal, ok := participant.state.Proposal(participant.proposalSeq)
+	if !ok || !participant.client.IsProposalReady(proposal) {
+		return nil
+	}
+
+	created, ok := participant.created[proposal.SequenceNumber]
+	if !ok {
+		accountIDs := make([]string, 0, len(proposal.Data.Members))
+		for _, member := range proposal.Data.Members {
+			accountIDs = append(accountIDs, member.Account)
+		}
+		result, err := participant.floraClient.CreateFloraAccountWithTopics(ctx, hcs16.CreateFloraAccountWithTopicsOptions{
+			Members:            accountIDs,
+			Threshold:          int(proposal.Data.Config.Threshold),
+			InitialBalanceHbar: participant.balance,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create flora for proposal %d: %w", proposal.SequenceNumber, err)
+		}
+		participant.created[proposal.SequenceNumber] = result
+		created = result
+	}
+
+	sequence, err := participant.submit(ctx, BuildCompleteMessage(CompleteData{
+		ProposalSeq:  proposal.SequenceNumber,
+		FloraAccount: created.FloraAccountID,
+		Topics: CompleteTopic{
+			Communication: created.Topics.Communication,
+			Transaction:   created.Topics.Transaction,
+			State:         created.Topics.State,
+		},
+		Proposer: participant.accountID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to complete proposal %d: %w", proposal.SequenceNumber, err)
+	}
+	participant.completeSeq = sequence
+	return nil
+}
+
+func (participant *Participant) submitMessage(ctx context.Context, message DiscoveryMessage) (int64, error) {
+	receipt, err := participant.client.SubmitMessage(ctx, participant.state.topicID, message, "")
+	if err != nil {
+		return 0, err
+	}
+	return int64(receipt.TopicSequenceNumber), nil
+}
+
+// findAnnouncement returns the announcement a proposal member refers to,
+// falling back to the member's latest active announcement.
+func findAnnouncement(announcements []TrackedAnnouncement, member ProposeMember) (TrackedAnnouncement, bool) {
+	var latest TrackedAnnouncement
+	found := false
+	for _, announcement := range announcements {
+		if announcement.Data.Account != member.Account {
+			continue
+		}
+		if announcement.SequenceNumber == member.AnnounceSeq {
+			return announcement, true
+		}
+		latest = announcement
+		found = true
+	}
+	return latest, found
+}
+
+func rejectedBy(proposal TrackedProposal) []string {
+	rejecters := make([]string, 0)
+	for accountID, response := range proposal.Responses {
+		if response.Decision == "reject" {
+			rejecters = append(rejecters, accountID)
+		}
+	}
+	sort.Strings(rejecters)
+	return rejecters
+}
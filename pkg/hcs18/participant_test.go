@@ -0,0 +1,253 @@
+package hcs18
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs16"
+)
+
+type fakeFloraCreator struct {
+	calls []hcs16.CreateFloraAccountWithTopicsOptions
+}
+
+func (f *fakeFloraCreator) CreateFloraAccountWithTopics(
+	_ context.Context,
+	options hcs16.CreateFloraAccountWithTopicsOptions,
+) (hcs16.CreateFloraAccountWithTopicsResult, error) {
+	f.calls = append(f.calls, options)
+	return hcs16.CreateFloraAccountWithTopicsResult{
+		FloraAccountID: "0.0.900",
+		Topics:         hcs16.FloraTopics{Communication: "0.0.901", Transaction: "0.0.902", State: "0.0.903"},
+	}, nil
+}
+
+func newTestParticipant(t *testing.T, mirror *discoveryMirror, serverURL string, config ParticipantConfig) *Participant {
+	t.Helper()
+	pk, _ := hedera.PrivateKeyGenerateEcdsa()
+	client, err := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  config.Announcement.Account,
+		OperatorPrivateKey: pk.String(),
+		MirrorBaseURL:      serverURL,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	config.Client = client
+	config.TopicID = "0.0.700"
+	participant, err := NewParticipant(config)
+	if err != nil {
+		t.Fatalf("NewParticipant failed: %v", err)
+	}
+	participant.submit = func(_ context.Context, message DiscoveryMessage) (int64, error) {
+		mirror.publish(t, message.Op, message.Data)
+		return int64(len(mirror.messages)), nil
+	}
+	return participant
+}
+
+func step(t *testing.T, participant *Participant) bool {
+	t.Helper()
+	_, done, err := participant.Step(context.Background())
+	if err != nil {
+		t.Fatalf("Step failed: %v", err)
+	}
+	return done
+}
+
+func TestParticipantFormsFlora(t *testing.T) {
+	mirror := &discoveryMirror{}
+	server := httptest.NewServer(mirror)
+	defer server.Close()
+
+	incompatible := announce("0.0.13", 0)
+	incompatible.Capabilities.Protocols = []string{"other"}
+	mirror.publish(t, OperationAnnounce, incompatible) // 1
+
+	creator := &fakeFloraCreator{}
+	proposer := newTestParticipant(t, mirror, server.URL, ParticipantConfig{
+		Announcement: announce("0.0.11", 0),
+		FloraClient:  creator,
+		FloraSize:    2,
+	})
+	responder := newTestParticipant(t, mirror, server.URL, ParticipantConfig{
+		Announcement: announce("0.0.12", 0),
+		Match:        MatchRules{RequiredProtocols: []string{"hcs-16"}},
+	})
+
+	step(t, proposer)                                // announces at 2
+	step(t, responder)                               // announces at 3
+	mirror.publish(t, OperationPropose, ProposeData{ // 4, from the incompatible petal
+		Proposer: "0.0.13",
+		Members:  []ProposeMember{{Account: "0.0.13", AnnounceSeq: 1}, {Account: "0.0.12", AnnounceSeq: 3}},
+		Config:   ProposeConfig{Name: "mismatch", Threshold: 2},
+	})
+	step(t, proposer)  // proposes at 5 with the responder only
+	step(t, responder) // rejects 4 at 6 and accepts 5 at 7
+	if _, err := responder.State().Sync(context.Background()); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+
+	rejected, _ := responder.State().Proposal(4)
+	if rejected.Responses["0.0.12"].Decision != "reject" || rejected.Responses["0.0.12"].Reason == "" {
+		t.Fatalf("expected the mismatched proposal to be rejected, got %+v", rejected.Responses)
+	}
+	accepted, ok := responder.State().Proposal(5)
+	if !ok || accepted.Data.Proposer != "0.0.11" || len(accepted.Data.Members) != 2 ||
+		accepted.Data.Members[1].Account != "0.0.12" || accepted.Data.Config.Threshold != 2 {
+		t.Fatalf("unexpected proposal %+v", accepted.Data)
+	}
+	if accepted.Responses["0.0.12"].Decision != "accept" {
+		t.Fatalf("expected the responder to accept, got %+v", accepted.Responses)
+	}
+
+	if step(t, proposer) { // creates the flora and completes at 8
+		t.Fatal("expected completion to be pending until it is read back")
+	}
+	if len(creator.calls) != 1 || creator.calls[0].Threshold != 2 || len(creator.calls[0].Members) != 2 {
+		t.Fatalf("unexpected flora creation %+v", creator.calls)
+	}
+	if step(t, proposer); len(mirror.messages) != 8 {
+		t.Fatalf("expected no further messages, got %d", len(mirror.messages))
+	}
+
+	flora, err := responder.Run(context.Background())
+	if err != nil || flora.Data.FloraAccount != "0.0.900" || flora.Proposal.SequenceNumber != 5 {
+		t.Fatalf("unexpected completed flora %+v, %v", flora, err)
+	}
+}
+
+func TestParticipantSettlesCompetingProposals(t *testing.T) {
+	mirror := &discoveryMirror{}
+	server := httptest.NewServer(mirror)
+	defer server.Close()
+
+	firstCreator := &fakeFloraCreator{}
+	first := newTestParticipant(t, mirror, server.URL, ParticipantConfig{
+		Announcement: announce("0.0.11", 0),
+		FloraClient:  firstCreator,
+		FloraSize:    2,
+	})
+	secondCreator := &fakeFloraCreator{}
+	second := newTestParticipant(t, mirror, server.URL, ParticipantConfig{
+		Announcement: announce("0.0.12", 0),
+		FloraClient:  secondCreator,
+		FloraSize:    2,
+	})
+
+	step(t, first)  // announces at 1
+	step(t, second) // announces at 2
+	step(t, second) // proposes at 3
+	// 4, proposed by the first petal before it read proposal 3.
+	mirror.publish(t, OperationPropose, ProposeData{
+		Proposer: "0.0.11",
+		Members:  []ProposeMember{{Account: "0.0.11", AnnounceSeq: 1}, {Account: "0.0.12", AnnounceSeq: 2}},
+		Config:   ProposeConfig{Name: "late", Threshold: 2},
+	})
+	step(t, first)  // accepts the earlier proposal 3 at 5
+	step(t, second) // completes 3 at 6 and holds off on 4
+	if len(mirror.messages) != 6 {
+		t.Fatalf("expected 6 messages, got %d", len(mirror.messages))
+	}
+	if _, err := second.State().Sync(context.Background()); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	late, ok := second.State().Proposal(4)
+	if !ok || len(late.Responses) != 0 {
+		t.Fatalf("expected the later proposal to stay unanswered, got %+v", late)
+	}
+	if !step(t, first) {
+		t.Fatal("expected the first petal to join the flora from proposal 3")
+	}
+	if len(firstCreator.calls) != 0 || len(secondCreator.calls) != 1 {
+		t.Fatalf("expected one flora, got %d and %d creations", len(firstCreator.calls), len(secondCreator.calls))
+	}
+}
+
+func TestParticipantIgnoresForgedMessages(t *testing.T) {
+	mirror := &discoveryMirror{}
+	server := httptest.NewServer(mirror)
+	defer server.Close()
+
+	mirror.publish(t, OperationAnnounce, announce("0.0.12", 0)) // 1
+	creator := &fakeFloraCreator{}
+	proposer := newTestParticipant(t, mirror, server.URL, ParticipantConfig{
+		Announcement: announce("0.0.11", 0),
+		FloraClient:  creator,
+		FloraSize:    2,
+	})
+	step(t, proposer) // announces at 2
+	step(t, proposer) // proposes at 3
+
+	mirror.publishAs(t, "0.0.99", OperationRespond, RespondData{Responder: "0.0.12", ProposalSeq: 3, Decision: "accept"})
+	mirror.publishAs(t, "0.0.99", OperationComplete, CompleteData{
+		ProposalSeq:  3,
+		FloraAccount: "0.0.666",
+		Topics:       CompleteTopic{Communication: "0.0.667", Transaction: "0.0.668", State: "0.0.669"},
+		Proposer:     "0.0.11",
+	})
+	if step(t, proposer) || len(creator.calls) != 0 {
+		t.Fatalf("expected forged messages to be ignored, got %+v", creator.calls)
+	}
+
+	mirror.publish(t, OperationRespond, RespondData{Responder: "0.0.12", ProposalSeq: 3, Decision: "accept"}) // 6
+	step(t, proposer)                                                                                         // creates the flora and completes at 7
+	flora, done, err := proposer.Step(context.Background())
+	if err != nil || !done || flora.Data.FloraAccount != "0.0.900" || len(creator.calls) != 1 {
+		t.Fatalf("unexpected completed flora %+v, %v, %v", flora, done, err)
+	}
+}
+
+func TestNewParticipantValidation(t *testing.T) {
+	mirror := &discoveryMirror{}
+	server := httptest.NewServer(mirror)
+	defer server.Close()
+	participant := newTestParticipant(t, mirror, server.URL, ParticipantConfig{Announcement: announce("0.0.11", 0)})
+
+	invalid := []ParticipantConfig{
+		{TopicID: "0.0.700", Announcement: announce("0.0.11", 0)},
+		{Client: participant.client, TopicID: "0.0.700"},
+		{Client: participant.client, TopicID: "0.0.700", Announcement: announce("0.0.12", 0)},
+		{Client: participant.client, TopicID: "0.0.700", Announcement: announce("0.0.11", 0), FloraSize: 1},
+		{Client: participant.client, TopicID: "0.0.700", Announcement: announce("0.0.11", 0), FloraSize: 2},
+		{Client: participant.client, TopicID: "0.0.700", Announcement: announce("0.0.11", 0), FloraSize: 2, Threshold: 3, FloraClient: &fakeFloraCreator{}},
+	}
+	for index, config := range invalid {
+		if _, err := NewParticipant(config); err == nil {
+			t.Fatalf("expected error for config %d", index)
+		}
+	}
+	if participant.accountID != "0.0.11" || participant.floraName != "0.0.11 flora" {
+		t.Fatalf("unexpected defaults %s, %s", participant.accountID, participant.floraName)
+	}
+}
+
+func TestMatchRules(t *testing.T) {
+	own := announce("0.0.11", 0)
+	own.Capabilities.Protocols = []string{"hcs-16", "hcs-17"}
+	candidate := announce("0.0.12", 0)
+	candidate.Petal.Priority = 5
+	candidate.Capabilities.Resources = map[string]string{"region": "eu", "gpu": "a100"}
+
+	if !(MatchRules{}).Matches(own, candidate) {
+		t.Fatal("expected a shared protocol to match")
+	}
+	for name, rules := range map[string]MatchRules{
+		"overlap":  {MinProtocolOverlap: 2},
+		"protocol": {RequiredProtocols: []string{"hcs-17"}},
+		"resource": {RequiredResources: map[string]string{"region": "us"}},
+		"priority": {MinPriority: 6},
+		"accept":   {Accept: func(AnnounceData) bool { return false }},
+	} {
+		if rules.Matches(own, candidate) {
+			t.Fatalf("expected %s rule to reject the candidate", name)
+		}
+	}
+	if !(MatchRules{RequiredResources: map[string]string{"gpu": "", "region": "eu"}, MinPriority: 5}).Matches(own, candidate) {
+		t.Fatal("expected resources and priority to match")
+	}
+}
@@ -0,0 +1,245 @@
+package hcs18
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+// DiscoveryState is a local view of a discovery topic: the announcements
+// still in force, the proposals that have not been completed and their
+// responses, and the floras formed so far. It is safe for concurrent use.
+//
+// An announcement with ValidFor expires once the topic sequence number moves
+// more than ValidFor messages past it, and is removed early by a withdraw
+// from the same account. Responses count only when they come from a member
+// listed in the proposal; a later response replaces an earlier one.
+//
+// Every message must be paid for by the account it acts for: the announcing
+// or withdrawing account, the proposer or the responder. A complete must be
+// paid for by the proposal's proposer. Other messages are ignored.
+type DiscoveryState struct {
+	client  *Client
+	topicID string
+
+	mu            sync.RWMutex
+	lastSequence  int64
+	announcements map[int64]TrackedAnnouncement
+	proposals     map[int64]TrackedProposal
+	completed     []CompletedFlora
+}
+
+// NewDiscoveryState creates an empty view of topicID. Call Sync to load it.
+func NewDiscoveryState(client *Client, topicID string) (*DiscoveryState, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	topicID = strings.TrimSpace(topicID)
+	if topicID == "" {
+		return nil, fmt.Errorf("topic ID is required")
+	}
+	return &DiscoveryState{
+		client:        client,
+		topicID:       topicID,
+		announcements: map[int64]TrackedAnnouncement{},
+		proposals:     map[int64]TrackedProposal{},
+	}, nil
+}
+
+// Sync reads the messages published after the last one applied and returns
+// how many valid discovery messages it applied.
+func (state *DiscoveryState) Sync(ctx context.Context) (int, error) {
+	options := mirror.MessageQueryOptions{Order: "asc"}
+	if last := state.LastSequence(); last > 0 {
+		options.SequenceNumber = fmt.Sprintf("gt:%d", last)
+	}
+	items, err := state.client.mirrorClient.GetTopicMessages(ctx, state.topicID, options)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read discovery topic %s: %w", state.topicID, err)
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	applied := 0
+	for _, item := range items {
+		if item.SequenceNumber <= state.lastSequence {
+			continue
+		}
+		if record, ok := decodeMessageRecord(item); ok {
+			state.apply(record)
+			applied++
+		}
+		state.advance(item.SequenceNumber)
+	}
+	return applied, nil
+}
+
+// Apply applies a single message, for callers that receive messages from
+// another source. Messages at or before the last applied sequence number
+// are ignored and Apply reports false.
+func (state *DiscoveryState) Apply(record MessageRecord) bool {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if record.SequenceNumber <= state.lastSequence {
+		return false
+	}
+	if err := ValidateMessage(record.Message); err == nil {
+		state.apply(record)
+	}
+	state.advance(record.SequenceNumber)
+	return true
+}
+
+// LastSequence returns the sequence number of the last message applied.
+func (state *DiscoveryState) LastSequence() int64 {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+	return state.lastSequence
+}
+
+// ActiveAnnouncements returns the announcements in force, in topic order.
+func (state *DiscoveryState) ActiveAnnouncements() []TrackedAnnouncement {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	announcements := make([]TrackedAnnouncement, 0, len(state.announcements))
+	for _, announcement := range state.announcements {
+		announcements = append(announcements, announcement)
+	}
+	sort.Slice(announcements, func(left, right int) bool {
+		return announcements[left].SequenceNumber < announcements[right].SequenceNumber
+	})
+	return announcements
+}
+
+// OpenProposals returns the proposals that have not been completed, in
+// topic order.
+func (state *DiscoveryState) OpenProposals() []TrackedProposal {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	proposals := make([]TrackedProposal, 0, len(state.proposals))
+	for _, proposal := range state.proposals {
+		proposals = append(proposals, copyProposal(proposal))
+	}
+	sort.Slice(proposals, func(left, right int) bool {
+		return proposals[left].SequenceNumber < proposals[right].SequenceNumber
+	})
+	return proposals
+}
+
+// Proposal returns the open proposal published at sequence.
+func (state *DiscoveryState) Proposal(sequence int64) (TrackedProposal, bool) {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	proposal, ok := state.proposals[sequence]
+	if !ok {
+		return TrackedProposal{}, false
+	}
+	return copyProposal(proposal), true
+}
+
+// CompletedFloras returns the completed proposals in topic order.
+func (state *DiscoveryState) CompletedFloras() []CompletedFlora {
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	completed := make([]CompletedFlora, 0, len(state.completed))
+	for _, flora := range state.completed {
+		flora.Proposal = copyProposal(flora.Proposal)
+		completed = append(completed, flora)
+	}
+	return completed
+}
+
+func (state *DiscoveryState) apply(record MessageRecord) {
+	payer := strings.TrimSpace(record.Payer)
+	switch data := record.Message.Data.(type) {
+	case AnnounceData:
+		if data.Account != payer {
+			return
+		}
+		announcement := TrackedAnnouncement{
+			Data:               data,
+			SequenceNumber:     record.SequenceNumber,
+			ConsensusTimestamp: record.ConsensusTimestamp,
+			Payer:              record.Payer,
+		}
+		if data.ValidFor > 0 {
+			announcement.ExpiresAfterSeq = record.SequenceNumber + data.ValidFor
+		}
+		state.announcements[record.SequenceNumber] = announcement
+	case WithdrawData:
+		announcement, ok := state.announcements[data.AnnounceSeq]
+		if ok && data.Account == payer && announcement.Data.Account == data.Account {
+			delete(state.announcements, data.AnnounceSeq)
+		}
+	case ProposeData:
+		if data.Proposer != payer {
+			return
+		}
+		state.proposals[record.SequenceNumber] = TrackedProposal{
+			Data:               data,
+			Responses:          map[string]TrackedResponse{},
+			SequenceNumber:     record.SequenceNumber,
+			ConsensusTimestamp: record.ConsensusTimestamp,
+			Payer:              record.Payer,
+		}
+	case RespondData:
+		proposal, ok := state.proposals[data.ProposalSeq]
+		if !ok || data.Responder != payer || !proposalHasMember(proposal.Data, data.Responder) {
+			return
+		}
+		proposal.Responses[data.Responder] = TrackedResponse{
+			Decision:       data.Decision,
+			Reason:         data.Reason,
+			AcceptedSeq:    data.AcceptedSeq,
+			SequenceNumber: record.SequenceNumber,
+		}
+	case CompleteData:
+		proposal, ok := state.proposals[data.ProposalSeq]
+		if !ok || data.Proposer != payer || data.Proposer != proposal.Data.Proposer {
+			return
+		}
+		delete(state.proposals, data.ProposalSeq)
+		state.completed = append(state.completed, CompletedFlora{
+			Proposal:           proposal,
+			Data:               data,
+			SequenceNumber:     record.SequenceNumber,
+			ConsensusTimestamp: record.ConsensusTimestamp,
+		})
+	}
+}
+
+// advance moves the topic position to sequence and drops announcements
+// that expired before it.
+func (state *DiscoveryState) advance(sequence int64) {
+	state.lastSequence = sequence
+	for announceSeq, announcement := range state.announcements {
+		if announcement.ExpiresAfterSeq > 0 && sequence > announcement.ExpiresAfterSeq {
+			delete(state.announcements, announceSeq)
+		}
+	}
+}
+
+func proposalHasMember(data ProposeData, accountID string) bool {
+	for _, member := range data.Members {
+		if member.Account == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+func copyProposal(proposal TrackedProposal) TrackedProposal {
+	proposal.Responses = maps.Clone(proposal.Responses)
+	return proposal
+}
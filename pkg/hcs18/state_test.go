@@ -0,0 +1,214 @@
+package hcs18
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// discoveryMirror serves a discovery topic and honours sequencenumber=gt:.
+type discoveryMirror struct {
+	messages []map[string]any
+	queries  []string
+}
+
+// publish publishes data paid for by the account it acts for.
+func (m *discoveryMirror) publish(t *testing.T, op DiscoveryOperation, data any) {
+	t.Helper()
+	var payer string
+	switch typed := data.(type) {
+	case AnnounceData:
+		payer = typed.Account
+	case WithdrawData:
+		payer = typed.Account
+	case ProposeData:
+		payer = typed.Proposer
+	case RespondData:
+		payer = typed.Responder
+	case CompleteData:
+		payer = typed.Proposer
+	}
+	m.publishAs(t, payer, op, data)
+}
+
+func (m *discoveryMirror) publishAs(t *testing.T, payer string, op DiscoveryOperation, data any) {
+	t.Helper()
+	raw, err := json.Marshal(map[string]any{"p": "hcs-18", "op": op, "data": data})
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+	sequence := len(m.messages) + 1
+	m.messages = append(m.messages, map[string]any{
+		"consensus_timestamp": fmt.Sprintf("%d.000000000", 1700000000+sequence),
+		"message":             base64.StdEncoding.EncodeToString(raw),
+		"payer_account_id":    payer,
+		"sequence_number":     sequence,
+	})
+}
+
+func (m *discoveryMirror) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.queries = append(m.queries, r.URL.RawQuery)
+	after := int64(0)
+	if bound := r.URL.Query().Get("sequencenumber"); bound != "" {
+		after, _ = strconv.ParseInt(strings.TrimPrefix(bound, "gt:"), 10, 64)
+	}
+	messages := make([]map[string]any, 0, len(m.messages))
+	for _, message := range m.messages {
+		if int64(message["sequence_number"].(int)) > after {
+			messages = append(messages, message)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"messages": messages})
+}
+
+func newDiscoveryState(t *testing.T, mirror *discoveryMirror) (*DiscoveryState, func()) {
+	t.Helper()
+	server := httptest.NewServer(mirror)
+	pk, _ := hedera.PrivateKeyGenerateEcdsa()
+	client, err := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  "0.0.1",
+		OperatorPrivateKey: pk.String(),
+		MirrorBaseURL:      server.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	state, err := NewDiscoveryState(client, "0.0.700")
+	if err != nil {
+		t.Fatalf("NewDiscoveryState failed: %v", err)
+	}
+	return state, server.Close
+}
+
+func announce(account string, validFor int64) AnnounceData {
+	return AnnounceData{
+		Account:      account,
+		Petal:        PetalDescriptor{Name: account, Priority: 1},
+		Capabilities: CapabilityDetails{Protocols: []string{"hcs-16"}},
+		ValidFor:     validFor,
+	}
+}
+
+func TestDiscoveryStateReplay(t *testing.T) {
+	mirror := &discoveryMirror{}
+	mirror.publish(t, OperationAnnounce, announce("0.0.11", 0)) // 1
+	mirror.publish(t, OperationAnnounce, announce("0.0.12", 2)) // 2, valid through 4
+	mirror.publish(t, OperationAnnounce, announce("0.0.13", 0)) // 3
+	mirror.publish(t, OperationWithdraw, WithdrawData{Account: "0.0.99", AnnounceSeq: 3})
+	mirror.publish(t, OperationPropose, ProposeData{ // 5
+		Proposer: "0.0.11",
+		Members:  []ProposeMember{{Account: "0.0.11", AnnounceSeq: 1}, {Account: "0.0.13", AnnounceSeq: 3}},
+		Config:   ProposeConfig{Name: "pair", Threshold: 2},
+	})
+	mirror.publish(t, OperationRespond, RespondData{Responder: "0.0.13", ProposalSeq: 5, Decision: "reject"})
+	mirror.publish(t, OperationRespond, RespondData{Responder: "0.0.42", ProposalSeq: 5, Decision: "accept"})
+	// Paid for by accounts other than the ones they act for.
+	mirror.publishAs(t, "0.0.99", OperationRespond, RespondData{Responder: "0.0.13", ProposalSeq: 5, Decision: "accept"}) // 8
+	mirror.publishAs(t, "0.0.99", OperationWithdraw, WithdrawData{Account: "0.0.11", AnnounceSeq: 1})
+	mirror.publishAs(t, "0.0.99", OperationPropose, ProposeData{ // 10
+		Proposer: "0.0.11",
+		Members:  []ProposeMember{{Account: "0.0.11", AnnounceSeq: 1}, {Account: "0.0.99"}},
+		Config:   ProposeConfig{Name: "forged", Threshold: 1},
+	})
+
+	state, closeServer := newDiscoveryState(t, mirror)
+	defer closeServer()
+
+	applied, err := state.Sync(context.Background())
+	if err != nil || applied != 10 || state.LastSequence() != 10 {
+		t.Fatalf("unexpected sync %d, %v at %d", applied, err, state.LastSequence())
+	}
+	announcements := state.ActiveAnnouncements()
+	if len(announcements) != 2 || announcements[0].Data.Account != "0.0.11" || announcements[1].Data.Account != "0.0.13" {
+		t.Fatalf("expected the expired announcement to be dropped and the foreign withdraws ignored, got %+v", announcements)
+	}
+	proposal, ok := state.Proposal(5)
+	if !ok || len(proposal.Responses) != 1 || proposal.Responses["0.0.13"].Decision != "reject" {
+		t.Fatalf("expected only the member response, got %+v", proposal)
+	}
+	if _, ok := state.Proposal(10); ok {
+		t.Fatal("expected the forged proposal to be ignored")
+	}
+	if state.client.IsProposalReady(proposal) {
+		t.Fatal("expected the rejected proposal not to be ready")
+	}
+
+	mirror.publish(t, OperationRespond, RespondData{Responder: "0.0.13", ProposalSeq: 5, Decision: "accept", AcceptedSeq: 5})
+	mirror.publish(t, OperationWithdraw, WithdrawData{Account: "0.0.13", AnnounceSeq: 3})
+	applied, err = state.Sync(context.Background())
+	if err != nil || applied != 2 {
+		t.Fatalf("unexpected incremental sync %d, %v", applied, err)
+	}
+	if last := mirror.queries[len(mirror.queries)-1]; !strings.Contains(last, "sequencenumber=gt%3A10") {
+		t.Fatalf("expected an incremental query, got %s", last)
+	}
+	open := state.OpenProposals()
+	if len(open) != 1 || !state.client.IsProposalReady(open[0]) || open[0].Responses["0.0.13"].SequenceNumber != 11 {
+		t.Fatalf("expected the later response to replace the rejection, got %+v", open)
+	}
+	if len(state.ActiveAnnouncements()) != 1 {
+		t.Fatalf("expected the withdrawn announcement to be removed")
+	}
+
+	open[0].Responses["0.0.13"] = TrackedResponse{Decision: "reject"}
+	if proposal, _ := state.Proposal(5); proposal.Responses["0.0.13"].Decision != "accept" {
+		t.Fatal("expected returned proposals to be copies")
+	}
+
+	forged := CompleteData{
+		ProposalSeq:  5,
+		FloraAccount: "0.0.666",
+		Topics:       CompleteTopic{Communication: "0.0.901", Transaction: "0.0.902", State: "0.0.903"},
+		Proposer:     "0.0.13",
+	}
+	mirror.publish(t, OperationComplete, forged)
+	forged.Proposer = "0.0.11"
+	mirror.publishAs(t, "0.0.13", OperationComplete, forged)
+	mirror.publish(t, OperationComplete, CompleteData{
+		ProposalSeq:  5,
+		FloraAccount: "0.0.900",
+		Topics:       CompleteTopic{Communication: "0.0.901", Transaction: "0.0.902", State: "0.0.903"},
+		Proposer:     "0.0.11",
+	})
+	if _, err := state.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	completed := state.CompletedFloras()
+	if len(state.OpenProposals()) != 0 || len(completed) != 1 || completed[0].Data.FloraAccount != "0.0.900" ||
+		completed[0].Proposal.Data.Config.Name != "pair" {
+		t.Fatalf("unexpected completion %+v", completed)
+	}
+
+	if state.Apply(MessageRecord{SequenceNumber: 3}) {
+		t.Fatal("expected an already applied sequence to be ignored")
+	}
+	if !state.Apply(MessageRecord{SequenceNumber: 17, Message: BuildAnnounceMessage(announce("0.0.14", 0)), Payer: "0.0.14"}) ||
+		len(state.ActiveAnnouncements()) != 2 {
+		t.Fatal("expected Apply to add the announcement")
+	}
+	if !state.Apply(MessageRecord{SequenceNumber: 18, Message: BuildAnnounceMessage(announce("0.0.15", 0)), Payer: "0.0.14"}) ||
+		len(state.ActiveAnnouncements()) != 2 {
+		t.Fatal("expected Apply to ignore an announcement paid for by another account")
+	}
+}
+
+func TestNewDiscoveryStateValidation(t *testing.T) {
+	if _, err := NewDiscoveryState(nil, "0.0.700"); err == nil {
+		t.Fatal("expected error for missing client")
+	}
+	state, closeServer := newDiscoveryState(t, &discoveryMirror{})
+	defer closeServer()
+	if _, err := NewDiscoveryState(state.client, " "); err == nil {
+		t.Fatal("expected error for missing topic ID")
+	}
+}
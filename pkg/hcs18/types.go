@@ -1,6 +1,12 @@
 package hcs18
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type DiscoveryOperation string
 
@@ -92,12 +98,39 @@ type MessageRecord struct {
 }
 
 type TrackedResponse struct {
-	Decision string
+	Decision       string
+	Reason         string
+	AcceptedSeq    int64
+	SequenceNumber int64
 }
 
+// TrackedProposal is a proposal replayed from a discovery topic. Responses
+// holds each member's latest response, keyed by account ID.
 type TrackedProposal struct {
-	Data      ProposeData
-	Responses map[string]TrackedResponse
+	Data               ProposeData
+	Responses          map[string]TrackedResponse
+	SequenceNumber     int64
+	ConsensusTimestamp string
+	Payer              string
+}
+
+// TrackedAnnouncement is an announcement that has not been withdrawn.
+// ExpiresAfterSeq is the last topic sequence number it is valid for, or zero
+// when it does not expire.
+type TrackedAnnouncement struct {
+	Data               AnnounceData
+	SequenceNumber     int64
+	ConsensusTimestamp string
+	Payer              string
+	ExpiresAfterSeq    int64
+}
+
+// CompletedFlora is a proposal that was completed into a flora.
+type CompletedFlora struct {
+	Proposal           TrackedProposal
+	Data               CompleteData
+	SequenceNumber     int64
+	ConsensusTimestamp string
 }
 
 type TopicMemo struct {
@@ -109,10 +142,13 @@ type TopicMemo struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateDiscoveryTopicOptions struct {
@@ -123,3 +159,44 @@ type CreateDiscoveryTopicOptions struct {
 	SubmitKey           string
 	MemoOverride        string
 }
+
+// MatchRules decides whether another petal's announcement is compatible.
+// Zero values impose no constraint beyond sharing one protocol.
+type MatchRules struct {
+	// MinProtocolOverlap is the number of protocols a candidate must share
+	// with this petal's announcement. Defaults to 1.
+	MinProtocolOverlap int
+	// RequiredProtocols must all be offered by the candidate.
+	RequiredProtocols []string
+	// RequiredResources must all be present in the candidate's resources.
+	// An empty value matches any value.
+	RequiredResources map[string]string
+	MinPriority       int64
+	// Accept is an optional final check.
+	Accept func(AnnounceData) bool
+}
+
+type ParticipantConfig struct {
+	Client *Client
+	// FloraClient creates the flora when this petal's proposal is ready.
+	// A *hcs16.Client can be used directly. It is only required when
+	// FloraSize is set.
+	FloraClient FloraCreator
+	TopicID     string
+	// Announcement is published for this petal. Account defaults to the
+	// client operator.
+	Announcement AnnounceData
+	Match        MatchRules
+	// FloraSize is the number of petals, including this one, to propose a
+	// flora with. Zero only responds to other petals' proposals.
+	FloraSize int
+	// Threshold defaults to a majority of FloraSize.
+	Threshold          int64
+	FloraName          string
+	Purpose            string
+	InitialBalanceHbar float64
+	// PollInterval is how often Run syncs the topic. Defaults to 10 seconds.
+	PollInterval time.Duration
+	// OnError is called from Run for each failed step.
+	OnError func(error)
+}
@@ -37,11 +37,12 @@ var errNoPublicKey = errors.New("no public key provided")
 // Client is the HCS-2 SDK client.
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
 	operatorKey       hedera.PrivateKey
-	operatorKeyRaw    string
+	operatorSigner    shared.Signer
 	network           string
 	inscriberAuthURL  string
 	inscriberAPIURL   string
@@ -56,11 +57,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 		return nil, err
 	}
 
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -77,11 +79,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
-		operatorKeyRaw:    operator.PrivateKeyRaw,
+		operatorSigner:    operator.Signer,
 		network:           network,
 		inscriberAuthURL:  strings.TrimSpace(config.InscriberAuthURL),
 		inscriberAPIURL:   strings.TrimSpace(config.InscriberAPIURL),
@@ -126,15 +129,11 @@ func (c *Client) CreateRegistry(
 		transaction.SetSubmitKey(*submitKey)
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return CreateRegistryResult{}, fmt.Errorf("failed to execute create topic transaction: %w", err)
 	}
-
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return CreateRegistryResult{}, fmt.Errorf("failed to get create topic receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return CreateRegistryResult{}, fmt.Errorf("topic ID missing in create topic receipt")
 	}
@@ -147,7 +146,7 @@ func (c *Client) CreateRegistry(
 	return CreateRegistryResult{
 		Success:       true,
 		TopicID:       topicID,
-		TransactionID: response.TransactionID.String(),
+		TransactionID: executed.TransactionID,
 	}, nil
 }
 
@@ -452,19 +451,15 @@ func (c *Client) submitMessage(
 		transaction.SetTransactionMemo(transactionMemo)
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return OperationResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
-
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return OperationResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
-	}
+	receipt := executed.Receipt
 
 	return OperationResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
+		TransactionID:  executed.TransactionID,
 		SequenceNumber: int64(receipt.TopicSequenceNumber), //nolint:gosec // overflow won't occur in practice
 	}, nil
 }
@@ -478,13 +473,13 @@ func (c *Client) inscribeOverflow(ctx context.Context, payload []byte) (string,
 	}
 
 	authClient := inscriber.NewAuthClient(c.inscriberAuthURL)
-	if strings.TrimSpace(c.operatorKeyRaw) == "" {
-		return "", fmt.Errorf("operator private key is required for inscriber-backed overflow handling")
+	if c.operatorSigner == nil {
+		return "", fmt.Errorf("operator private key or signer is required for inscriber-backed overflow handling")
 	}
-	authResult, authErr := authClient.Authenticate(
+	authResult, authErr := authClient.AuthenticateWithSigner(
 		ctx,
 		c.operatorID.String(),
-		c.operatorKeyRaw,
+		c.operatorSigner,
 		network,
 	)
 	if authErr != nil {
@@ -523,9 +518,9 @@ func (c *Client) inscribeOverflow(ctx context.Context, payload []byte) (string,
 		ctx,
 		job.TransactionBytes,
 		inscriber.HederaClientConfig{
-			AccountID:  c.operatorID.String(),
-			PrivateKey: c.operatorKeyRaw,
-			Network:    network,
+			AccountID: c.operatorID.String(),
+			Signer:    c.operatorSigner,
+			Network:   network,
 		},
 	)
 	if execErr != nil {
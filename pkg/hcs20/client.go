@@ -15,12 +15,14 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
 	operatorKey       hedera.PrivateKey
-	operatorKeyRaw    string
+	operatorSigner    shared.Signer
 	network           string
+	executionMode     shared.ExecutionMode
 
 	publicTopicID   string
 	registryTopicID string
@@ -32,12 +34,17 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	executionMode, err := shared.NormalizeExecutionMode(config.ExecutionMode)
+	if err != nil {
+		return nil, err
+	}
 
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -63,12 +70,14 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
-		operatorKeyRaw:    operator.PrivateKeyRaw,
+		operatorSigner:    operator.Signer,
 		network:           network,
+		executionMode:     executionMode,
 		publicTopicID:     publicTopicID,
 		registryTopicID:   registryTopicID,
 	}, nil
@@ -109,7 +118,9 @@ func (client *Client) SetRegistryTopicID(topicID string) error {
 	return nil
 }
 
-// CreatePublicTopic creates a new topic and assigns it as the client's public topic ID.
+// CreatePublicTopic creates a new topic and assigns it as the client's public
+// topic ID. It cannot return an envelope, so in envelope mode use
+// BuildPublicTopicEnvelope instead.
 func (client *Client) CreatePublicTopic(
 	ctx context.Context,
 	options CreateTopicOptions,
@@ -122,17 +133,32 @@ func (client *Client) CreatePublicTopic(
 	return topicID, transactionID, nil
 }
 
+// BuildPublicTopicEnvelope freezes the topic create transaction of
+// CreatePublicTopic into an envelope instead of submitting it. Once the
+// envelope is submitted, assign the new topic with SetPublicTopicID.
+func (client *Client) BuildPublicTopicEnvelope(options CreateTopicOptions) (*shared.TxEnvelope, error) {
+	transaction, err := client.buildTopicTx(options)
+	if err != nil {
+		return nil, err
+	}
+	return shared.FreezeTxEnvelope(transaction, shared.FreezeOptions{Client: client.hederaClient})
+}
+
 // CreateRegistryTopic creates a new indexed HCS-2 registry and assigns it as the client's registry topic ID.
 func (client *Client) CreateRegistryTopic(
 	ctx context.Context,
 	options hcs2.CreateRegistryOptions,
 ) (string, string, error) {
+	if client.executionMode == shared.ExecutionModeEnvelope {
+		return "", "", fmt.Errorf("registry topic creation is not supported in envelope mode")
+	}
 	hcs2Client, err := hcs2.NewClient(hcs2.ClientConfig{
-		OperatorAccountID:  client.operatorID.String(),
-		OperatorPrivateKey: client.operatorKeyRaw,
-		Network:            client.network,
-		MirrorBaseURL:      client.mirrorClient.BaseURL(),
-		HederaClient:       client.hederaClient,
+		OperatorAccountID: client.operatorID.String(),
+		OperatorSigner:    client.operatorSigner,
+		Network:           client.network,
+		MirrorBaseURL:     client.mirrorClient.BaseURL(),
+		HederaClient:      client.hederaClient,
+		Executor:          client.executor,
 	})
 	if err != nil {
 		return "", "", err
@@ -169,6 +195,12 @@ func (client *Client) DeployPoints(
 
 	targetTopicID := client.publicTopicID
 	if options.UsePrivateTopic {
+		if client.executionMode == shared.ExecutionModeEnvelope {
+			return PointsInfo{}, PointsDeploymentError{
+				HCS20Error: HCS20Error{Message: "private topic deployment is not supported in envelope mode"},
+				Tick:       options.Tick,
+			}
+		}
 		topicMemo := strings.TrimSpace(options.TopicMemo)
 		if topicMemo == "" {
 			topicMemo = fmt.Sprintf("hcs-20:%s", NormalizeTick(options.Tick))
@@ -222,7 +254,7 @@ func (client *Client) DeployPoints(
 		DeployTxID: result.TransactionID,
 	})
 
-	if !options.DisableMirrorCheck {
+	if !options.DisableMirrorCheck && result.Envelope == nil {
 		if err := client.waitForMirrorSequence(ctx, targetTopicID, result.SequenceNumber, 15); err != nil {
 			return PointsInfo{}, err
 		}
@@ -249,8 +281,9 @@ func (client *Client) DeployPoints(
 		TopicID:             targetTopicID,
 		DeployerAccountID:   client.operatorID.String(),
 		CurrentSupply:       "0",
-		DeploymentTimestamp: result.ConsensusAt.UTC().Format(time.RFC3339Nano),
+		DeploymentTimestamp: formatConsensusAt(result.ConsensusAt),
 		IsPrivate:           options.UsePrivateTopic,
+		Envelope:            result.Envelope,
 	}, nil
 }
 
@@ -298,7 +331,7 @@ func (client *Client) MintPoints(
 		MintTxID:   result.TransactionID,
 	})
 
-	if !options.DisableMirrorCheck {
+	if !options.DisableMirrorCheck && result.Envelope == nil {
 		if err := client.waitForMirrorSequence(ctx, targetTopicID, result.SequenceNumber, 15); err != nil {
 			return PointsTransaction{}, err
 		}
@@ -316,11 +349,12 @@ func (client *Client) MintPoints(
 		Tick:           NormalizeTick(options.Tick),
 		Amount:         strings.TrimSpace(options.Amount),
 		To:             strings.TrimSpace(options.To),
-		Timestamp:      result.ConsensusAt.UTC().Format(time.RFC3339Nano),
+		Timestamp:      formatConsensusAt(result.ConsensusAt),
 		SequenceNumber: result.SequenceNumber,
 		TopicID:        targetTopicID,
 		TransactionID:  result.TransactionID,
 		Memo:           strings.TrimSpace(options.Memo),
+		Envelope:       result.Envelope,
 	}, nil
 }
 
@@ -388,7 +422,7 @@ func (client *Client) TransferPoints(
 		TransferTxID: result.TransactionID,
 	})
 
-	if !options.DisableMirrorCheck {
+	if !options.DisableMirrorCheck && result.Envelope == nil {
 		if err := client.waitForMirrorSequence(ctx, targetTopicID, result.SequenceNumber, 15); err != nil {
 			return PointsTransaction{}, err
 		}
@@ -407,11 +441,12 @@ func (client *Client) TransferPoints(
 		Amount:         strings.TrimSpace(options.Amount),
 		From:           fromAccountID,
 		To:             toAccountID,
-		Timestamp:      result.ConsensusAt.UTC().Format(time.RFC3339Nano),
+		Timestamp:      formatConsensusAt(result.ConsensusAt),
 		SequenceNumber: result.SequenceNumber,
 		TopicID:        targetTopicID,
 		TransactionID:  result.TransactionID,
 		Memo:           strings.TrimSpace(options.Memo),
+		Envelope:       result.Envelope,
 	}, nil
 }
 
@@ -472,7 +507,7 @@ func (client *Client) BurnPoints(
 		BurnTxID:   result.TransactionID,
 	})
 
-	if !options.DisableMirrorCheck {
+	if !options.DisableMirrorCheck && result.Envelope == nil {
 		if err := client.waitForMirrorSequence(ctx, targetTopicID, result.SequenceNumber, 15); err != nil {
 			return PointsTransaction{}, err
 		}
@@ -490,11 +525,12 @@ func (client *Client) BurnPoints(
 		Tick:           NormalizeTick(options.Tick),
 		Amount:         strings.TrimSpace(options.Amount),
 		From:           fromAccountID,
-		Timestamp:      result.ConsensusAt.UTC().Format(time.RFC3339Nano),
+		Timestamp:      formatConsensusAt(result.ConsensusAt),
 		SequenceNumber: result.SequenceNumber,
 		TopicID:        targetTopicID,
 		TransactionID:  result.TransactionID,
 		Memo:           strings.TrimSpace(options.Memo),
+		Envelope:       result.Envelope,
 	}, nil
 }
 
@@ -547,7 +583,7 @@ func (client *Client) RegisterTopic(
 		RegisterTxID: result.TransactionID,
 	})
 
-	if !options.DisableMirrorCheck {
+	if !options.DisableMirrorCheck && result.Envelope == nil {
 		if err := client.waitForMirrorSequence(ctx, registryTopicID, result.SequenceNumber, 15); err != nil {
 			return OperationResult{}, err
 		}
@@ -566,6 +602,27 @@ func (client *Client) createTopic(
 	ctx context.Context,
 	options CreateTopicOptions,
 ) (string, string, error) {
+	if client.executionMode == shared.ExecutionModeEnvelope {
+		return "", "", fmt.Errorf("topic creation cannot return an envelope; use BuildPublicTopicEnvelope in envelope mode")
+	}
+	transaction, err := client.buildTopicTx(options)
+	if err != nil {
+		return "", "", err
+	}
+
+	executed, err := client.executor.Execute(ctx, client.hederaClient, transaction)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create topic: %w", err)
+	}
+	receipt := executed.Receipt
+	if receipt.TopicID == nil {
+		return "", "", fmt.Errorf("topic receipt did not include topic ID")
+	}
+
+	return receipt.TopicID.String(), executed.TransactionID, nil
+}
+
+func (client *Client) buildTopicTx(options CreateTopicOptions) (*hedera.TopicCreateTransaction, error) {
 	memo := strings.TrimSpace(options.Memo)
 	if memo == "" {
 		memo = "hcs-20"
@@ -575,7 +632,7 @@ func (client *Client) createTopic(
 
 	adminKey, err := client.resolvePublicKey(options.AdminKey, options.UseOperatorAsAdmin)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 	if adminKey != nil {
 		transaction.SetAdminKey(*adminKey)
@@ -583,26 +640,12 @@ func (client *Client) createTopic(
 
 	submitKey, err := client.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 	if submitKey != nil {
 		transaction.SetSubmitKey(*submitKey)
 	}
-
-	response, err := transaction.Execute(client.hederaClient)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to create topic: %w", err)
-	}
-
-	receipt, err := response.GetReceipt(client.hederaClient)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to get topic receipt: %w", err)
-	}
-	if receipt.TopicID == nil {
-		return "", "", fmt.Errorf("topic receipt did not include topic ID")
-	}
-
-	return receipt.TopicID.String(), response.TransactionID.String(), nil
+	return transaction, nil
 }
 
 func (client *Client) resolvePublicKey(
@@ -649,24 +692,32 @@ func (client *Client) submitTransaction(
 	transaction *hedera.TopicMessageSubmitTransaction,
 	topicID string,
 ) (OperationResult, error) {
-	response, err := transaction.Execute(client.hederaClient)
-	if err != nil {
-		return OperationResult{}, fmt.Errorf("failed to execute topic message transaction: %w", err)
+	if client.executionMode == shared.ExecutionModeEnvelope {
+		envelope, err := shared.FreezeTxEnvelope(transaction, shared.FreezeOptions{Client: client.hederaClient})
+		if err != nil {
+			return OperationResult{}, err
+		}
+		transactionID, err := envelope.TransactionID()
+		if err != nil {
+			return OperationResult{}, err
+		}
+		return OperationResult{
+			TopicID:       topicID,
+			TransactionID: transactionID.String(),
+			Envelope:      envelope,
+		}, nil
 	}
 
-	receipt, err := response.GetReceipt(client.hederaClient)
+	executed, err := client.executor.ExecuteWithRecord(ctx, client.hederaClient, transaction)
 	if err != nil {
-		return OperationResult{}, fmt.Errorf("failed to get topic message receipt: %w", err)
+		return OperationResult{}, fmt.Errorf("failed to execute topic message transaction: %w", err)
 	}
 
-	record, recordErr := response.GetRecord(client.hederaClient)
 	result := OperationResult{
 		TopicID:        topicID,
-		TransactionID:  response.TransactionID.String(),
-		SequenceNumber: int64(receipt.TopicSequenceNumber),
-	}
-	if recordErr == nil {
-		result.ConsensusAt = record.ConsensusTimestamp
+		TransactionID:  executed.TransactionID,
+		SequenceNumber: executed.SequenceNumber(),
+		ConsensusAt:    executed.ConsensusTimestamp,
 	}
 
 	if result.ConsensusAt.IsZero() {
@@ -676,6 +727,13 @@ func (client *Client) submitTransaction(
 	return result, nil
 }
 
+func formatConsensusAt(consensusAt time.Time) string {
+	if consensusAt.IsZero() {
+		return ""
+	}
+	return consensusAt.UTC().Format(time.RFC3339Nano)
+}
+
 func (client *Client) waitForMirrorSequence(
 	ctx context.Context,
 	topicID string,
@@ -8,6 +8,7 @@ import (
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 
 	"github.com/hashgraph-online/standards-sdk-go/pkg/hcs2"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 func TestNewClientFailures(t *testing.T) {
@@ -149,3 +150,51 @@ func TestIndexerPollingFail(t *testing.T) {
 		t.Fatal("expected empty")
 	}
 }
+
+func TestMintPointsEnvelopeMode(t *testing.T) {
+	key, _ := hedera.PrivateKeyGenerateEd25519()
+	hederaClient := hedera.ClientForNetwork(map[string]hedera.AccountID{"127.0.0.1:50211": {Account: 3}})
+	hederaClient.SetOperator(hedera.AccountID{Account: 1234}, key)
+	client, err := NewClient(ClientConfig{
+		Network:       "testnet",
+		HederaClient:  hederaClient,
+		ExecutionMode: shared.ExecutionModeEnvelope,
+	})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	result, err := client.MintPoints(context.Background(), MintPointsOptions{
+		TopicID: "0.0.5005",
+		Tick:    "pts",
+		Amount:  "10",
+		To:      "0.0.1234",
+	})
+	if err != nil {
+		t.Fatalf("MintPoints failed: %v", err)
+	}
+	if result.Envelope == nil || result.Timestamp != "" || result.SequenceNumber != 0 {
+		t.Fatalf("expected unsubmitted envelope, got %+v", result)
+	}
+	if signed, _ := result.Envelope.SignedBy(key.PublicKey()); signed {
+		t.Fatalf("expected envelope to be left unsigned")
+	}
+
+	if _, err := client.DeployPoints(context.Background(), DeployPointsOptions{UsePrivateTopic: true}); err == nil {
+		t.Fatalf("expected private topic deployment to be rejected in envelope mode")
+	}
+	if _, _, err := client.CreateRegistryTopic(context.Background(), hcs2.CreateRegistryOptions{}); err == nil {
+		t.Fatalf("expected registry creation to be rejected in envelope mode")
+	}
+	if _, _, err := client.CreatePublicTopic(context.Background(), CreateTopicOptions{}); err == nil {
+		t.Fatalf("expected public topic creation to be rejected in envelope mode")
+	}
+	publicTopic, err := client.BuildPublicTopicEnvelope(CreateTopicOptions{})
+	if err != nil || publicTopic == nil || !publicTopic.IsFrozen() {
+		t.Fatalf("expected a frozen public topic envelope, got %v (%v)", publicTopic, err)
+	}
+
+	if _, err := NewClient(ClientConfig{HederaClient: hederaClient, ExecutionMode: "later"}); err == nil {
+		t.Fatalf("expected error for unknown execution mode")
+	}
+}
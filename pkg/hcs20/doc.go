@@ -37,4 +37,20 @@
 //		Tick: "loyal",
 //		Max:  "1000000",
 //	})
+//
+// # Resumable Indexing
+//
+// NewPointsIndexerFromStore restores points state and per-topic cursors from
+// a StateStore and saves a snapshot after every pass that reads new
+// messages, so a restarted indexer only fetches what it has not seen.
+// Snapshots carry supply, balances and cursors; transaction history is only
+// kept up to IndexerConfig.MaxPersistedTransactions. MemoryStateStore,
+// FileStateStore and SQLStateStore (any database/sql driver, one row per
+// cursor and balance, writing only changed rows) are provided:
+//
+//	store, err := hcs20.NewFileStateStore("/var/lib/points/hcs20.json")
+//	indexer, err := hcs20.NewPointsIndexerFromStore(ctx, hcs20.IndexerConfig{
+//		Network: "mainnet",
+//	}, store)
+//	err = indexer.StartPolling(ctx, hcs20.IndexOptions{}, time.Minute)
 package hcs20
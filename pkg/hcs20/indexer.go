@@ -24,6 +24,10 @@ type PointsIndexer struct {
 	state               PointsState
 	lastIndexedSequence map[string]int64
 
+	store                    StateStore
+	persistMutex             sync.Mutex
+	maxPersistedTransactions int
+
 	pollStopChannel chan struct{}
 	pollDoneChannel chan struct{}
 }
@@ -34,6 +38,9 @@ func NewPointsIndexer(config IndexerConfig) (*PointsIndexer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if config.MaxPersistedTransactions < 0 {
+		return nil, fmt.Errorf("max persisted transactions must not be negative")
+	}
 
 	mirrorClient, err := mirror.NewClient(mirror.Config{
 		Network: network,
@@ -45,19 +52,110 @@ func NewPointsIndexer(config IndexerConfig) (*PointsIndexer, error) {
 	}
 
 	return &PointsIndexer{
-		mirrorClient:        mirrorClient,
-		state:               newEmptyState(),
-		lastIndexedSequence: map[string]int64{},
-		pollStopChannel:     nil,
-		pollDoneChannel:     nil,
+		mirrorClient:             mirrorClient,
+		state:                    newEmptyState(),
+		lastIndexedSequence:      map[string]int64{},
+		maxPersistedTransactions: config.MaxPersistedTransactions,
+		pollStopChannel:          nil,
+		pollDoneChannel:          nil,
 	}, nil
 }
 
+// NewPointsIndexerFromStore creates an indexer that restores its points
+// state and per-topic cursors from store, so indexing resumes after the last
+// persisted message. Each indexing pass that reads new messages saves a
+// snapshot back to the store, keeping at most MaxPersistedTransactions of
+// the transaction history.
+func NewPointsIndexerFromStore(
+	ctx context.Context,
+	config IndexerConfig,
+	store StateStore,
+) (*PointsIndexer, error) {
+	if store == nil {
+		return nil, fmt.Errorf("state store is required")
+	}
+	indexer, err := NewPointsIndexer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, found, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore HCS-20 indexer state: %w", err)
+	}
+	if found {
+		indexer.restore(snapshot)
+	}
+	indexer.store = store
+	return indexer, nil
+}
+
 // StateSnapshot returns a deep copy of the current index state.
 func (indexer *PointsIndexer) StateSnapshot() PointsState {
 	indexer.mutex.RLock()
 	defer indexer.mutex.RUnlock()
+	return indexer.copyState(-1)
+}
+
+// Snapshot returns a deep copy of the index state and per-topic cursors.
+func (indexer *PointsIndexer) Snapshot() IndexerSnapshot {
+	return indexer.snapshot(-1)
+}
+
+// snapshot copies the state with at most maxTransactions of the most recent
+// transactions; a negative limit copies them all.
+func (indexer *PointsIndexer) snapshot(maxTransactions int) IndexerSnapshot {
+	indexer.mutex.RLock()
+	defer indexer.mutex.RUnlock()
 
+	cursors := make(map[string]int64, len(indexer.lastIndexedSequence))
+	for topicID, sequence := range indexer.lastIndexedSequence {
+		cursors[topicID] = sequence
+	}
+	return IndexerSnapshot{
+		State:   indexer.copyState(maxTransactions),
+		Cursors: cursors,
+	}
+}
+
+// SaveState writes the current snapshot, trimmed to the configured
+// transaction history, to the indexer's store. It is a no-op for indexers
+// created without one.
+func (indexer *PointsIndexer) SaveState(ctx context.Context) error {
+	if indexer.store == nil {
+		return nil
+	}
+	indexer.persistMutex.Lock()
+	defer indexer.persistMutex.Unlock()
+	if err := indexer.store.Save(ctx, indexer.snapshot(indexer.maxPersistedTransactions)); err != nil {
+		return fmt.Errorf("failed to persist HCS-20 indexer state: %w", err)
+	}
+	return nil
+}
+
+func (indexer *PointsIndexer) restore(snapshot IndexerSnapshot) {
+	state := snapshot.State
+	if state.DeployedPoints == nil {
+		state.DeployedPoints = map[string]PointsInfo{}
+	}
+	if state.Balances == nil {
+		state.Balances = map[string]map[string]PointsBalance{}
+	}
+	if state.Transactions == nil {
+		state.Transactions = []PointsTransaction{}
+	}
+	cursors := snapshot.Cursors
+	if cursors == nil {
+		cursors = map[string]int64{}
+	}
+
+	indexer.mutex.Lock()
+	defer indexer.mutex.Unlock()
+	indexer.state = state
+	indexer.lastIndexedSequence = cursors
+}
+
+func (indexer *PointsIndexer) copyState(maxTransactions int) PointsState {
 	deployedPoints := make(map[string]PointsInfo, len(indexer.state.DeployedPoints))
 	for key, value := range indexer.state.DeployedPoints {
 		deployedPoints[key] = value
@@ -72,8 +170,12 @@ func (indexer *PointsIndexer) StateSnapshot() PointsState {
 		balances[tick] = clone
 	}
 
-	transactions := make([]PointsTransaction, len(indexer.state.Transactions))
-	copy(transactions, indexer.state.Transactions)
+	history := indexer.state.Transactions
+	if maxTransactions >= 0 && len(history) > maxTransactions {
+		history = history[len(history)-maxTransactions:]
+	}
+	transactions := make([]PointsTransaction, len(history))
+	copy(transactions, history)
 
 	return PointsState{
 		DeployedPoints:         deployedPoints,
@@ -336,6 +438,7 @@ func (indexer *PointsIndexer) indexTopic(
 		indexer.mutex.Lock()
 		indexer.lastIndexedSequence[topicID] = maxSequence
 		indexer.mutex.Unlock()
+		return indexer.SaveState(ctx)
 	}
 
 	return nil
@@ -0,0 +1,450 @@
+package hcs20
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultSQLStateTable = "hcs20_indexer_state"
+
+var sqlIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// StateStore persists indexer snapshots so a PointsIndexer can resume after
+// a restart instead of re-indexing every topic from the first message.
+type StateStore interface {
+	// Load returns the last saved snapshot, or false when nothing was saved.
+	Load(ctx context.Context) (IndexerSnapshot, bool, error)
+	// Save replaces the stored snapshot.
+	Save(ctx context.Context, snapshot IndexerSnapshot) error
+}
+
+// MemoryStateStore keeps the snapshot in memory. It is useful in tests and
+// for sharing state between indexers in one process.
+type MemoryStateStore struct {
+	mutex    sync.RWMutex
+	snapshot []byte
+}
+
+// NewMemoryStateStore creates an empty in-memory store.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{}
+}
+
+// Load returns a copy of the saved snapshot.
+func (store *MemoryStateStore) Load(_ context.Context) (IndexerSnapshot, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	if store.snapshot == nil {
+		return IndexerSnapshot{}, false, nil
+	}
+	snapshot, err := decodeSnapshot(store.snapshot)
+	if err != nil {
+		return IndexerSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// Save stores a copy of snapshot.
+func (store *MemoryStateStore) Save(_ context.Context, snapshot IndexerSnapshot) error {
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode indexer snapshot: %w", err)
+	}
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.snapshot = encoded
+	return nil
+}
+
+// FileStateStore keeps the snapshot in a JSON file. Saves write a temporary
+// file next to it and rename it into place, so a crash never leaves a
+// partial snapshot behind.
+type FileStateStore struct {
+	path string
+}
+
+// NewFileStateStore creates a store for the JSON file at path. The file is
+// created on the first Save.
+func NewFileStateStore(path string) (*FileStateStore, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("state file path is required")
+	}
+	return &FileStateStore{path: path}, nil
+}
+
+// Load reads the snapshot file. A missing file reports false.
+func (store *FileStateStore) Load(_ context.Context) (IndexerSnapshot, bool, error) {
+	encoded, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return IndexerSnapshot{}, false, nil
+	}
+	if err != nil {
+		return IndexerSnapshot{}, false, fmt.Errorf("failed to read state file %s: %w", store.path, err)
+	}
+	snapshot, err := decodeSnapshot(encoded)
+	if err != nil {
+		return IndexerSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// Save atomically replaces the snapshot file.
+func (store *FileStateStore) Save(_ context.Context, snapshot IndexerSnapshot) error {
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode indexer snapshot: %w", err)
+	}
+
+	temporary, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary state file: %w", err)
+	}
+	defer os.Remove(temporary.Name())
+
+	if _, err := temporary.Write(encoded); err != nil {
+		_ = temporary.Close()
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	if err := temporary.Sync(); err != nil {
+		_ = temporary.Close()
+		return fmt.Errorf("failed to sync state file: %w", err)
+	}
+	if err := temporary.Close(); err != nil {
+		return fmt.Errorf("failed to close state file: %w", err)
+	}
+	if err := os.Rename(temporary.Name(), store.path); err != nil {
+		return fmt.Errorf("failed to replace state file %s: %w", store.path, err)
+	}
+	return nil
+}
+
+// SQLStateStore keeps the snapshot as rows of a table with the columns
+// store_key, kind, item, data and updated_at: one row per topic cursor,
+// deployed tick and account balance, one per persisted transaction, and
+// two for the processed sequence and timestamp. Save writes only the rows
+// that changed since the last Load or Save, so the cost of a pass follows
+// what it indexed rather than the size of the leaderboard. It uses only
+// portable SQL, so it works with any database/sql driver; EnsureTable
+// creates the table when the caller does not manage the schema itself.
+type SQLStateStore struct {
+	db          *sql.DB
+	table       string
+	key         string
+	placeholder func(index int) string
+
+	mutex sync.Mutex
+	saved map[sqlStateRow]string
+}
+
+// sqlStateRow identifies one row of a store's snapshot.
+type sqlStateRow struct {
+	kind string
+	item string
+}
+
+const (
+	sqlRowCursor      = "cursor"
+	sqlRowPoints      = "points"
+	sqlRowBalance     = "balance"
+	sqlRowTransaction = "transaction"
+	sqlRowMeta        = "meta"
+
+	sqlMetaSequence  = "last_processed_sequence"
+	sqlMetaTimestamp = "last_processed_timestamp"
+)
+
+// NewSQLStateStore creates a store over an open database handle.
+func NewSQLStateStore(config SQLStateStoreConfig) (*SQLStateStore, error) {
+	if config.DB == nil {
+		return nil, fmt.Errorf("database is required")
+	}
+	table := strings.TrimSpace(config.Table)
+	if table == "" {
+		table = defaultSQLStateTable
+	}
+	if !sqlIdentifierPattern.MatchString(table) {
+		return nil, fmt.Errorf("invalid state table name %q", table)
+	}
+	key := strings.TrimSpace(config.Key)
+	if key == "" {
+		key = "default"
+	}
+	placeholder := config.Placeholder
+	if placeholder == nil {
+		placeholder = func(int) string { return "?" }
+	}
+	return &SQLStateStore{
+		db:          config.DB,
+		table:       table,
+		key:         key,
+		placeholder: placeholder,
+	}, nil
+}
+
+// DollarPlaceholder returns PostgreSQL-style bind parameters ($1, $2, ...).
+func DollarPlaceholder(index int) string {
+	return fmt.Sprintf("$%d", index)
+}
+
+// EnsureTable creates the state table if it does not exist.
+func (store *SQLStateStore) EnsureTable(ctx context.Context) error {
+	query := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (store_key VARCHAR(255) NOT NULL, kind VARCHAR(32) NOT NULL, "+
+			"item VARCHAR(255) NOT NULL, data TEXT NOT NULL, updated_at VARCHAR(64) NOT NULL, "+
+			"PRIMARY KEY (store_key, kind, item))",
+		store.table,
+	)
+	if _, err := store.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create state table %s: %w", store.table, err)
+	}
+	return nil
+}
+
+// Load reads the store's rows. A store without rows reports false.
+func (store *SQLStateStore) Load(ctx context.Context) (IndexerSnapshot, bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	rows, err := store.loadRows(ctx)
+	if err != nil {
+		return IndexerSnapshot{}, false, err
+	}
+	store.saved = rows
+	if len(rows) == 0 {
+		return IndexerSnapshot{}, false, nil
+	}
+	snapshot, err := snapshotFromRows(rows)
+	if err != nil {
+		return IndexerSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// Save writes the rows that differ from the last loaded or saved snapshot
+// and deletes the ones it no longer contains, in one transaction.
+func (store *SQLStateStore) Save(ctx context.Context, snapshot IndexerSnapshot) error {
+	rows, err := snapshotRows(snapshot)
+	if err != nil {
+		return err
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.saved == nil {
+		saved, err := store.loadRows(ctx)
+		if err != nil {
+			return err
+		}
+		store.saved = saved
+	}
+
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin snapshot transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	updatedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	for row, data := range rows {
+		if saved, ok := store.saved[row]; ok && saved == data {
+			continue
+		}
+		if err := store.upsertRow(ctx, tx, row, data, updatedAt); err != nil {
+			return err
+		}
+	}
+	for row := range store.saved {
+		if _, ok := rows[row]; ok {
+			continue
+		}
+		remove := fmt.Sprintf(
+			"DELETE FROM %s WHERE store_key = %s AND kind = %s AND item = %s",
+			store.table, store.placeholder(1), store.placeholder(2), store.placeholder(3),
+		)
+		if _, err := tx.ExecContext(ctx, remove, store.key, row.kind, row.item); err != nil {
+			return fmt.Errorf("failed to delete indexer %s %s: %w", row.kind, row.item, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		store.saved = nil
+		return fmt.Errorf("failed to commit indexer snapshot: %w", err)
+	}
+	store.saved = rows
+	return nil
+}
+
+func (store *SQLStateStore) loadRows(ctx context.Context) (map[sqlStateRow]string, error) {
+	query := fmt.Sprintf("SELECT kind, item, data FROM %s WHERE store_key = %s", store.table, store.placeholder(1))
+	result, err := store.db.QueryContext(ctx, query, store.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load indexer snapshot: %w", err)
+	}
+	defer result.Close()
+
+	rows := map[sqlStateRow]string{}
+	for result.Next() {
+		var row sqlStateRow
+		var data string
+		if err := result.Scan(&row.kind, &row.item, &data); err != nil {
+			return nil, fmt.Errorf("failed to load indexer snapshot: %w", err)
+		}
+		rows[row] = data
+	}
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load indexer snapshot: %w", err)
+	}
+	return rows, nil
+}
+
+func (store *SQLStateStore) upsertRow(ctx context.Context, tx *sql.Tx, row sqlStateRow, data string, updatedAt string) error {
+	update := fmt.Sprintf(
+		"UPDATE %s SET data = %s, updated_at = %s WHERE store_key = %s AND kind = %s AND item = %s",
+		store.table, store.placeholder(1), store.placeholder(2), store.placeholder(3), store.placeholder(4), store.placeholder(5),
+	)
+	result, err := tx.ExecContext(ctx, update, data, updatedAt, store.key, row.kind, row.item)
+	if err != nil {
+		return fmt.Errorf("failed to update indexer %s %s: %w", row.kind, row.item, err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update indexer %s %s: %w", row.kind, row.item, err)
+	}
+	if updated > 0 {
+		return nil
+	}
+	insert := fmt.Sprintf(
+		"INSERT INTO %s (store_key, kind, item, data, updated_at) VALUES (%s, %s, %s, %s, %s)",
+		store.table, store.placeholder(1), store.placeholder(2), store.placeholder(3), store.placeholder(4), store.placeholder(5),
+	)
+	if _, err := tx.ExecContext(ctx, insert, store.key, row.kind, row.item, data, updatedAt); err != nil {
+		return fmt.Errorf("failed to insert indexer %s %s: %w", row.kind, row.item, err)
+	}
+	return nil
+}
+
+// snapshotRows flattens a snapshot into SQL rows. Balances are keyed by
+// tick and account, transactions by topic and sequence number.
+func snapshotRows(snapshot IndexerSnapshot) (map[sqlStateRow]string, error) {
+	rows := map[sqlStateRow]string{
+		{kind: sqlRowMeta, item: sqlMetaSequence}:  strconv.FormatInt(snapshot.State.LastProcessedSequence, 10),
+		{kind: sqlRowMeta, item: sqlMetaTimestamp}: snapshot.State.LastProcessedTimestamp,
+	}
+	add := func(kind string, item string, value any) error {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode indexer %s %s: %w", kind, item, err)
+		}
+		rows[sqlStateRow{kind: kind, item: item}] = string(encoded)
+		return nil
+	}
+
+	for topicID, sequence := range snapshot.Cursors {
+		rows[sqlStateRow{kind: sqlRowCursor, item: topicID}] = strconv.FormatInt(sequence, 10)
+	}
+	for tick, info := range snapshot.State.DeployedPoints {
+		if err := add(sqlRowPoints, tick, info); err != nil {
+			return nil, err
+		}
+	}
+	for tick, balances := range snapshot.State.Balances {
+		for accountID, balance := range balances {
+			if err := add(sqlRowBalance, tick+"/"+accountID, balance); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, transaction := range snapshot.State.Transactions {
+		item := fmt.Sprintf("%s/%020d", transaction.TopicID, transaction.SequenceNumber)
+		if err := add(sqlRowTransaction, item, transaction); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// snapshotFromRows rebuilds a snapshot from its SQL rows, ordering the
+// transactions by consensus timestamp.
+func snapshotFromRows(rows map[sqlStateRow]string) (IndexerSnapshot, error) {
+	type keyedTransaction struct {
+		item        string
+		transaction PointsTransaction
+	}
+
+	snapshot := IndexerSnapshot{State: newEmptyState(), Cursors: map[string]int64{}}
+	transactions := make([]keyedTransaction, 0)
+	for row, data := range rows {
+		var err error
+		switch row.kind {
+		case sqlRowMeta:
+			switch row.item {
+			case sqlMetaSequence:
+				snapshot.State.LastProcessedSequence, err = strconv.ParseInt(data, 10, 64)
+			case sqlMetaTimestamp:
+				snapshot.State.LastProcessedTimestamp = data
+			}
+		case sqlRowCursor:
+			snapshot.Cursors[row.item], err = strconv.ParseInt(data, 10, 64)
+		case sqlRowPoints:
+			var info PointsInfo
+			if err = json.Unmarshal([]byte(data), &info); err == nil {
+				snapshot.State.DeployedPoints[row.item] = info
+			}
+		case sqlRowBalance:
+			separator := strings.LastIndex(row.item, "/")
+			if separator < 0 {
+				err = fmt.Errorf("missing account")
+				break
+			}
+			tick, accountID := row.item[:separator], row.item[separator+1:]
+			var balance PointsBalance
+			if err = json.Unmarshal([]byte(data), &balance); err == nil {
+				tickBalances, ok := snapshot.State.Balances[tick]
+				if !ok {
+					tickBalances = map[string]PointsBalance{}
+					snapshot.State.Balances[tick] = tickBalances
+				}
+				tickBalances[accountID] = balance
+			}
+		case sqlRowTransaction:
+			var transaction PointsTransaction
+			if err = json.Unmarshal([]byte(data), &transaction); err == nil {
+				transactions = append(transactions, keyedTransaction{item: row.item, transaction: transaction})
+			}
+		}
+		if err != nil {
+			return IndexerSnapshot{}, fmt.Errorf("failed to decode indexer %s %s: %w", row.kind, row.item, err)
+		}
+	}
+
+	sort.Slice(transactions, func(left, right int) bool {
+		leftTimestamp, rightTimestamp := transactions[left].transaction.Timestamp, transactions[right].transaction.Timestamp
+		if leftTimestamp != rightTimestamp {
+			return leftTimestamp < rightTimestamp
+		}
+		return transactions[left].item < transactions[right].item
+	})
+	for _, keyed := range transactions {
+		snapshot.State.Transactions = append(snapshot.State.Transactions, keyed.transaction)
+	}
+	return snapshot, nil
+}
+
+func decodeSnapshot(encoded []byte) (IndexerSnapshot, error) {
+	var snapshot IndexerSnapshot
+	if err := json.Unmarshal(encoded, &snapshot); err != nil {
+		return IndexerSnapshot{}, fmt.Errorf("failed to decode indexer snapshot: %w", err)
+	}
+	return snapshot, nil
+}
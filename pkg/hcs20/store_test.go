@@ -0,0 +1,329 @@
+package hcs20
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSQLDriver understands just the statements SQLStateStore issues and
+// keeps rows in memory, keyed by store key, kind and item.
+type fakeSQLDriver struct {
+	mutex   sync.Mutex
+	rows    map[fakeSQLKey]string
+	queries []string
+}
+
+type fakeSQLKey struct{ store, kind, item string }
+
+var registerFakeSQLDriver = sync.OnceValue(func() *fakeSQLDriver {
+	fake := &fakeSQLDriver{rows: map[fakeSQLKey]string{}}
+	sql.Register("hcs20-fake", fake)
+	return fake
+})
+
+func (fake *fakeSQLDriver) Open(string) (driver.Conn, error) { return fakeSQLConn{fake}, nil }
+
+// statements returns the recorded statements starting with prefix.
+func (fake *fakeSQLDriver) statements(prefix string) int {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	count := 0
+	for _, query := range fake.queries {
+		if strings.HasPrefix(query, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+type fakeSQLConn struct{ driver *fakeSQLDriver }
+
+func (conn fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeSQLStmt{driver: conn.driver, query: query}, nil
+}
+func (fakeSQLConn) Close() error              { return nil }
+func (fakeSQLConn) Begin() (driver.Tx, error) { return fakeSQLTx{}, nil }
+
+type fakeSQLTx struct{}
+
+func (fakeSQLTx) Commit() error   { return nil }
+func (fakeSQLTx) Rollback() error { return nil }
+
+type fakeSQLStmt struct {
+	driver *fakeSQLDriver
+	query  string
+}
+
+func (fakeSQLStmt) Close() error  { return nil }
+func (fakeSQLStmt) NumInput() int { return -1 }
+
+func (stmt fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	stmt.driver.mutex.Lock()
+	defer stmt.driver.mutex.Unlock()
+	stmt.driver.queries = append(stmt.driver.queries, stmt.query)
+
+	switch {
+	case strings.HasPrefix(stmt.query, "CREATE TABLE"):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(stmt.query, "UPDATE"):
+		key := fakeSQLKey{args[2].(string), args[3].(string), args[4].(string)}
+		if _, ok := stmt.driver.rows[key]; !ok {
+			return driver.RowsAffected(0), nil
+		}
+		stmt.driver.rows[key] = args[0].(string)
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(stmt.query, "INSERT"):
+		stmt.driver.rows[fakeSQLKey{args[0].(string), args[1].(string), args[2].(string)}] = args[3].(string)
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(stmt.query, "DELETE"):
+		delete(stmt.driver.rows, fakeSQLKey{args[0].(string), args[1].(string), args[2].(string)})
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unsupported statement %s", stmt.query)
+}
+
+func (stmt fakeSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	stmt.driver.mutex.Lock()
+	defer stmt.driver.mutex.Unlock()
+	stmt.driver.queries = append(stmt.driver.queries, stmt.query)
+
+	rows := &fakeSQLRows{}
+	for key, data := range stmt.driver.rows {
+		if key.store == args[0].(string) {
+			rows.values = append(rows.values, [3]string{key.kind, key.item, data})
+		}
+	}
+	return rows, nil
+}
+
+type fakeSQLRows struct{ values [][3]string }
+
+func (*fakeSQLRows) Columns() []string { return []string{"kind", "item", "data"} }
+func (*fakeSQLRows) Close() error      { return nil }
+func (rows *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(rows.values) == 0 {
+		return io.EOF
+	}
+	for index, value := range rows.values[0] {
+		dest[index] = value
+	}
+	rows.values = rows.values[1:]
+	return nil
+}
+
+func testSnapshot() IndexerSnapshot {
+	state := newEmptyState()
+	state.DeployedPoints["loyal"] = PointsInfo{Tick: "loyal", CurrentSupply: "70"}
+	state.Balances["loyal"] = map[string]PointsBalance{"0.0.1001": {Tick: "loyal", AccountID: "0.0.1001", Balance: "60"}}
+	state.Transactions = []PointsTransaction{
+		{ID: "a", Operation: OperationMint, Tick: "loyal", Amount: "100", TopicID: "0.0.5001", SequenceNumber: 3, Timestamp: "1700000003.000000000"},
+		{ID: "b", Operation: OperationBurn, Tick: "loyal", Amount: "40", TopicID: "0.0.5001", SequenceNumber: 4, Timestamp: "1700000004.000000000"},
+	}
+	state.LastProcessedSequence = 4
+	return IndexerSnapshot{State: state, Cursors: map[string]int64{"0.0.5001": 4}}
+}
+
+func assertStoreRoundTrip(t *testing.T, store StateStore) {
+	t.Helper()
+	if _, found, err := store.Load(context.Background()); err != nil || found {
+		t.Fatalf("expected an empty store, got %v, %v", found, err)
+	}
+	for _, supply := range []string{"10", "70"} {
+		snapshot := testSnapshot()
+		info := snapshot.State.DeployedPoints["loyal"]
+		info.CurrentSupply = supply
+		snapshot.State.DeployedPoints["loyal"] = info
+		if err := store.Save(context.Background(), snapshot); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+	loaded, found, err := store.Load(context.Background())
+	if err != nil || !found {
+		t.Fatalf("expected a saved snapshot, got %v, %v", found, err)
+	}
+	if loaded.Cursors["0.0.5001"] != 4 || loaded.State.DeployedPoints["loyal"].CurrentSupply != "70" ||
+		loaded.State.Balances["loyal"]["0.0.1001"].Balance != "60" || loaded.State.LastProcessedSequence != 4 {
+		t.Fatalf("unexpected snapshot %+v", loaded)
+	}
+	if transactions := loaded.State.Transactions; len(transactions) != 2 || transactions[0].ID != "a" || transactions[1].ID != "b" {
+		t.Fatalf("expected the transactions in order, got %+v", transactions)
+	}
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	store := NewMemoryStateStore()
+	assertStoreRoundTrip(t, store)
+
+	loaded, _, _ := store.Load(context.Background())
+	loaded.Cursors["0.0.5001"] = 99
+	if again, _, _ := store.Load(context.Background()); again.Cursors["0.0.5001"] != 4 {
+		t.Fatal("expected loaded snapshots to be copies")
+	}
+}
+
+func TestFileStateStore(t *testing.T) {
+	if _, err := NewFileStateStore(" "); err == nil {
+		t.Fatal("expected error for missing path")
+	}
+	directory := t.TempDir()
+	store, err := NewFileStateStore(filepath.Join(directory, "state.json"))
+	if err != nil {
+		t.Fatalf("NewFileStateStore failed: %v", err)
+	}
+	assertStoreRoundTrip(t, store)
+
+	entries, _ := os.ReadDir(directory)
+	if len(entries) != 1 {
+		t.Fatalf("expected temporary files to be cleaned up, got %d entries", len(entries))
+	}
+	if err := os.WriteFile(store.path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("failed to corrupt state file: %v", err)
+	}
+	if _, _, err := store.Load(context.Background()); err == nil {
+		t.Fatal("expected error for a corrupt state file")
+	}
+}
+
+func TestSQLStateStore(t *testing.T) {
+	fake := registerFakeSQLDriver()
+	db, err := sql.Open("hcs20-fake", "")
+	if err != nil {
+		t.Fatalf("sql.Open failed: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := NewSQLStateStore(SQLStateStoreConfig{}); err == nil {
+		t.Fatal("expected error for missing database")
+	}
+	if _, err := NewSQLStateStore(SQLStateStoreConfig{DB: db, Table: "state; DROP TABLE x"}); err == nil {
+		t.Fatal("expected error for an invalid table name")
+	}
+	store, err := NewSQLStateStore(SQLStateStoreConfig{DB: db, Key: "leaderboard", Placeholder: DollarPlaceholder})
+	if err != nil {
+		t.Fatalf("NewSQLStateStore failed: %v", err)
+	}
+	if err := store.EnsureTable(context.Background()); err != nil {
+		t.Fatalf("EnsureTable failed: %v", err)
+	}
+	assertStoreRoundTrip(t, store)
+
+	if fake.rows[fakeSQLKey{"leaderboard", "cursor", "0.0.5001"}] != "4" ||
+		fake.rows[fakeSQLKey{"leaderboard", "balance", "loyal/0.0.1001"}] == "" ||
+		fake.rows[fakeSQLKey{"leaderboard", "transaction", "0.0.5001/00000000000000000004"}] == "" {
+		t.Fatalf("expected a row per cursor, balance and transaction, got %v", fake.rows)
+	}
+	if last := fake.queries[len(fake.queries)-1]; last != "SELECT kind, item, data FROM hcs20_indexer_state WHERE store_key = $1" {
+		t.Fatalf("unexpected query %s", last)
+	}
+
+	// A second store has no cached rows and must diff against the table.
+	restarted, err := NewSQLStateStore(SQLStateStoreConfig{DB: db, Key: "leaderboard", Placeholder: DollarPlaceholder})
+	if err != nil {
+		t.Fatalf("NewSQLStateStore failed: %v", err)
+	}
+	snapshot := testSnapshot()
+	snapshot.State.Balances["loyal"]["0.0.1001"] = PointsBalance{Tick: "loyal", AccountID: "0.0.1001", Balance: "55"}
+	snapshot.State.Transactions = snapshot.State.Transactions[1:]
+	updates, inserts, deletes := fake.statements("UPDATE"), fake.statements("INSERT"), fake.statements("DELETE")
+	if err := restarted.Save(context.Background(), snapshot); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if fake.statements("UPDATE")-updates != 1 || fake.statements("INSERT") != inserts || fake.statements("DELETE")-deletes != 1 {
+		t.Fatalf("expected only the changed balance and dropped transaction to be written, got %v", fake.queries)
+	}
+	loaded, _, err := restarted.Load(context.Background())
+	if err != nil || loaded.State.Balances["loyal"]["0.0.1001"].Balance != "55" || len(loaded.State.Transactions) != 1 {
+		t.Fatalf("unexpected snapshot %+v, %v", loaded, err)
+	}
+}
+
+func TestPointsIndexerResumesFromStore(t *testing.T) {
+	privateTopicID := "0.0.5001"
+	fixtures := topicMessagesFixture{
+		privateTopicID: {
+			newFixtureMessage(t, privateTopicID, 1, "0.0.1001", Message{
+				Protocol: "hcs-20", Operation: "deploy", Name: "Loyalty", Tick: "loyal", Max: "1000",
+			}),
+			newFixtureMessage(t, privateTopicID, 2, "0.0.1001", Message{
+				Protocol: "hcs-20", Operation: "mint", Tick: "loyal", Amount: "100", To: "0.0.1001",
+			}),
+		},
+	}
+	server := newMirrorFixtureServer(t, fixtures)
+	defer server.Close()
+
+	config := IndexerConfig{Network: "testnet", MirrorBaseURL: server.URL}
+	options := IndexOptions{PrivateTopics: []string{privateTopicID}}
+	store := NewMemoryStateStore()
+
+	if _, err := NewPointsIndexerFromStore(t.Context(), config, nil); err == nil {
+		t.Fatal("expected error for missing store")
+	}
+	first, err := NewPointsIndexerFromStore(t.Context(), config, store)
+	if err != nil {
+		t.Fatalf("NewPointsIndexerFromStore failed: %v", err)
+	}
+	if err := first.IndexOnce(t.Context(), options); err != nil {
+		t.Fatalf("IndexOnce failed: %v", err)
+	}
+
+	// A message the store never saw: replaying the mint would double count.
+	fixtures[privateTopicID] = append(fixtures[privateTopicID],
+		newFixtureMessage(t, privateTopicID, 3, "0.0.1001", Message{
+			Protocol: "hcs-20", Operation: "burn", Tick: "loyal", Amount: "30", From: "0.0.1001",
+		}),
+	)
+
+	restarted, err := NewPointsIndexerFromStore(t.Context(), config, store)
+	if err != nil {
+		t.Fatalf("NewPointsIndexerFromStore failed: %v", err)
+	}
+	if balance := restarted.GetBalance("loyal", "0.0.1001"); balance != "100" {
+		t.Fatalf("expected the restored balance 100, got %s", balance)
+	}
+	if err := restarted.IndexOnce(t.Context(), options); err != nil {
+		t.Fatalf("IndexOnce failed: %v", err)
+	}
+	if balance := restarted.GetBalance("loyal", "0.0.1001"); balance != "70" {
+		t.Fatalf("expected balance 70 after resuming, got %s", balance)
+	}
+
+	saved, _, _ := store.Load(t.Context())
+	if saved.Cursors[privateTopicID] != 3 || saved.State.DeployedPoints["loyal"].CurrentSupply != "70" {
+		t.Fatalf("expected the resumed pass to be saved, got %+v", saved)
+	}
+	if len(saved.State.Transactions) != 0 || len(restarted.StateSnapshot().Transactions) != 1 {
+		t.Fatalf("expected no transaction history to be persisted by default, got %+v", saved.State.Transactions)
+	}
+}
+
+func TestPointsIndexerCapsPersistedTransactions(t *testing.T) {
+	if _, err := NewPointsIndexer(IndexerConfig{Network: "testnet", MaxPersistedTransactions: -1}); err == nil {
+		t.Fatal("expected error for a negative transaction cap")
+	}
+	store := NewMemoryStateStore()
+	indexer, err := NewPointsIndexerFromStore(t.Context(), IndexerConfig{Network: "testnet", MaxPersistedTransactions: 2}, store)
+	if err != nil {
+		t.Fatalf("NewPointsIndexerFromStore failed: %v", err)
+	}
+	indexer.state.Transactions = []PointsTransaction{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	if err := indexer.SaveState(t.Context()); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	saved, _, _ := store.Load(t.Context())
+	if len(saved.State.Transactions) != 2 || saved.State.Transactions[0].ID != "b" || saved.State.Transactions[1].ID != "c" {
+		t.Fatalf("expected the two most recent transactions, got %+v", saved.State.Transactions)
+	}
+	if len(indexer.Snapshot().State.Transactions) != 3 {
+		t.Fatal("expected Snapshot to keep the full history")
+	}
+}
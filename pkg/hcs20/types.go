@@ -1,10 +1,13 @@
 package hcs20
 
 import (
+	"database/sql"
 	"regexp"
 	"time"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 const (
@@ -101,12 +104,19 @@ type RegisterMessage struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	PublicTopicID      string
 	RegistryTopicID    string
 	HederaClient       *hedera.Client
+	// ExecutionMode set to shared.ExecutionModeEnvelope makes deploy, mint,
+	// transfer, burn and register return frozen envelopes instead of
+	// submitting them.
+	ExecutionMode shared.ExecutionMode
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateTopicOptions struct {
@@ -218,6 +228,8 @@ type PointsInfo struct {
 	CurrentSupply       string `json:"currentSupply"`
 	DeploymentTimestamp string `json:"deploymentTimestamp"`
 	IsPrivate           bool   `json:"isPrivate"`
+	// Envelope is set instead of DeploymentTimestamp in envelope mode.
+	Envelope *shared.TxEnvelope `json:"-"`
 }
 
 type PointsBalance struct {
@@ -239,6 +251,8 @@ type PointsTransaction struct {
 	TopicID        string `json:"topicId"`
 	TransactionID  string `json:"transactionId"`
 	Memo           string `json:"memo,omitempty"`
+	// Envelope is set instead of Timestamp and SequenceNumber in envelope mode.
+	Envelope *shared.TxEnvelope `json:"-"`
 }
 
 type OperationResult struct {
@@ -246,6 +260,7 @@ type OperationResult struct {
 	TransactionID  string
 	SequenceNumber int64
 	ConsensusAt    time.Time
+	Envelope       *shared.TxEnvelope
 }
 
 type PointsState struct {
@@ -256,10 +271,34 @@ type PointsState struct {
 	LastProcessedTimestamp string
 }
 
+// IndexerSnapshot is the persisted form of a PointsIndexer: its points state
+// and the last indexed sequence number of each topic.
+type IndexerSnapshot struct {
+	State   PointsState      `json:"state"`
+	Cursors map[string]int64 `json:"cursors"`
+}
+
 type IndexerConfig struct {
 	Network       string
 	MirrorBaseURL string
 	MirrorAPIKey  string
+	// MaxPersistedTransactions caps the transaction history saved with each
+	// snapshot to the most recent entries. Zero saves no history; supply,
+	// balances and cursors are always saved.
+	MaxPersistedTransactions int
+}
+
+// SQLStateStoreConfig configures a StateStore backed by database/sql.
+type SQLStateStoreConfig struct {
+	DB *sql.DB
+	// Table defaults to "hcs20_indexer_state".
+	Table string
+	// Key identifies the indexer's rows, so several indexers can share a
+	// table. Defaults to "default".
+	Key string
+	// Placeholder returns the bind parameter for the 1-based index. Defaults
+	// to "?"; use DollarPlaceholder for PostgreSQL drivers.
+	Placeholder func(index int) string
 }
 
 type IndexOptions struct {
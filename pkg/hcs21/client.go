@@ -16,11 +16,12 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
 	operatorKey       hedera.PrivateKey
-	operatorKeyRaw    string
+	operatorSigner    shared.Signer
 }
 
 // NewClient creates a new Client.
@@ -29,11 +30,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -50,11 +52,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
-		operatorKeyRaw:    operator.PrivateKeyRaw,
+		operatorSigner:    operator.Signer,
 	}, nil
 }
 
@@ -95,8 +98,6 @@ func (c *Client) CreateRegistryTopic(
 	ctx context.Context,
 	options CreateRegistryTopicOptions,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction, err := BuildCreateRegistryTopicTx(
 		options.TTL,
 		options.Indexed,
@@ -112,14 +113,11 @@ func (c *Client) CreateRegistryTopic(
 		transaction.SetTransactionMemo(strings.TrimSpace(options.TransactionMemo))
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to create HCS-21 topic")
 	}
@@ -131,21 +129,15 @@ func (c *Client) PublishDeclaration(
 	ctx context.Context,
 	options PublishDeclarationOptions,
 ) (hedera.TransactionReceipt, string, error) {
-	_ = ctx
-
 	transaction, err := BuildDeclarationMessageTx(options.TopicID, options.Declaration, options.TransactionMemo)
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to execute declaration transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to get declaration receipt: %w", err)
-	}
-	return receipt, response.TransactionID.String(), nil
+	return executed.Receipt, executed.TransactionID, nil
 }
 
 // FetchDeclarations performs the requested operation.
@@ -194,8 +186,6 @@ func (c *Client) CreateAdapterVersionPointerTopic(
 	useOperatorAsSubmit bool,
 	transactionMemo string,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction := hcs2.BuildHCS2CreateRegistryTx(hcs2.CreateRegistryTxParams{
 		RegistryType: hcs2.RegistryTypeNonIndexed,
 		TTL:          ttl,
@@ -205,14 +195,11 @@ func (c *Client) CreateAdapterVersionPointerTopic(
 	if strings.TrimSpace(transactionMemo) != "" {
 		transaction.SetTransactionMemo(strings.TrimSpace(transactionMemo))
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute version pointer topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get version pointer topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to create HCS-2 version pointer topic")
 	}
@@ -227,8 +214,6 @@ func (c *Client) CreateRegistryDiscoveryTopic(
 	useOperatorAsSubmit bool,
 	transactionMemo string,
 ) (string, hedera.TransactionReceipt, error) {
-	_ = ctx
-
 	transaction := hcs2.BuildHCS2CreateRegistryTx(hcs2.CreateRegistryTxParams{
 		RegistryType: hcs2.RegistryTypeIndexed,
 		TTL:          ttl,
@@ -238,14 +223,11 @@ func (c *Client) CreateRegistryDiscoveryTopic(
 	if strings.TrimSpace(transactionMemo) != "" {
 		transaction.SetTransactionMemo(strings.TrimSpace(transactionMemo))
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to execute discovery topic create transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to get discovery topic create receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", hedera.TransactionReceipt{}, fmt.Errorf("failed to create HCS-2 discovery topic")
 	}
@@ -260,8 +242,6 @@ func (c *Client) PublishVersionPointer(
 	memo string,
 	transactionMemo string,
 ) (hedera.TransactionReceipt, string, error) {
-	_ = ctx
-
 	transaction, err := hcs2.BuildHCS2RegisterTx(hcs2.RegisterTxParams{
 		RegistryTopicID: versionTopicID,
 		TargetTopicID:   declarationTopicID,
@@ -272,15 +252,11 @@ func (c *Client) PublishVersionPointer(
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to execute version pointer register transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to get version pointer register receipt: %w", err)
-	}
-	return receipt, response.TransactionID.String(), nil
+	return executed.Receipt, executed.TransactionID, nil
 }
 
 // RegisterCategoryTopic performs the requested operation.
@@ -292,8 +268,6 @@ func (c *Client) RegisterCategoryTopic(
 	memo string,
 	transactionMemo string,
 ) (hedera.TransactionReceipt, string, error) {
-	_ = ctx
-
 	transaction, err := hcs2.BuildHCS2RegisterTx(hcs2.RegisterTxParams{
 		RegistryTopicID: discoveryTopicID,
 		TargetTopicID:   categoryTopicID,
@@ -305,15 +279,11 @@ func (c *Client) RegisterCategoryTopic(
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to execute category register transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to get category register receipt: %w", err)
-	}
-	return receipt, response.TransactionID.String(), nil
+	return executed.Receipt, executed.TransactionID, nil
 }
 
 // PublishCategoryEntry performs the requested operation.
@@ -326,8 +296,6 @@ func (c *Client) PublishCategoryEntry(
 	memo string,
 	transactionMemo string,
 ) (hedera.TransactionReceipt, string, error) {
-	_ = ctx
-
 	resolvedMemo := strings.TrimSpace(memo)
 	if resolvedMemo == "" {
 		resolvedMemo = "adapter:" + strings.TrimSpace(adapterID)
@@ -344,15 +312,11 @@ func (c *Client) PublishCategoryEntry(
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", err
 	}
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to execute category entry transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return hedera.TransactionReceipt{}, "", fmt.Errorf("failed to get category entry receipt: %w", err)
-	}
-	return receipt, response.TransactionID.String(), nil
+	return executed.Receipt, executed.TransactionID, nil
 }
 
 // ResolveLatestVersionPointer performs the requested operation.
@@ -367,11 +331,12 @@ func (c *Client) ResolveLatestVersionPointer(
 		}
 	}
 	registryClient, err := hcs2.NewClient(hcs2.ClientConfig{
-		OperatorAccountID:  c.operatorID.String(),
-		OperatorPrivateKey: c.operatorKeyRaw,
-		Network:            networkStr,
-		MirrorBaseURL:      c.mirrorClient.BaseURL(),
-		HederaClient:       c.hederaClient,
+		OperatorAccountID: c.operatorID.String(),
+		OperatorSigner:    c.operatorSigner,
+		Network:           networkStr,
+		MirrorBaseURL:     c.mirrorClient.BaseURL(),
+		HederaClient:      c.hederaClient,
+		Executor:          c.executor,
 	})
 	if err != nil {
 		return "", 0, "", err
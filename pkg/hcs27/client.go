@@ -24,11 +24,12 @@ import (
 
 type Client struct {
 	hederaClient            *hedera.Client
+	executor                *shared.Executor
 	mirrorClient            *mirror.Client
 	operatorID              hedera.AccountID
 	operatorPublicKey       hedera.PublicKey
 	operatorKey             hedera.PrivateKey
-	operatorKeyRaw          string
+	operatorSigner          shared.Signer
 	network                 string
 	inscriberAuthURL        string
 	inscriberAPIURL         string
@@ -60,11 +61,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -81,11 +83,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
-		operatorKeyRaw:    operator.PrivateKeyRaw,
+		operatorSigner:    operator.Signer,
 		network:           network,
 		inscriberAuthURL:  strings.TrimSpace(config.InscriberAuthURL),
 		inscriberAPIURL:   strings.TrimSpace(config.InscriberAPIURL),
@@ -121,19 +124,16 @@ func (c *Client) CreateCheckpointTopic(
 		transaction.SetSubmitKey(*submitKey)
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create checkpoint topic: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to get create topic receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return "", "", fmt.Errorf("create topic receipt did not include topic ID")
 	}
 
-	return receipt.TopicID.String(), response.TransactionID.String(), nil
+	return receipt.TopicID.String(), executed.TransactionID, nil
 }
 
 // PublishCheckpoint publishes the requested message payload.
@@ -194,23 +194,18 @@ func (c *Client) PublishCheckpoint(
 		transactionMemo = BuildTransactionMemo()
 	}
 
-	response, err := hedera.NewTopicMessageSubmitTransaction().
+	transaction := hedera.NewTopicMessageSubmitTransaction().
 		SetTopicID(topic).
 		SetMessage(payload).
-		SetTransactionMemo(transactionMemo).
-		Execute(c.hederaClient)
+		SetTransactionMemo(transactionMemo)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return PublishResult{}, fmt.Errorf("failed to publish checkpoint message: %w", err)
 	}
 
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return PublishResult{}, fmt.Errorf("failed to get checkpoint receipt: %w", err)
-	}
-
 	return PublishResult{
-		TransactionID:  response.TransactionID.String(),
-		SequenceNumber: int64(receipt.TopicSequenceNumber),
+		TransactionID:  executed.TransactionID,
+		SequenceNumber: int64(executed.Receipt.TopicSequenceNumber),
 	}, nil
 }
 
@@ -261,15 +256,15 @@ func (c *Client) publishMetadataHCS1(
 	if strings.EqualFold(c.network, shared.NetworkMainnet) {
 		network = inscriber.NetworkMainnet
 	}
-	if strings.TrimSpace(c.operatorKeyRaw) == "" {
-		return "", nil, fmt.Errorf("operator private key is required for inscriber-backed metadata publication")
+	if c.operatorSigner == nil {
+		return "", nil, fmt.Errorf("operator private key or signer is required for inscriber-backed metadata publication")
 	}
 
 	authClient := inscriber.NewAuthClient(c.inscriberAuthURL)
-	authResult, err := authClient.Authenticate(
+	authResult, err := authClient.AuthenticateWithSigner(
 		ctx,
 		c.operatorID.String(),
-		c.operatorKeyRaw,
+		c.operatorSigner,
 		network,
 	)
 	if err != nil {
@@ -295,9 +290,9 @@ func (c *Client) publishMetadataHCS1(
 			MimeType: "application/json",
 		},
 		inscriber.HederaClientConfig{
-			AccountID:  c.operatorID.String(),
-			PrivateKey: c.operatorKeyRaw,
-			Network:    network,
+			AccountID: c.operatorID.String(),
+			Signer:    c.operatorSigner,
+			Network:   network,
 		},
 		inscriber.InscriptionOptions{
 			Mode:                inscriber.ModeFile,
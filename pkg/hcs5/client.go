@@ -14,13 +14,15 @@ import (
 )
 
 type Client struct {
-	hederaClient          *hedera.Client
-	operatorAccountID     hedera.AccountID
-	operatorPrivateKey    hedera.PrivateKey
-	operatorPrivateKeyRaw string
-	network               string
-	inscriberAuthURL      string
-	inscriberAPIURL       string
+	hederaClient       *hedera.Client
+	executor           *shared.Executor
+	operatorAccountID  hedera.AccountID
+	operatorPrivateKey hedera.PrivateKey
+	operatorPublicKey  hedera.PublicKey
+	operatorSigner     shared.Signer
+	network            string
+	inscriberAuthURL   string
+	inscriberAPIURL    string
 }
 
 // NewClient creates a new Client.
@@ -29,24 +31,27 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		hederaClient:          hederaClient,
-		operatorAccountID:     operator.AccountID,
-		operatorPrivateKey:    operator.PrivateKey,
-		operatorPrivateKeyRaw: operator.PrivateKeyRaw,
-		network:               network,
-		inscriberAuthURL:      strings.TrimSpace(config.InscriberAuthURL),
-		inscriberAPIURL:       strings.TrimSpace(config.InscriberAPIURL),
+		hederaClient:       hederaClient,
+		executor:           config.Executor.WithOperatorSigner(operator.Signer),
+		operatorAccountID:  operator.AccountID,
+		operatorPrivateKey: operator.PrivateKey,
+		operatorPublicKey:  operator.PublicKey,
+		operatorSigner:     operator.Signer,
+		network:            network,
+		inscriberAuthURL:   strings.TrimSpace(config.InscriberAuthURL),
+		inscriberAPIURL:    strings.TrimSpace(config.InscriberAPIURL),
 	}, nil
 }
 
@@ -80,15 +85,11 @@ func (c *Client) Mint(
 		frozenTransaction = frozenTransaction.Sign(supplyKey)
 	}
 
-	response, err := hedera.TransactionExecute(frozenTransaction, c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozenTransaction)
 	if err != nil {
 		return MintResponse{}, fmt.Errorf("failed to execute mint transaction: %w", err)
 	}
-
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return MintResponse{}, fmt.Errorf("failed to retrieve mint receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.Status.String() != "SUCCESS" {
 		return MintResponse{}, fmt.Errorf("mint transaction failed with status %s", receipt.Status.String())
 	}
@@ -101,7 +102,7 @@ func (c *Client) Mint(
 	return MintResponse{
 		Success:       true,
 		SerialNumber:  serial,
-		TransactionID: response.TransactionID.String(),
+		TransactionID: executed.TransactionID,
 		Metadata:      BuildHCS1HRL(options.MetadataTopicID),
 	}, nil
 }
@@ -119,43 +120,13 @@ func (c *Client) CreateHashinal(
 		request.HolderID = c.operatorAccountID.String()
 	}
 
-	inscriberNetwork := options.InscriberNetwork
-	if inscriberNetwork == "" {
-		if c.network == shared.NetworkMainnet {
-			inscriberNetwork = inscriber.NetworkMainnet
-		} else {
-			inscriberNetwork = inscriber.NetworkTestnet
-		}
-	}
-
-	authBaseURL := options.InscriberAuthURL
-	if strings.TrimSpace(authBaseURL) == "" {
-		authBaseURL = c.inscriberAuthURL
-	}
-	apiBaseURL := options.InscriberAPIURL
-	if strings.TrimSpace(apiBaseURL) == "" {
-		apiBaseURL = c.inscriberAPIURL
-	}
-
-	authClient := inscriber.NewAuthClient(authBaseURL)
-	if strings.TrimSpace(c.operatorPrivateKeyRaw) == "" {
-		return MintResponse{}, fmt.Errorf("operator private key is required for inscriber-backed hashinal creation")
-	}
-	authResult, err := authClient.Authenticate(
+	inscriberNetwork := c.resolveInscriberNetwork(options.InscriberNetwork)
+	inscriberClient, err := c.newInscriberClient(
 		ctx,
-		c.operatorAccountID.String(),
-		c.operatorPrivateKeyRaw,
 		inscriberNetwork,
+		options.InscriberAuthURL,
+		options.InscriberAPIURL,
 	)
-	if err != nil {
-		return MintResponse{}, fmt.Errorf("failed to authenticate inscriber client: %w", err)
-	}
-
-	inscriberClient, err := inscriber.NewClient(inscriber.Config{
-		APIKey:  authResult.APIKey,
-		Network: inscriberNetwork,
-		BaseURL: apiBaseURL,
-	})
 	if err != nil {
 		return MintResponse{}, err
 	}
@@ -172,9 +143,9 @@ func (c *Client) CreateHashinal(
 		ctx,
 		job.TransactionBytes,
 		inscriber.HederaClientConfig{
-			AccountID:  c.operatorAccountID.String(),
-			PrivateKey: c.operatorPrivateKeyRaw,
-			Network:    inscriberNetwork,
+			AccountID: c.operatorAccountID.String(),
+			Signer:    c.operatorSigner,
+			Network:   inscriberNetwork,
 		},
 	)
 	if err != nil {
@@ -223,3 +194,49 @@ func (c *Client) CreateHashinal(
 		Memo:            options.Memo,
 	})
 }
+
+func (c *Client) resolveInscriberNetwork(network inscriber.Network) inscriber.Network {
+	if network != "" {
+		return network
+	}
+	if c.network == shared.NetworkMainnet {
+		return inscriber.NetworkMainnet
+	}
+	return inscriber.NetworkTestnet
+}
+
+func (c *Client) newInscriberClient(
+	ctx context.Context,
+	network inscriber.Network,
+	authURL string,
+	apiURL string,
+) (*inscriber.Client, error) {
+	authBaseURL := authURL
+	if strings.TrimSpace(authBaseURL) == "" {
+		authBaseURL = c.inscriberAuthURL
+	}
+	apiBaseURL := apiURL
+	if strings.TrimSpace(apiBaseURL) == "" {
+		apiBaseURL = c.inscriberAPIURL
+	}
+
+	authClient := inscriber.NewAuthClient(authBaseURL)
+	if c.operatorSigner == nil {
+		return nil, fmt.Errorf("operator private key or signer is required for inscriber-backed hashinal creation")
+	}
+	authResult, err := authClient.AuthenticateWithSigner(
+		ctx,
+		c.operatorAccountID.String(),
+		c.operatorSigner,
+		network,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate inscriber client: %w", err)
+	}
+
+	return inscriber.NewClient(inscriber.Config{
+		APIKey:  authResult.APIKey,
+		Network: network,
+		BaseURL: apiBaseURL,
+	})
+}
@@ -0,0 +1,377 @@
+package hcs5
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+const (
+	MaxMintBatchSize = 10
+
+	maxTokenMemoBytes = 100
+	maxCustomFees     = 10
+
+	defaultCollectionConcurrency = 4
+	defaultCollectionRetries     = 2
+	defaultCollectionRetryDelay  = 2 * time.Second
+)
+
+// CreateHashinalCollection creates the non-fungible token that Hashinals are
+// minted into and returns its token ID, ready for Mint and MintCollection.
+//
+// The operator is the default treasury, auto-renew account and key holder.
+// Only royalty and fixed custom fees are offered because Hedera rejects
+// fractional fees on non-fungible tokens.
+func (c *Client) CreateHashinalCollection(
+	ctx context.Context,
+	options CreateHashinalCollectionOptions,
+) (CreateHashinalCollectionResult, error) {
+	treasuryAccountID := c.operatorAccountID
+	if strings.TrimSpace(options.TreasuryAccountID) != "" {
+		parsed, err := hedera.AccountIDFromString(strings.TrimSpace(options.TreasuryAccountID))
+		if err != nil {
+			return CreateHashinalCollectionResult{}, fmt.Errorf("invalid treasury account ID: %w", err)
+		}
+		treasuryAccountID = parsed
+	}
+	autoRenewAccountID := c.operatorAccountID
+	if strings.TrimSpace(options.AutoRenewAccountID) != "" {
+		parsed, err := hedera.AccountIDFromString(strings.TrimSpace(options.AutoRenewAccountID))
+		if err != nil {
+			return CreateHashinalCollectionResult{}, fmt.Errorf("invalid auto-renew account ID: %w", err)
+		}
+		autoRenewAccountID = parsed
+	}
+
+	supplyKey, err := c.resolveCollectionKey("supply", options.SupplyKey, true)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, err
+	}
+	adminKey, err := c.resolveCollectionKey("admin", options.AdminKey, !options.OmitAdminKey)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, err
+	}
+	metadataKey, err := c.resolveCollectionKey("metadata", options.MetadataKey, !options.OmitMetadataKey)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, err
+	}
+	feeScheduleKey, err := c.resolveCollectionKey("fee schedule", options.FeeScheduleKey, false)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, err
+	}
+
+	metadata := options.Metadata
+	if strings.TrimSpace(metadata) == "" && strings.TrimSpace(options.MetadataTopicID) != "" {
+		metadata = BuildHCS1HRL(strings.TrimSpace(options.MetadataTopicID))
+	}
+
+	signingKeys := make([]hedera.PrivateKey, 0, len(options.SigningKeys))
+	for _, raw := range options.SigningKeys {
+		parsed, parseErr := shared.ParsePrivateKey(raw)
+		if parseErr != nil {
+			return CreateHashinalCollectionResult{}, parseErr
+		}
+		signingKeys = append(signingKeys, parsed)
+	}
+
+	transaction, err := BuildCreateHashinalCollectionTx(CreateHashinalCollectionTxParams{
+		Name:               options.Name,
+		Symbol:             options.Symbol,
+		Memo:               options.Memo,
+		MaxSupply:          options.MaxSupply,
+		TreasuryAccountID:  treasuryAccountID,
+		AutoRenewAccountID: &autoRenewAccountID,
+		SupplyKey:          supplyKey,
+		AdminKey:           adminKey,
+		MetadataKey:        metadataKey,
+		FeeScheduleKey:     feeScheduleKey,
+		Metadata:           metadata,
+		RoyaltyFees:        options.RoyaltyFees,
+		FixedFees:          options.FixedFees,
+		TransactionMemo:    options.TransactionMemo,
+	})
+	if err != nil {
+		return CreateHashinalCollectionResult{}, err
+	}
+
+	frozenTransaction, err := transaction.FreezeWith(c.hederaClient)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, fmt.Errorf("failed to freeze token create transaction: %w", err)
+	}
+	for _, signingKey := range signingKeys {
+		frozenTransaction = frozenTransaction.Sign(signingKey)
+	}
+
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozenTransaction)
+	if err != nil {
+		return CreateHashinalCollectionResult{}, fmt.Errorf("failed to execute token create transaction: %w", err)
+	}
+	receipt := executed.Receipt
+	if receipt.Status != hedera.StatusSuccess {
+		return CreateHashinalCollectionResult{}, fmt.Errorf("token create transaction failed with status %s", receipt.Status.String())
+	}
+	if receipt.TokenID == nil {
+		return CreateHashinalCollectionResult{}, fmt.Errorf("token create receipt did not include token ID")
+	}
+
+	return CreateHashinalCollectionResult{
+		TokenID:       receipt.TokenID.String(),
+		TransactionID: executed.TransactionID,
+	}, nil
+}
+
+func (c *Client) resolveCollectionKey(name string, raw string, useOperatorByDefault bool) (hedera.Key, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed != "" {
+		publicKey, err := hedera.PublicKeyFromString(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s key: %w", name, err)
+		}
+		return publicKey, nil
+	}
+	if !useOperatorByDefault {
+		return nil, nil
+	}
+	if strings.TrimSpace(c.operatorPublicKey.String()) == "" {
+		return nil, fmt.Errorf("operator public key is unavailable for the %s key", name)
+	}
+	return c.operatorPublicKey, nil
+}
+
+type inscribeItemFunc func(ctx context.Context, input inscriber.InscriptionInput) (topicID string, transactionID string, err error)
+
+type mintBatchFunc func(ctx context.Context, tokenID string, topicIDs []string) (serials []int64, transactionID string, err error)
+
+// MintCollection inscribes every input as a hashinal and mints one serial per
+// inscription, packing up to MaxMintBatchSize metadata entries into each
+// TokenMintTransaction.
+//
+// Inscriptions run concurrently and are retried. Each mint batch is submitted
+// once through the client's Executor, whose retry policy alone decides when a
+// precheck rejection is resubmitted; MaxRetries applies to inscriptions only.
+// Item failures
+// are recorded in the returned manifest, with the mint transaction ID, rather
+// than aborting the collection; the error is reserved for setup failures.
+func (c *Client) MintCollection(
+	ctx context.Context,
+	tokenID string,
+	inputs []inscriber.InscriptionInput,
+	options MintCollectionOptions,
+) (MintCollectionResult, error) {
+	if _, err := hedera.TokenIDFromString(strings.TrimSpace(tokenID)); err != nil {
+		return MintCollectionResult{}, fmt.Errorf("invalid token ID: %w", err)
+	}
+	if len(inputs) == 0 {
+		return MintCollectionResult{}, fmt.Errorf("at least one inscription input is required")
+	}
+
+	inscriptionOptions := options.InscriptionOptions
+	inscriptionOptions.Network = c.resolveInscriberNetwork(inscriptionOptions.Network)
+	if inscriptionOptions.Mode == "" {
+		inscriptionOptions.Mode = inscriber.ModeHashinal
+	}
+	waitForConfirmation := true
+	inscriptionOptions.WaitForConfirmation = &waitForConfirmation
+
+	inscriberClient, err := c.newInscriberClient(
+		ctx,
+		inscriptionOptions.Network,
+		options.InscriberAuthURL,
+		options.InscriberAPIURL,
+	)
+	if err != nil {
+		return MintCollectionResult{}, err
+	}
+	clientConfig := inscriber.HederaClientConfig{
+		AccountID: c.operatorAccountID.String(),
+		Signer:    c.operatorSigner,
+		Network:   inscriptionOptions.Network,
+	}
+
+	var supplyKey *hedera.PrivateKey
+	if strings.TrimSpace(options.SupplyKey) != "" {
+		parsed, parseErr := shared.ParsePrivateKey(options.SupplyKey)
+		if parseErr != nil {
+			return MintCollectionResult{}, parseErr
+		}
+		supplyKey = &parsed
+	}
+
+	inscribe := func(ctx context.Context, input inscriber.InscriptionInput) (string, string, error) {
+		response, err := inscriber.Inscribe(ctx, input, clientConfig, inscriptionOptions, inscriberClient)
+		if err != nil {
+			return "", "", err
+		}
+		result, _ := response.Result.(inscriber.InscriptionResult)
+		if !response.Confirmed {
+			return "", result.TransactionID, fmt.Errorf("inscription did not complete successfully")
+		}
+		if strings.TrimSpace(result.TopicID) == "" {
+			return "", result.TransactionID, fmt.Errorf("inscription completion did not include topic ID")
+		}
+		return result.TopicID, result.TransactionID, nil
+	}
+	mintBatch := func(ctx context.Context, tokenID string, topicIDs []string) ([]int64, string, error) {
+		return c.mintBatch(ctx, tokenID, topicIDs, supplyKey, options.Memo)
+	}
+
+	return mintCollection(ctx, tokenID, inputs, options, inscribe, mintBatch), nil
+}
+
+func mintCollection(
+	ctx context.Context,
+	tokenID string,
+	inputs []inscriber.InscriptionInput,
+	options MintCollectionOptions,
+	inscribe inscribeItemFunc,
+	mintBatch mintBatchFunc,
+) MintCollectionResult {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultCollectionConcurrency
+	}
+	maxRetries := options.MaxRetries
+	if maxRetries < 0 {
+		maxRetries = 0
+	} else if maxRetries == 0 {
+		maxRetries = defaultCollectionRetries
+	}
+	retryDelay := options.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = defaultCollectionRetryDelay
+	}
+	batchSize := options.BatchSize
+	if batchSize <= 0 || batchSize > MaxMintBatchSize {
+		batchSize = MaxMintBatchSize
+	}
+
+	items := make([]CollectionItemResult, len(inputs))
+	for index, input := range inputs {
+		items[index] = CollectionItemResult{Index: index, FileName: input.FileName}
+	}
+
+	jobs := make(chan int)
+	var workers sync.WaitGroup
+	for worker := 0; worker < concurrency && worker < len(inputs); worker++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for index := range jobs {
+				item := &items[index]
+				for attempt := 0; attempt <= maxRetries; attempt++ {
+					if attempt > 0 && !sleepContext(ctx, retryDelay) {
+						break
+					}
+					item.InscriptionAttempts++
+					topicID, transactionID, err := inscribe(ctx, inputs[index])
+					item.InscriptionTransactionID = transactionID
+					if err == nil {
+						item.TopicID = topicID
+						item.HRL = BuildHCS1HRL(topicID)
+						item.Error = ""
+						break
+					}
+					item.Error = fmt.Sprintf("inscription failed: %v", err)
+				}
+			}
+		}()
+	}
+	for index := range inputs {
+		jobs <- index
+	}
+	close(jobs)
+	workers.Wait()
+
+	pending := make([]int, 0, len(items))
+	for index := range items {
+		if items[index].TopicID != "" {
+			pending = append(pending, index)
+		}
+	}
+
+	for start := 0; start < len(pending); start += batchSize {
+		end := min(start+batchSize, len(pending))
+		batch := pending[start:end]
+		topicIDs := make([]string, 0, len(batch))
+		for _, index := range batch {
+			topicIDs = append(topicIDs, items[index].TopicID)
+		}
+
+		serials, transactionID, err := mintBatch(ctx, tokenID, topicIDs)
+		if err == nil && len(serials) != len(batch) {
+			err = fmt.Errorf("mint receipt returned %d serials for %d metadata entries", len(serials), len(batch))
+		}
+
+		for position, index := range batch {
+			item := &items[index]
+			item.MintAttempts = 1
+			item.TransactionID = transactionID
+			if err != nil {
+				item.Error = fmt.Sprintf("mint failed: %v", err)
+				continue
+			}
+			item.SerialNumber = serials[position]
+			item.Success = true
+		}
+	}
+
+	result := MintCollectionResult{TokenID: strings.TrimSpace(tokenID), Items: items}
+	for _, item := range items {
+		if item.Success {
+			result.Minted++
+		} else {
+			result.Failed++
+		}
+	}
+	return result
+}
+
+func (c *Client) mintBatch(
+	ctx context.Context,
+	tokenID string,
+	topicIDs []string,
+	supplyKey *hedera.PrivateKey,
+	memo string,
+) ([]int64, string, error) {
+	transaction, err := BuildBatchMintWithHRLTx(tokenID, topicIDs, memo)
+	if err != nil {
+		return nil, "", err
+	}
+	frozenTransaction, err := transaction.FreezeWith(c.hederaClient)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to freeze mint transaction: %w", err)
+	}
+	if supplyKey != nil {
+		frozenTransaction = frozenTransaction.Sign(*supplyKey)
+	}
+	transactionID := frozenTransaction.GetTransactionID().String()
+
+	executed, err := c.executor.Execute(ctx, c.hederaClient, frozenTransaction)
+	if err != nil {
+		return nil, transactionID, fmt.Errorf("failed to execute mint transaction: %w", err)
+	}
+	receipt := executed.Receipt
+	if receipt.Status != hedera.StatusSuccess {
+		return nil, executed.TransactionID, fmt.Errorf("mint transaction failed with status %s", receipt.Status.String())
+	}
+	return receipt.SerialNumbers, executed.TransactionID, nil
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,103 @@
+package hcs5
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+func TestMintCollectionBatchesAndRetriesInscriptions(t *testing.T) {
+	inputs := make([]inscriber.InscriptionInput, 23)
+	for index := range inputs {
+		inputs[index] = inscriber.InscriptionInput{FileName: fmt.Sprintf("%d.png", index)}
+	}
+
+	var mutex sync.Mutex
+	inscribeCalls := map[string]int{}
+	inscribe := func(_ context.Context, input inscriber.InscriptionInput) (string, string, error) {
+		mutex.Lock()
+		inscribeCalls[input.FileName]++
+		calls := inscribeCalls[input.FileName]
+		mutex.Unlock()
+
+		switch {
+		case input.FileName == "3.png" && calls == 1:
+			return "", "", fmt.Errorf("transient")
+		case input.FileName == "7.png":
+			return "", "", fmt.Errorf("permanent")
+		}
+		var index int
+		fmt.Sscanf(input.FileName, "%d.png", &index)
+		return fmt.Sprintf("0.0.%d", 1000+index), "0.0.1@1.1", nil
+	}
+
+	batchSizes := []int{}
+	nextSerial := int64(1)
+	mintCalls := 0
+	mintBatch := func(_ context.Context, tokenID string, topicIDs []string) ([]int64, string, error) {
+		mintCalls++
+		switch mintCalls {
+		case 3:
+			return nil, "0.0.1@3.0", &shared.TransactionStatusError{Status: hedera.StatusBusy, Precheck: true}
+		}
+		batchSizes = append(batchSizes, len(topicIDs))
+		serials := make([]int64, len(topicIDs))
+		for index := range serials {
+			serials[index] = nextSerial
+			nextSerial++
+		}
+		return serials, fmt.Sprintf("0.0.1@%d.0", mintCalls), nil
+	}
+
+	result := mintCollection(context.Background(), "0.0.55", inputs, MintCollectionOptions{
+		Concurrency: 5,
+		RetryDelay:  time.Millisecond,
+	}, inscribe, mintBatch)
+
+	if result.Minted != 20 || result.Failed != 3 {
+		t.Fatalf("unexpected totals: minted=%d failed=%d", result.Minted, result.Failed)
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != 10 || batchSizes[1] != 10 {
+		t.Fatalf("unexpected batch sizes: %v", batchSizes)
+	}
+
+	retried := result.Items[3]
+	if !retried.Success || retried.InscriptionAttempts != 2 || retried.HRL != "hcs://1/0.0.1003" {
+		t.Fatalf("unexpected retried item: %+v", retried)
+	}
+	failed := result.Items[7]
+	if failed.Success || failed.InscriptionAttempts != 3 || failed.Error == "" {
+		t.Fatalf("unexpected failed item: %+v", failed)
+	}
+	if result.Items[0].SerialNumber != 1 || result.Items[0].TransactionID != "0.0.1@1.0" {
+		t.Fatalf("unexpected first item: %+v", result.Items[0])
+	}
+	if result.Items[12].MintAttempts != 1 || result.Items[12].TransactionID != "0.0.1@2.0" {
+		t.Fatalf("unexpected second batch item: %+v", result.Items[12])
+	}
+	if last := result.Items[22]; last.Success || last.MintAttempts != 1 || last.TransactionID != "0.0.1@3.0" {
+		t.Fatalf("expected a failed batch not to be resubmitted locally: %+v", last)
+	}
+}
+
+func TestMintCollectionValidation(t *testing.T) {
+	pk, _ := hedera.PrivateKeyGenerateEcdsa()
+	client, _ := NewClient(ClientConfig{
+		Network:            "testnet",
+		OperatorAccountID:  "0.0.1",
+		OperatorPrivateKey: pk.String(),
+	})
+	if _, err := client.MintCollection(context.Background(), "invalid", []inscriber.InscriptionInput{{}}, MintCollectionOptions{}); err == nil {
+		t.Fatal("expected invalid token error")
+	}
+	if _, err := client.MintCollection(context.Background(), "0.0.55", nil, MintCollectionOptions{}); err == nil {
+		t.Fatal("expected missing inputs error")
+	}
+}
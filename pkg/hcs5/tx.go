@@ -42,3 +42,160 @@ func BuildMintWithHRLTx(
 
 	return BuildMintTx(tokenID, BuildHCS1HRL(trimmedTopicID), transactionMemo)
 }
+
+// BuildBatchMintWithHRLTx builds a mint transaction carrying one HRL per
+// metadata topic, up to MaxMintBatchSize serials.
+func BuildBatchMintWithHRLTx(
+	tokenID string,
+	metadataTopicIDs []string,
+	transactionMemo string,
+) (*hedera.TokenMintTransaction, error) {
+	if len(metadataTopicIDs) == 0 {
+		return nil, fmt.Errorf("at least one metadata topic ID is required")
+	}
+	if len(metadataTopicIDs) > MaxMintBatchSize {
+		return nil, fmt.Errorf("a mint transaction supports at most %d metadata entries", MaxMintBatchSize)
+	}
+
+	metadata := make([][]byte, 0, len(metadataTopicIDs))
+	for _, topicID := range metadataTopicIDs {
+		trimmedTopicID := strings.TrimSpace(topicID)
+		if trimmedTopicID == "" {
+			return nil, fmt.Errorf("metadata topic ID is required")
+		}
+		metadata = append(metadata, []byte(BuildHCS1HRL(trimmedTopicID)))
+	}
+
+	transaction, err := BuildMintTx(tokenID, "", transactionMemo)
+	if err != nil {
+		return nil, err
+	}
+	transaction.SetMetadatas(metadata)
+	return transaction, nil
+}
+
+// BuildCreateHashinalCollectionTx builds a non-fungible token create
+// transaction for a Hashinal collection: zero decimals, zero initial supply,
+// and a finite supply only when MaxSupply is set.
+func BuildCreateHashinalCollectionTx(
+	params CreateHashinalCollectionTxParams,
+) (*hedera.TokenCreateTransaction, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return nil, fmt.Errorf("collection name is required")
+	}
+	symbol := strings.TrimSpace(params.Symbol)
+	if symbol == "" {
+		return nil, fmt.Errorf("collection symbol is required")
+	}
+	if params.SupplyKey == nil {
+		return nil, fmt.Errorf("supply key is required to mint hashinals")
+	}
+	if params.MaxSupply < 0 {
+		return nil, fmt.Errorf("max supply must not be negative")
+	}
+	if len(params.Memo) > maxTokenMemoBytes {
+		return nil, fmt.Errorf("token memo must not exceed %d bytes", maxTokenMemoBytes)
+	}
+
+	customFees, err := buildCollectionCustomFees(params.RoyaltyFees, params.FixedFees)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := hedera.NewTokenCreateTransaction().
+		SetTokenName(name).
+		SetTokenSymbol(symbol).
+		SetTokenType(hedera.TokenTypeNonFungibleUnique).
+		SetDecimals(0).
+		SetInitialSupply(0).
+		SetTreasuryAccountID(params.TreasuryAccountID).
+		SetSupplyKey(params.SupplyKey)
+
+	if params.MaxSupply > 0 {
+		transaction.SetSupplyType(hedera.TokenSupplyTypeFinite).SetMaxSupply(params.MaxSupply)
+	} else {
+		transaction.SetSupplyType(hedera.TokenSupplyTypeInfinite)
+	}
+	if params.Memo != "" {
+		transaction.SetTokenMemo(params.Memo)
+	}
+	if strings.TrimSpace(params.Metadata) != "" {
+		transaction.SetTokenMetadata([]byte(params.Metadata))
+	}
+	if params.AutoRenewAccountID != nil {
+		transaction.SetAutoRenewAccount(*params.AutoRenewAccountID)
+	}
+	if params.AdminKey != nil {
+		transaction.SetAdminKey(params.AdminKey)
+	}
+	if params.MetadataKey != nil {
+		transaction.SetMetadataKey(params.MetadataKey)
+	}
+	if params.FeeScheduleKey != nil {
+		transaction.SetFeeScheduleKey(params.FeeScheduleKey)
+	}
+	if len(customFees) > 0 {
+		transaction.SetCustomFees(customFees)
+	}
+	if strings.TrimSpace(params.TransactionMemo) != "" {
+		transaction.SetTransactionMemo(params.TransactionMemo)
+	}
+	return transaction, nil
+}
+
+func buildCollectionCustomFees(royaltyFees []RoyaltyFee, fixedFees []FixedFee) ([]hedera.Fee, error) {
+	if len(royaltyFees)+len(fixedFees) > maxCustomFees {
+		return nil, fmt.Errorf("a token supports at most %d custom fees", maxCustomFees)
+	}
+
+	fees := make([]hedera.Fee, 0, len(royaltyFees)+len(fixedFees))
+	for index, fee := range royaltyFees {
+		if fee.Numerator <= 0 || fee.Denominator <= 0 || fee.Numerator > fee.Denominator {
+			return nil, fmt.Errorf("royalty fee %d must be a fraction between 0 and 1", index)
+		}
+		if fee.FallbackHbarTinybars < 0 {
+			return nil, fmt.Errorf("royalty fee %d fallback must not be negative", index)
+		}
+		collector, err := hedera.AccountIDFromString(strings.TrimSpace(fee.CollectorAccountID))
+		if err != nil {
+			return nil, fmt.Errorf("invalid royalty fee %d collector account ID: %w", index, err)
+		}
+
+		royalty := hedera.NewCustomRoyaltyFee().
+			SetNumerator(fee.Numerator).
+			SetDenominator(fee.Denominator).
+			SetFeeCollectorAccountID(collector).
+			SetAllCollectorsAreExempt(fee.AllCollectorsAreExempt)
+		if fee.FallbackHbarTinybars > 0 {
+			royalty.SetFallbackFee(hedera.NewCustomFixedFee().
+				SetAmount(fee.FallbackHbarTinybars).
+				SetFeeCollectorAccountID(collector))
+		}
+		fees = append(fees, *royalty)
+	}
+
+	for index, fee := range fixedFees {
+		if fee.Amount <= 0 {
+			return nil, fmt.Errorf("fixed fee %d amount must be positive", index)
+		}
+		collector, err := hedera.AccountIDFromString(strings.TrimSpace(fee.CollectorAccountID))
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixed fee %d collector account ID: %w", index, err)
+		}
+
+		fixed := hedera.NewCustomFixedFee().
+			SetAmount(fee.Amount).
+			SetFeeCollectorAccountID(collector).
+			SetAllCollectorsAreExempt(fee.AllCollectorsAreExempt)
+		if strings.TrimSpace(fee.DenominatingTokenID) != "" {
+			denominatingTokenID, err := hedera.TokenIDFromString(strings.TrimSpace(fee.DenominatingTokenID))
+			if err != nil {
+				return nil, fmt.Errorf("invalid fixed fee %d denominating token ID: %w", index, err)
+			}
+			fixed.SetDenominatingTokenID(denominatingTokenID)
+		}
+		fees = append(fees, *fixed)
+	}
+	return fees, nil
+}
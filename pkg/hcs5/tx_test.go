@@ -2,6 +2,8 @@ package hcs5
 
 import (
 	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 )
 
 func TestBuildHCS1HRL(t *testing.T) {
@@ -30,3 +32,96 @@ func TestBuildMintWithHRLTx(t *testing.T) {
 		t.Fatalf("unexpected metadata: %s", string(metadata[0]))
 	}
 }
+
+func TestBuildBatchMintWithHRLTx(t *testing.T) {
+	transaction, err := BuildBatchMintWithHRLTx("0.0.55", []string{"0.0.1", "0.0.2"}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	metadata := transaction.GetMetadatas()
+	if len(metadata) != 2 || string(metadata[1]) != "hcs://1/0.0.2" {
+		t.Fatalf("unexpected metadata: %q", metadata)
+	}
+
+	tooMany := make([]string, MaxMintBatchSize+1)
+	for index := range tooMany {
+		tooMany[index] = "0.0.1"
+	}
+	if _, err := BuildBatchMintWithHRLTx("0.0.55", tooMany, ""); err == nil {
+		t.Fatal("expected batch size error")
+	}
+	if _, err := BuildBatchMintWithHRLTx("0.0.55", nil, ""); err == nil {
+		t.Fatal("expected empty batch error")
+	}
+}
+
+func TestBuildCreateHashinalCollectionTx(t *testing.T) {
+	key, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	params := CreateHashinalCollectionTxParams{
+		Name:              "Hashinals",
+		Symbol:            "HASH",
+		MaxSupply:         100,
+		TreasuryAccountID: hedera.AccountID{Account: 1001},
+		SupplyKey:         key.PublicKey(),
+		MetadataKey:       key.PublicKey(),
+		Metadata:          BuildHCS1HRL("0.0.42"),
+		RoyaltyFees: []RoyaltyFee{{
+			Numerator: 5, Denominator: 100, FallbackHbarTinybars: 100000000, CollectorAccountID: "0.0.1001",
+		}},
+		FixedFees: []FixedFee{{Amount: 10, CollectorAccountID: "0.0.1002"}},
+	}
+
+	transaction, err := BuildCreateHashinalCollectionTx(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transaction.GetTokenType() != hedera.TokenTypeNonFungibleUnique {
+		t.Fatalf("unexpected token type: %v", transaction.GetTokenType())
+	}
+	if transaction.GetSupplyType() != hedera.TokenSupplyTypeFinite || transaction.GetMaxSupply() != 100 {
+		t.Fatalf("unexpected supply: %v %d", transaction.GetSupplyType(), transaction.GetMaxSupply())
+	}
+	if transaction.GetDecimals() != 0 || transaction.GetInitialSupply() != 0 {
+		t.Fatal("expected zero decimals and initial supply")
+	}
+	if string(transaction.GetTokenMetadata()) != "hcs://1/0.0.42" {
+		t.Fatalf("unexpected token metadata: %s", transaction.GetTokenMetadata())
+	}
+	if transaction.GetAdminKey() != nil {
+		t.Fatal("expected no admin key")
+	}
+	if len(transaction.GetCustomFees()) != 2 {
+		t.Fatalf("expected two custom fees, got %d", len(transaction.GetCustomFees()))
+	}
+
+	params.MaxSupply = 0
+	transaction, err = BuildCreateHashinalCollectionTx(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transaction.GetSupplyType() != hedera.TokenSupplyTypeInfinite {
+		t.Fatalf("expected infinite supply, got %v", transaction.GetSupplyType())
+	}
+
+	invalid := params
+	invalid.SupplyKey = nil
+	if _, err := BuildCreateHashinalCollectionTx(invalid); err == nil {
+		t.Fatal("expected missing supply key error")
+	}
+	invalid = params
+	invalid.RoyaltyFees = []RoyaltyFee{{Numerator: 2, Denominator: 1, CollectorAccountID: "0.0.1001"}}
+	if _, err := BuildCreateHashinalCollectionTx(invalid); err == nil {
+		t.Fatal("expected royalty fraction error")
+	}
+	invalid = params
+	invalid.FixedFees = make([]FixedFee, maxCustomFees)
+	for index := range invalid.FixedFees {
+		invalid.FixedFees[index] = FixedFee{Amount: 1, CollectorAccountID: "0.0.1002"}
+	}
+	if _, err := BuildCreateHashinalCollectionTx(invalid); err == nil {
+		t.Fatal("expected custom fee limit error")
+	}
+}
@@ -1,18 +1,24 @@
 package hcs5
 
 import (
+	"time"
+
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 
 	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	InscriberAuthURL   string
 	InscriberAPIURL    string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type MintOptions struct {
@@ -41,6 +47,110 @@ type MintResponse struct {
 	Error         string
 }
 
+// MintCollectionOptions configures MintCollection. MaxRetries bounds
+// inscription retries; zero uses the default and a negative value disables
+// them. Mint retries follow the client's Executor.
+type MintCollectionOptions struct {
+	SupplyKey          string
+	Memo               string
+	Concurrency        int
+	BatchSize          int
+	MaxRetries         int
+	RetryDelay         time.Duration
+	InscriptionOptions inscriber.InscriptionOptions
+	InscriberAuthURL   string
+	InscriberAPIURL    string
+}
+
+type CollectionItemResult struct {
+	Index                    int    `json:"index"`
+	FileName                 string `json:"fileName,omitempty"`
+	Success                  bool   `json:"success"`
+	SerialNumber             int64  `json:"serialNumber,omitempty"`
+	TopicID                  string `json:"topicId,omitempty"`
+	HRL                      string `json:"hrl,omitempty"`
+	TransactionID            string `json:"transactionId,omitempty"`
+	InscriptionTransactionID string `json:"inscriptionTransactionId,omitempty"`
+	InscriptionAttempts      int    `json:"inscriptionAttempts"`
+	MintAttempts             int    `json:"mintAttempts"`
+	Error                    string `json:"error,omitempty"`
+}
+
+type MintCollectionResult struct {
+	TokenID string                 `json:"tokenId"`
+	Items   []CollectionItemResult `json:"items"`
+	Minted  int                    `json:"minted"`
+	Failed  int                    `json:"failed"`
+}
+
+// CreateHashinalCollectionOptions configures CreateHashinalCollection.
+//
+// Key fields take public key strings. The supply, admin and metadata keys
+// default to the operator key unless the matching Omit flag is set; the fee
+// schedule key is only set when provided. SigningKeys are private keys for a
+// non-operator treasury or admin key, which must sign the create.
+type CreateHashinalCollectionOptions struct {
+	Name               string
+	Symbol             string
+	Memo               string
+	MaxSupply          int64
+	TreasuryAccountID  string
+	AutoRenewAccountID string
+	SupplyKey          string
+	AdminKey           string
+	MetadataKey        string
+	FeeScheduleKey     string
+	OmitAdminKey       bool
+	OmitMetadataKey    bool
+	Metadata           string
+	MetadataTopicID    string
+	RoyaltyFees        []RoyaltyFee
+	FixedFees          []FixedFee
+	SigningKeys        []string
+	TransactionMemo    string
+}
+
+// RoyaltyFee charges Numerator/Denominator of the fungible value exchanged for
+// an NFT. FallbackHbarTinybars is charged when no fungible value is exchanged.
+type RoyaltyFee struct {
+	Numerator              int64
+	Denominator            int64
+	FallbackHbarTinybars   int64
+	CollectorAccountID     string
+	AllCollectorsAreExempt bool
+}
+
+// FixedFee charges Amount per transfer, in tinybars unless DenominatingTokenID
+// is set.
+type FixedFee struct {
+	Amount                 int64
+	DenominatingTokenID    string
+	CollectorAccountID     string
+	AllCollectorsAreExempt bool
+}
+
+type CreateHashinalCollectionTxParams struct {
+	Name               string
+	Symbol             string
+	Memo               string
+	MaxSupply          int64
+	TreasuryAccountID  hedera.AccountID
+	AutoRenewAccountID *hedera.AccountID
+	SupplyKey          hedera.Key
+	AdminKey           hedera.Key
+	MetadataKey        hedera.Key
+	FeeScheduleKey     hedera.Key
+	Metadata           string
+	RoyaltyFees        []RoyaltyFee
+	FixedFees          []FixedFee
+	TransactionMemo    string
+}
+
+type CreateHashinalCollectionResult struct {
+	TokenID       string `json:"tokenId"`
+	TransactionID string `json:"transactionId"`
+}
+
 // BuildHCS1HRL builds and returns the configured value.
 func BuildHCS1HRL(topicID string) string {
 	return "hcs://1/" + topicID
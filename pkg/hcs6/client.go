@@ -14,10 +14,15 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
 	operatorKey       hedera.PrivateKey
+	operatorSigner    shared.Signer
+	network           string
+	inscriberAuthURL  string
+	inscriberAPIURL   string
 }
 
 // NewClient creates a new Client.
@@ -26,11 +31,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -47,10 +53,15 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
 		operatorKey:       operator.PrivateKey,
+		operatorSigner:    operator.Signer,
+		network:           network,
+		inscriberAuthURL:  strings.TrimSpace(config.InscriberAuthURL),
+		inscriberAPIURL:   strings.TrimSpace(config.InscriberAPIURL),
 	}, nil
 }
 
@@ -61,8 +72,6 @@ func (c *Client) MirrorClient() *mirror.Client {
 
 // CreateRegistry creates the requested resource.
 func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptions) (CreateRegistryResult, error) {
-	_ = ctx
-
 	ttl := options.TTL
 	if ttl <= 0 {
 		ttl = DefaultTTL
@@ -78,14 +87,11 @@ func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptio
 		MemoOverride: "",
 	})
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return CreateRegistryResult{}, fmt.Errorf("failed to execute create topic transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return CreateRegistryResult{}, fmt.Errorf("failed to get create topic receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return CreateRegistryResult{}, fmt.Errorf("topic ID missing in create topic receipt")
 	}
@@ -93,7 +99,7 @@ func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptio
 	return CreateRegistryResult{
 		Success:       true,
 		TopicID:       receipt.TopicID.String(),
-		TransactionID: response.TransactionID.String(),
+		TransactionID: executed.TransactionID,
 	}, nil
 }
 
@@ -103,8 +109,6 @@ func (c *Client) RegisterEntry(
 	registryTopicID string,
 	options RegisterEntryOptions,
 ) (OperationResult, error) {
-	_ = ctx
-
 	analyticsMemo := strings.TrimSpace(options.AnalyticsMemo)
 	if analyticsMemo == "" {
 		analyticsMemo = BuildTransactionMemo()
@@ -120,18 +124,15 @@ func (c *Client) RegisterEntry(
 		return OperationResult{}, err
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return OperationResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return OperationResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
-	}
+	receipt := executed.Receipt
 
 	return OperationResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
+		TransactionID:  executed.TransactionID,
 		SequenceNumber: int64(receipt.TopicSequenceNumber),
 	}, nil
 }
@@ -143,7 +144,6 @@ func (c *Client) SubmitMessage(
 	message Message,
 	transactionMemo string,
 ) (OperationResult, error) {
-	_ = ctx
 	if err := ValidateMessage(message); err != nil {
 		return OperationResult{}, err
 	}
@@ -164,31 +164,82 @@ func (c *Client) SubmitMessage(
 		transaction.SetTransactionMemo(strings.TrimSpace(transactionMemo))
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return OperationResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return OperationResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
-	}
+	receipt := executed.Receipt
 
 	return OperationResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
+		TransactionID:  executed.TransactionID,
 		SequenceNumber: int64(receipt.TopicSequenceNumber),
 	}, nil
 }
 
 // GetRegistry returns the requested value.
 func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryRegistryOptions) (TopicRegistry, error) {
-	topicInfo, err := c.mirrorClient.GetTopicInfo(ctx, topicID)
+	entries, parsedMemo, err := c.readRegistryEntries(ctx, topicID, options)
 	if err != nil {
 		return TopicRegistry{}, err
 	}
+
+	var latestEntry *RegistryEntry
+	for _, entry := range entries {
+		if latestEntry == nil || entry.Timestamp > latestEntry.Timestamp {
+			copyEntry := entry
+			latestEntry = &copyEntry
+		}
+	}
+
+	resolvedEntries := make([]RegistryEntry, 0, 1)
+	if latestEntry != nil {
+		resolvedEntries = append(resolvedEntries, *latestEntry)
+	}
+
+	return TopicRegistry{
+		TopicID:      topicID,
+		RegistryType: RegistryTypeNonIndexed,
+		TTL:          parsedMemo.TTL,
+		Entries:      resolvedEntries,
+		LatestEntry:  latestEntry,
+	}, nil
+}
+
+// History returns every target the registry has pointed to, oldest first.
+func (c *Client) History(ctx context.Context, registryTopicID string) ([]HistoryEntry, error) {
+	entries, _, err := c.readRegistryEntries(ctx, strings.TrimSpace(registryTopicID), QueryRegistryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]HistoryEntry, 0, len(entries))
+	for index, entry := range entries {
+		history = append(history, HistoryEntry{
+			Version:            index + 1,
+			TopicID:            entry.Message.TopicID,
+			HRL:                BuildHCS1HRL(entry.Message.TopicID),
+			Memo:               entry.Message.Memo,
+			Sequence:           entry.Sequence,
+			ConsensusTimestamp: entry.ConsensusTimestamp,
+			Payer:              entry.Payer,
+		})
+	}
+	return history, nil
+}
+
+func (c *Client) readRegistryEntries(
+	ctx context.Context,
+	topicID string,
+	options QueryRegistryOptions,
+) ([]RegistryEntry, *TopicMemo, error) {
+	topicInfo, err := c.mirrorClient.GetTopicInfo(ctx, topicID)
+	if err != nil {
+		return nil, nil, err
+	}
 	parsedMemo, ok := ParseTopicMemo(topicInfo.Memo)
 	if !ok {
-		return TopicRegistry{}, fmt.Errorf("topic %s is not an HCS-6 registry", topicID)
+		return nil, nil, fmt.Errorf("topic %s is not an HCS-6 registry", topicID)
 	}
 
 	order := strings.TrimSpace(options.Order)
@@ -207,11 +258,10 @@ func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryR
 		Order:          order,
 	})
 	if err != nil {
-		return TopicRegistry{}, err
+		return nil, nil, err
 	}
 
 	entries := make([]RegistryEntry, 0, len(messages))
-	var latestEntry *RegistryEntry
 	for _, item := range messages {
 		var message Message
 		if err := mirror.DecodeMessageJSON(item, &message); err != nil {
@@ -221,7 +271,7 @@ func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryR
 			continue
 		}
 
-		entry := RegistryEntry{
+		entries = append(entries, RegistryEntry{
 			TopicID:            topicID,
 			Sequence:           item.SequenceNumber,
 			Timestamp:          item.ConsensusTimestamp,
@@ -229,26 +279,9 @@ func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryR
 			Message:            message,
 			ConsensusTimestamp: item.ConsensusTimestamp,
 			RegistryType:       RegistryTypeNonIndexed,
-		}
-		entries = append(entries, entry)
-		if latestEntry == nil || entry.Timestamp > latestEntry.Timestamp {
-			copyEntry := entry
-			latestEntry = &copyEntry
-		}
-	}
-
-	resolvedEntries := make([]RegistryEntry, 0, 1)
-	if latestEntry != nil {
-		resolvedEntries = append(resolvedEntries, *latestEntry)
+		})
 	}
-
-	return TopicRegistry{
-		TopicID:      topicID,
-		RegistryType: RegistryTypeNonIndexed,
-		TTL:          parsedMemo.TTL,
-		Entries:      resolvedEntries,
-		LatestEntry:  latestEntry,
-	}, nil
+	return entries, parsedMemo, nil
 }
 
 func (c *Client) resolvePublicKey(raw string, useOperator bool) hedera.Key {
@@ -1,6 +1,7 @@
 // Package hcs6 implements the HCS-6 Dynamic Hashinals registry standard.
 // It provides non-indexed registry topic creation, register operations,
-// memo helpers, transaction builders, and mirror-node reads.
+// one-step publishing of new content versions, version history, memo
+// helpers, transaction builders, and mirror-node reads.
 //
 // # Specification
 //
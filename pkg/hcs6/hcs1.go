@@ -0,0 +1,136 @@
+package hcs6
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+)
+
+// hcs1ChunkSize keeps each {"o":n,"c":"..."} message under the 1024 byte
+// consensus message limit.
+const hcs1ChunkSize = 1000
+
+type hcs1Chunk struct {
+	Order   int    `json:"o"`
+	Content string `json:"c"`
+}
+
+// writeHCS1File stores content on a new HCS-1 topic whose submit key is the
+// operator key, and returns the topic ID.
+func (c *Client) writeHCS1File(ctx context.Context, input inscriber.InscriptionInput) (string, error) {
+	content, mimeType, err := readInscriptionInput(input)
+	if err != nil {
+		return "", err
+	}
+	memo, chunks, err := buildHCS1File(content, mimeType)
+	if err != nil {
+		return "", err
+	}
+
+	created, err := c.executor.Execute(
+		ctx,
+		c.hederaClient,
+		hedera.NewTopicCreateTransaction().SetTopicMemo(memo).SetSubmitKey(c.operatorPublicKey),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute HCS-1 topic create transaction: %w", err)
+	}
+	createReceipt := created.Receipt
+	if createReceipt.TopicID == nil {
+		return "", fmt.Errorf("topic ID missing in HCS-1 topic create receipt")
+	}
+
+	for _, chunk := range chunks {
+		payload, err := json.Marshal(chunk)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal HCS-1 chunk: %w", err)
+		}
+		transaction := hedera.NewTopicMessageSubmitTransaction().
+			SetTopicID(*createReceipt.TopicID).
+			SetMessage(payload)
+		if _, err := c.executor.Execute(ctx, c.hederaClient, transaction); err != nil {
+			return "", fmt.Errorf("failed to submit HCS-1 chunk %d: %w", chunk.Order, err)
+		}
+	}
+
+	return createReceipt.TopicID.String(), nil
+}
+
+// buildHCS1File compresses content into a brotli data URL and splits it into
+// ordered chunks. The memo is "<sha256 of content>:brotli:base64".
+func buildHCS1File(content []byte, mimeType string) (string, []hcs1Chunk, error) {
+	if len(content) == 0 {
+		return "", nil, fmt.Errorf("HCS-1 content is empty")
+	}
+
+	var compressed bytes.Buffer
+	writer := brotli.NewWriter(&compressed)
+	if _, err := writer.Write(content); err != nil {
+		return "", nil, fmt.Errorf("failed to compress HCS-1 content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", nil, fmt.Errorf("failed to compress HCS-1 content: %w", err)
+	}
+
+	dataURL := fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	chunks := make([]hcs1Chunk, 0, len(dataURL)/hcs1ChunkSize+1)
+	for start := 0; start < len(dataURL); start += hcs1ChunkSize {
+		end := min(start+hcs1ChunkSize, len(dataURL))
+		chunks = append(chunks, hcs1Chunk{Order: len(chunks), Content: dataURL[start:end]})
+	}
+
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]) + ":brotli:base64", chunks, nil
+}
+
+func readInscriptionInput(input inscriber.InscriptionInput) ([]byte, string, error) {
+	var (
+		content  []byte
+		fileName = strings.TrimSpace(input.FileName)
+	)
+	switch input.Type {
+	case inscriber.InscriptionInputTypeBuffer:
+		if len(input.Buffer) == 0 {
+			return nil, "", fmt.Errorf("input.buffer is required for buffer input type")
+		}
+		content = input.Buffer
+	case inscriber.InscriptionInputTypeFile:
+		path := strings.TrimSpace(input.Path)
+		if path == "" {
+			return nil, "", fmt.Errorf("input.path is required for file input type")
+		}
+		read, err := os.ReadFile(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read file %s: %w", path, err)
+		}
+		content = read
+		if fileName == "" {
+			fileName = filepath.Base(path)
+		}
+	default:
+		return nil, "", fmt.Errorf("HCS-1 publishing supports file and buffer inputs only")
+	}
+
+	mimeType := strings.TrimSpace(input.MimeType)
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+	return content, mimeType, nil
+}
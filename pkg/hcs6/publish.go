@@ -0,0 +1,211 @@
+package hcs6
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
+
+const (
+	defaultMirrorMaxRetries    = 10
+	defaultMirrorRetryInterval = 2 * time.Second
+)
+
+type writeContentFunc func(ctx context.Context, input inscriber.InscriptionInput) (topicID string, err error)
+
+type registerEntryFunc func(ctx context.Context, registryTopicID string, options RegisterEntryOptions) (OperationResult, error)
+
+// BuildHCS1HRL returns the hcs://1 HRL for the topic.
+func BuildHCS1HRL(topicID string) string {
+	return "hcs://1/" + topicID
+}
+
+// Publish stores new content, points the registry at it, and waits until the
+// mirror node serves the update. The result carries the new HRL and the full
+// version history, newest last.
+//
+// When a step fails after the content was written, the returned result still
+// carries the content topic ID and, once registered, the pointer update's
+// transaction ID and sequence number; Success stays false.
+func (c *Client) Publish(
+	ctx context.Context,
+	registryTopicID string,
+	input inscriber.InscriptionInput,
+	options PublishOptions,
+) (PublishResult, error) {
+	var writeContent writeContentFunc
+	switch options.Method {
+	case "", PublishMethodInscriber:
+		writeContent = func(ctx context.Context, input inscriber.InscriptionInput) (string, error) {
+			return c.inscribeContent(ctx, input, options)
+		}
+	case PublishMethodHCS1:
+		writeContent = c.writeHCS1File
+	default:
+		return PublishResult{}, fmt.Errorf("unsupported publish method %q", options.Method)
+	}
+	return c.publish(ctx, registryTopicID, input, options, writeContent, c.RegisterEntry)
+}
+
+func (c *Client) publish(
+	ctx context.Context,
+	registryTopicID string,
+	input inscriber.InscriptionInput,
+	options PublishOptions,
+	writeContent writeContentFunc,
+	register registerEntryFunc,
+) (PublishResult, error) {
+	trimmedRegistryTopicID := strings.TrimSpace(registryTopicID)
+	if _, err := hedera.TopicIDFromString(trimmedRegistryTopicID); err != nil {
+		return PublishResult{}, fmt.Errorf("invalid registry topic ID: %w", err)
+	}
+
+	topicID, err := writeContent(ctx, input)
+	if err != nil {
+		return PublishResult{}, fmt.Errorf("failed to publish content: %w", err)
+	}
+	result := PublishResult{
+		TopicID: topicID,
+		HRL:     BuildHCS1HRL(topicID),
+	}
+
+	registered, err := register(ctx, trimmedRegistryTopicID, RegisterEntryOptions{
+		TargetTopicID: topicID,
+		Memo:          options.Memo,
+		AnalyticsMemo: options.AnalyticsMemo,
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to register content topic %s: %w", topicID, err)
+	}
+	result.TransactionID = registered.TransactionID
+	result.SequenceNumber = registered.SequenceNumber
+
+	if err := c.waitForMirrorSequence(
+		ctx,
+		trimmedRegistryTopicID,
+		registered.SequenceNumber,
+		options.MirrorMaxRetries,
+		options.MirrorRetryInterval,
+	); err != nil {
+		return result, err
+	}
+
+	history, err := c.History(ctx, trimmedRegistryTopicID)
+	if err != nil {
+		return result, err
+	}
+	result.Success = true
+	result.History = history
+	return result, nil
+}
+
+func (c *Client) inscribeContent(
+	ctx context.Context,
+	input inscriber.InscriptionInput,
+	options PublishOptions,
+) (string, error) {
+	inscriptionOptions := options.InscriptionOptions
+	if inscriptionOptions.Network == "" {
+		inscriptionOptions.Network = inscriber.NetworkTestnet
+		if c.network == shared.NetworkMainnet {
+			inscriptionOptions.Network = inscriber.NetworkMainnet
+		}
+	}
+	if inscriptionOptions.Mode == "" {
+		inscriptionOptions.Mode = inscriber.ModeFile
+	}
+	waitForConfirmation := true
+	inscriptionOptions.WaitForConfirmation = &waitForConfirmation
+
+	authURL := strings.TrimSpace(options.InscriberAuthURL)
+	if authURL == "" {
+		authURL = c.inscriberAuthURL
+	}
+	apiURL := strings.TrimSpace(options.InscriberAPIURL)
+	if apiURL == "" {
+		apiURL = c.inscriberAPIURL
+	}
+
+	if c.operatorSigner == nil {
+		return "", fmt.Errorf("operator private key or signer is required for inscriber-backed publishing")
+	}
+	authResult, err := inscriber.NewAuthClient(authURL).AuthenticateWithSigner(
+		ctx,
+		c.operatorID.String(),
+		c.operatorSigner,
+		inscriptionOptions.Network,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to authenticate inscriber client: %w", err)
+	}
+	inscriberClient, err := inscriber.NewClient(inscriber.Config{
+		APIKey:  authResult.APIKey,
+		Network: inscriptionOptions.Network,
+		BaseURL: apiURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create inscriber client: %w", err)
+	}
+
+	response, err := inscriber.Inscribe(
+		ctx,
+		input,
+		inscriber.HederaClientConfig{
+			AccountID: c.operatorID.String(),
+			Signer:    c.operatorSigner,
+			Network:   inscriptionOptions.Network,
+		},
+		inscriptionOptions,
+		inscriberClient,
+	)
+	if err != nil {
+		return "", err
+	}
+	result, _ := response.Result.(inscriber.InscriptionResult)
+	if !response.Confirmed {
+		return "", fmt.Errorf("inscription did not complete successfully")
+	}
+	if strings.TrimSpace(result.TopicID) == "" {
+		return "", fmt.Errorf("inscription completion did not include topic ID")
+	}
+	return result.TopicID, nil
+}
+
+func (c *Client) waitForMirrorSequence(
+	ctx context.Context,
+	topicID string,
+	sequenceNumber int64,
+	maxRetries int,
+	interval time.Duration,
+) error {
+	if sequenceNumber <= 0 {
+		return nil
+	}
+	if maxRetries <= 0 {
+		maxRetries = defaultMirrorMaxRetries
+	}
+	if interval <= 0 {
+		interval = defaultMirrorRetryInterval
+	}
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		message, err := c.mirrorClient.GetTopicMessageBySequence(ctx, topicID, sequenceNumber)
+		if err == nil && message != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	return fmt.Errorf("mirror node did not return sequence %d for topic %s", sequenceNumber, topicID)
+}
@@ -0,0 +1,166 @@
+package hcs6
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+func TestPublishRegistersAndReturnsHistory(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		messages []map[string]any
+	)
+	appendRegister := func(topicID string) int64 {
+		mutex.Lock()
+		defer mutex.Unlock()
+		payload, _ := json.Marshal(Message{P: "hcs-6", Op: OperationRegister, TopicID: topicID})
+		sequence := int64(len(messages) + 1)
+		messages = append(messages, map[string]any{
+			"sequence_number":     sequence,
+			"consensus_timestamp": fmt.Sprintf("1700000000.%09d", sequence),
+			"payer_account_id":    "0.0.1001",
+			"message":             base64.StdEncoding.EncodeToString(payload),
+		})
+		return sequence
+	}
+	appendRegister("0.0.500")
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		switch request.URL.Path {
+		case "/api/v1/topics/0.0.700":
+			_ = json.NewEncoder(writer).Encode(map[string]any{"memo": "hcs-6:1:86400"})
+		case "/api/v1/topics/0.0.700/messages":
+			mutex.Lock()
+			defer mutex.Unlock()
+			filtered := messages
+			if sequence := request.URL.Query().Get("sequencenumber"); strings.HasPrefix(sequence, "eq:") {
+				filtered = nil
+				for _, message := range messages {
+					if fmt.Sprintf("eq:%d", message["sequence_number"]) == sequence {
+						filtered = append(filtered, message)
+					}
+				}
+			}
+			_ = json.NewEncoder(writer).Encode(map[string]any{"messages": filtered})
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+	defer server.Close()
+
+	mirrorClient, err := mirror.NewClient(mirror.Config{Network: "testnet", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected mirror error: %v", err)
+	}
+	client := &Client{mirrorClient: mirrorClient}
+
+	writeContent := func(_ context.Context, input inscriber.InscriptionInput) (string, error) {
+		if string(input.Buffer) != "v2" {
+			t.Fatalf("unexpected input: %+v", input)
+		}
+		return "0.0.501", nil
+	}
+	register := func(_ context.Context, registryTopicID string, options RegisterEntryOptions) (OperationResult, error) {
+		if registryTopicID != "0.0.700" || options.TargetTopicID != "0.0.501" {
+			t.Fatalf("unexpected register call: %s %+v", registryTopicID, options)
+		}
+		return OperationResult{Success: true, TransactionID: "0.0.1001@1.2", SequenceNumber: appendRegister("0.0.501")}, nil
+	}
+
+	result, err := client.publish(
+		context.Background(),
+		"0.0.700",
+		inscriber.InscriptionInput{Type: inscriber.InscriptionInputTypeBuffer, Buffer: []byte("v2")},
+		PublishOptions{MirrorRetryInterval: time.Millisecond},
+		writeContent,
+		register,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.HRL != "hcs://1/0.0.501" || result.SequenceNumber != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(result.History) != 2 {
+		t.Fatalf("expected two versions, got %+v", result.History)
+	}
+	if result.History[0].TopicID != "0.0.500" || result.History[1].Version != 2 || result.History[1].HRL != "hcs://1/0.0.501" {
+		t.Fatalf("unexpected history: %+v", result.History)
+	}
+	if result.History[1].ConsensusTimestamp != "1700000000.000000002" {
+		t.Fatalf("unexpected timestamp: %s", result.History[1].ConsensusTimestamp)
+	}
+}
+
+func TestPublishReturnsPartialResultWhenMirrorLags(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	mirrorClient, err := mirror.NewClient(mirror.Config{Network: "testnet", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected mirror error: %v", err)
+	}
+	client := &Client{mirrorClient: mirrorClient}
+
+	result, err := client.publish(
+		context.Background(),
+		"0.0.700",
+		inscriber.InscriptionInput{Type: inscriber.InscriptionInputTypeBuffer, Buffer: []byte("v2")},
+		PublishOptions{MirrorMaxRetries: 1, MirrorRetryInterval: time.Millisecond},
+		func(context.Context, inscriber.InscriptionInput) (string, error) {
+			return "0.0.501", nil
+		},
+		func(context.Context, string, RegisterEntryOptions) (OperationResult, error) {
+			return OperationResult{Success: true, TransactionID: "0.0.1001@1.2", SequenceNumber: 9}, nil
+		},
+	)
+	if err == nil {
+		t.Fatal("expected mirror wait error")
+	}
+	if result.Success || result.TopicID != "0.0.501" || result.TransactionID != "0.0.1001@1.2" || result.SequenceNumber != 9 {
+		t.Fatalf("expected partial result, got %+v", result)
+	}
+}
+
+func TestBuildHCS1FileRoundTrip(t *testing.T) {
+	content := []byte(strings.Repeat("dynamic hashinal ", 500))
+	memo, chunks, err := buildHCS1File(content, "text/plain")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(memo, ":brotli:base64") {
+		t.Fatalf("unexpected memo: %s", memo)
+	}
+
+	items := make([]mirror.TopicMessage, 0, len(chunks))
+	for _, chunk := range chunks {
+		payload, _ := json.Marshal(chunk)
+		if len(payload) > 1024 {
+			t.Fatalf("chunk %d exceeds message size: %d", chunk.Order, len(payload))
+		}
+		items = append(items, mirror.TopicMessage{Message: base64.StdEncoding.EncodeToString(payload)})
+	}
+	decoded, err := mirror.DecodeHCS1File("0.0.501", items)
+	if err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	if string(decoded) != string(content) {
+		t.Fatal("decoded content does not match")
+	}
+
+	if _, _, err := readInscriptionInput(inscriber.InscriptionInput{Type: inscriber.InscriptionInputTypeURL, URL: "https://example.com"}); err == nil {
+		t.Fatal("expected URL input error")
+	}
+}
@@ -1,6 +1,13 @@
 package hcs6
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/inscriber"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type Operation string
 
@@ -30,10 +37,15 @@ type TopicMemo struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
+	InscriberAuthURL   string
+	InscriberAPIURL    string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateRegistryOptions struct {
@@ -87,3 +99,45 @@ type OperationResult struct {
 	Error          string `json:"error,omitempty"`
 	SequenceNumber int64  `json:"sequence_number,omitempty"`
 }
+
+type PublishMethod string
+
+const (
+	// PublishMethodInscriber inscribes the content through the inscriber API.
+	PublishMethodInscriber PublishMethod = "inscriber"
+	// PublishMethodHCS1 writes the content to a new HCS-1 topic directly.
+	PublishMethodHCS1 PublishMethod = "hcs-1"
+)
+
+// PublishOptions configures Publish. The zero value inscribes through the
+// inscriber API and waits for the registry update on the mirror node.
+type PublishOptions struct {
+	Method              PublishMethod
+	Memo                string
+	AnalyticsMemo       string
+	InscriptionOptions  inscriber.InscriptionOptions
+	InscriberAuthURL    string
+	InscriberAPIURL     string
+	MirrorMaxRetries    int
+	MirrorRetryInterval time.Duration
+}
+
+type PublishResult struct {
+	Success        bool           `json:"success"`
+	TopicID        string         `json:"topic_id"`
+	HRL            string         `json:"hrl"`
+	TransactionID  string         `json:"transaction_id,omitempty"`
+	SequenceNumber int64          `json:"sequence_number,omitempty"`
+	History        []HistoryEntry `json:"history"`
+}
+
+// HistoryEntry is one version of a dynamic registry, oldest first.
+type HistoryEntry struct {
+	Version            int    `json:"version"`
+	TopicID            string `json:"topic_id"`
+	HRL                string `json:"hrl"`
+	Memo               string `json:"memo,omitempty"`
+	Sequence           int64  `json:"sequence"`
+	ConsensusTimestamp string `json:"consensus_timestamp"`
+	Payer              string `json:"payer"`
+}
@@ -0,0 +1,131 @@
+package hcs7
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const abiWordSize = 32
+
+// FunctionSelector returns the 4-byte selector for the ABI function signature.
+func FunctionSelector(abi AbiDefinition) ([]byte, error) {
+	name := strings.TrimSpace(abi.Name)
+	if name == "" {
+		return nil, fmt.Errorf("abi name is required")
+	}
+	inputTypes := make([]string, 0, len(abi.Inputs))
+	for _, input := range abi.Inputs {
+		inputTypes = append(inputTypes, strings.TrimSpace(input.Type))
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(fmt.Sprintf("%s(%s)", name, strings.Join(inputTypes, ","))))
+	return hasher.Sum(nil)[:4], nil
+}
+
+// EncodeFunctionCall encodes calldata for a view function without arguments.
+func EncodeFunctionCall(abi AbiDefinition) (string, error) {
+	if len(abi.Inputs) > 0 {
+		return "", fmt.Errorf("abi function %s takes inputs, which HCS-7 configs cannot supply", abi.Name)
+	}
+	selector, err := FunctionSelector(abi)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(selector), nil
+}
+
+// DecodeFunctionResult decodes hex return data into the ABI outputs. Integers
+// are returned as *big.Int, bool as bool, and address/bytes as 0x-prefixed hex.
+func DecodeFunctionResult(abi AbiDefinition, result string) ([]any, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(result), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI result hex: %w", err)
+	}
+	if len(data) < len(abi.Outputs)*abiWordSize {
+		return nil, fmt.Errorf("ABI result too short for %d outputs", len(abi.Outputs))
+	}
+
+	values := make([]any, 0, len(abi.Outputs))
+	for index, output := range abi.Outputs {
+		word := data[index*abiWordSize : (index+1)*abiWordSize]
+		value, err := decodeABIValue(strings.TrimSpace(output.Type), word, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode output %d: %w", index, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func decodeABIValue(abiType string, word []byte, data []byte) (any, error) {
+	switch {
+	case abiType == "bool":
+		return new(big.Int).SetBytes(word).Sign() != 0, nil
+	case abiType == "address":
+		return "0x" + hex.EncodeToString(word[abiWordSize-20:]), nil
+	case abiType == "string" || abiType == "bytes":
+		content, err := readDynamicABIBytes(word, data)
+		if err != nil {
+			return nil, err
+		}
+		if abiType == "string" {
+			return string(content), nil
+		}
+		return "0x" + hex.EncodeToString(content), nil
+	case strings.HasPrefix(abiType, "uint"):
+		if _, err := abiBitSize(abiType, "uint"); err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(word), nil
+	case strings.HasPrefix(abiType, "int"):
+		bits, err := abiBitSize(abiType, "int")
+		if err != nil {
+			return nil, err
+		}
+		value := new(big.Int).SetBytes(word[abiWordSize-bits/8:])
+		if value.Bit(bits-1) == 1 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+		}
+		return value, nil
+	case strings.HasPrefix(abiType, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(abiType, "bytes"))
+		if err != nil || size < 1 || size > abiWordSize {
+			return nil, fmt.Errorf("unsupported ABI type %q", abiType)
+		}
+		return "0x" + hex.EncodeToString(word[:size]), nil
+	default:
+		return nil, fmt.Errorf("unsupported ABI type %q", abiType)
+	}
+}
+
+func abiBitSize(abiType string, prefix string) (int, error) {
+	suffix := strings.TrimPrefix(abiType, prefix)
+	if suffix == "" {
+		return 256, nil
+	}
+	bits, err := strconv.Atoi(suffix)
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+		return 0, fmt.Errorf("unsupported ABI type %q", abiType)
+	}
+	return bits, nil
+}
+
+func readDynamicABIBytes(offsetWord []byte, data []byte) ([]byte, error) {
+	offset := new(big.Int).SetBytes(offsetWord)
+	if !offset.IsInt64() || offset.Int64() > int64(len(data)-abiWordSize) {
+		return nil, fmt.Errorf("dynamic ABI offset out of range")
+	}
+	start := int(offset.Int64())
+	length := new(big.Int).SetBytes(data[start : start+abiWordSize])
+	if !length.IsInt64() || length.Int64() > int64(len(data)-start-abiWordSize) {
+		return nil, fmt.Errorf("dynamic ABI length out of range")
+	}
+	contentStart := start + abiWordSize
+	return data[contentStart : contentStart+int(length.Int64())], nil
+}
@@ -15,6 +15,7 @@ import (
 
 type Client struct {
 	hederaClient      *hedera.Client
+	executor          *shared.Executor
 	mirrorClient      *mirror.Client
 	operatorID        hedera.AccountID
 	operatorPublicKey hedera.PublicKey
@@ -27,11 +28,12 @@ func NewClient(config ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	hederaClient, operator, err := shared.ResolveHederaClientAndOperator(
+	hederaClient, operator, err := shared.ResolveHederaClientAndOperatorWithSigner(
 		network,
 		config.HederaClient,
 		config.OperatorAccountID,
 		config.OperatorPrivateKey,
+		config.OperatorSigner,
 	)
 	if err != nil {
 		return nil, err
@@ -48,6 +50,7 @@ func NewClient(config ClientConfig) (*Client, error) {
 
 	return &Client{
 		hederaClient:      hederaClient,
+		executor:          config.Executor.WithOperatorSigner(operator.Signer),
 		mirrorClient:      mirrorClient,
 		operatorID:        operator.AccountID,
 		operatorPublicKey: operator.PublicKey,
@@ -62,8 +65,6 @@ func (c *Client) MirrorClient() *mirror.Client {
 
 // CreateRegistry creates the requested resource.
 func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptions) (CreateRegistryResult, error) {
-	_ = ctx
-
 	ttl := options.TTL
 	if ttl <= 0 {
 		ttl = DefaultTTL
@@ -78,14 +79,11 @@ func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptio
 		SubmitKey: c.resolvePublicKey(options.SubmitKey, options.UseOperatorAsSubmit),
 	})
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return CreateRegistryResult{}, fmt.Errorf("failed to execute create topic transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return CreateRegistryResult{}, fmt.Errorf("failed to get create topic receipt: %w", err)
-	}
+	receipt := executed.Receipt
 	if receipt.TopicID == nil {
 		return CreateRegistryResult{}, fmt.Errorf("topic ID missing in create topic receipt")
 	}
@@ -93,14 +91,12 @@ func (c *Client) CreateRegistry(ctx context.Context, options CreateRegistryOptio
 	return CreateRegistryResult{
 		Success:       true,
 		TopicID:       receipt.TopicID.String(),
-		TransactionID: response.TransactionID.String(),
+		TransactionID: executed.TransactionID,
 	}, nil
 }
 
 // RegisterConfig performs the requested operation.
 func (c *Client) RegisterConfig(ctx context.Context, options RegisterConfigOptions) (RegistryOperationResult, error) {
-	_ = ctx
-
 	message, err := c.buildConfigMessage(options)
 	if err != nil {
 		return RegistryOperationResult{}, err
@@ -112,13 +108,11 @@ func (c *Client) RegisterConfig(ctx context.Context, options RegisterConfigOptio
 	}
 
 	submitKey := c.resolvePrivateKey(options.SubmitKey)
-	return c.submitMessage(options.RegistryTopicID, message, submitKey, analyticsMemo)
+	return c.submitMessage(ctx, options.RegistryTopicID, message, submitKey, analyticsMemo)
 }
 
 // RegisterMetadata performs the requested operation.
 func (c *Client) RegisterMetadata(ctx context.Context, options RegisterMetadataOptions) (RegistryOperationResult, error) {
-	_ = ctx
-
 	if len(options.Tags) == 0 {
 		return RegistryOperationResult{}, fmt.Errorf("tags are required")
 	}
@@ -144,12 +138,21 @@ func (c *Client) RegisterMetadata(ctx context.Context, options RegisterMetadataO
 	}
 
 	submitKey := c.resolvePrivateKey(options.SubmitKey)
-	return c.submitMessage(options.RegistryTopicID, message, submitKey, analyticsMemo)
+	return c.submitMessage(ctx, options.RegistryTopicID, message, submitKey, analyticsMemo)
 }
 
 // GetRegistry performs the requested operation.
 func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryRegistryOptions) (RegistryTopic, error) {
-	info, err := c.mirrorClient.GetTopicInfo(ctx, topicID)
+	return fetchRegistry(ctx, c.mirrorClient, topicID, options)
+}
+
+func fetchRegistry(
+	ctx context.Context,
+	mirrorClient *mirror.Client,
+	topicID string,
+	options QueryRegistryOptions,
+) (RegistryTopic, error) {
+	info, err := mirrorClient.GetTopicInfo(ctx, topicID)
 	if err != nil {
 		return RegistryTopic{}, err
 	}
@@ -167,7 +170,7 @@ func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryR
 		sequenceNumber = fmt.Sprintf("gt:%d", options.Skip)
 	}
 
-	items, err := c.mirrorClient.GetTopicMessages(ctx, topicID, mirror.MessageQueryOptions{
+	items, err := mirrorClient.GetTopicMessages(ctx, topicID, mirror.MessageQueryOptions{
 		SequenceNumber: sequenceNumber,
 		Limit:          options.Limit,
 		Order:          order,
@@ -185,12 +188,18 @@ func (c *Client) GetRegistry(ctx context.Context, topicID string, options QueryR
 		if err := ValidateMessage(message); err != nil {
 			continue
 		}
-		entries = append(entries, RegistryEntry{
+		entry := RegistryEntry{
 			SequenceNumber: item.SequenceNumber,
 			Timestamp:      item.ConsensusTimestamp,
 			Payer:          item.PayerAccountID,
 			Message:        message,
-		})
+		}
+		if message.Op == OperationRegister {
+			if metadata, err := ParseMetadataData(message.Data); err == nil {
+				entry.Metadata = &metadata
+			}
+		}
+		entries = append(entries, entry)
 	}
 
 	return RegistryTopic{
@@ -227,7 +236,7 @@ func (c *Client) buildConfigMessage(options RegisterConfigOptions) (Message, err
 	return message, nil
 }
 
-func (c *Client) submitMessage(topicID string, message Message, submitKey *hedera.PrivateKey, transactionMemo string) (RegistryOperationResult, error) {
+func (c *Client) submitMessage(ctx context.Context, topicID string, message Message, submitKey *hedera.PrivateKey, transactionMemo string) (RegistryOperationResult, error) {
 	transaction, err := BuildSubmitMessageTx(topicID, message, transactionMemo)
 	if err != nil {
 		return RegistryOperationResult{}, err
@@ -238,34 +247,17 @@ func (c *Client) submitMessage(topicID string, message Message, submitKey *heder
 		if freezeErr != nil {
 			return RegistryOperationResult{}, fmt.Errorf("failed to freeze transaction: %w", freezeErr)
 		}
-		frozen.Sign(*submitKey)
-		response, executeErr := frozen.Execute(c.hederaClient)
-		if executeErr != nil {
-			return RegistryOperationResult{}, fmt.Errorf("failed to execute message submit transaction: %w", executeErr)
-		}
-		receipt, receiptErr := response.GetReceipt(c.hederaClient)
-		if receiptErr != nil {
-			return RegistryOperationResult{}, fmt.Errorf("failed to get message submit receipt: %w", receiptErr)
-		}
-		return RegistryOperationResult{
-			Success:        true,
-			TransactionID:  response.TransactionID.String(),
-			SequenceNumber: int64(receipt.TopicSequenceNumber),
-		}, nil
+		transaction = frozen.Sign(*submitKey)
 	}
 
-	response, err := transaction.Execute(c.hederaClient)
+	executed, err := c.executor.Execute(ctx, c.hederaClient, transaction)
 	if err != nil {
 		return RegistryOperationResult{}, fmt.Errorf("failed to execute message submit transaction: %w", err)
 	}
-	receipt, err := response.GetReceipt(c.hederaClient)
-	if err != nil {
-		return RegistryOperationResult{}, fmt.Errorf("failed to get message submit receipt: %w", err)
-	}
 	return RegistryOperationResult{
 		Success:        true,
-		TransactionID:  response.TransactionID.String(),
-		SequenceNumber: int64(receipt.TopicSequenceNumber),
+		TransactionID:  executed.TransactionID,
+		SequenceNumber: executed.SequenceNumber(),
 	}, nil
 }
 
@@ -1,7 +1,11 @@
 // Package hcs7 implements the HCS-7 Registry for validator/runtime
 // configuration metadata. It supports indexed registry topic creation,
 // config registration, metadata registration, transaction builders,
-// and mirror-node registry reads.
+// and mirror-node registry reads. Evaluator reads EVM state through the
+// mirror node, runs the registry's WASM module over it, and selects the
+// metadata topic a smart hashinal currently renders. The WASM module may
+// export the import-free alloc/ptr-len ABI described on Evaluator.Evaluate or
+// be a wasm-bindgen build with a synchronous string process_state.
 //
 // # Specification
 //
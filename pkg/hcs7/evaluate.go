@@ -0,0 +1,279 @@
+package hcs7
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+)
+
+const (
+	defaultEvaluationTimeout          = 10 * time.Second
+	defaultEvaluationMemoryLimitPages = 256
+	maxEvaluationMemoryLimitPages     = 65536
+)
+
+// Evaluator resolves which HCS-1 topic an HCS-7 registry currently points to.
+type Evaluator struct {
+	mirrorClient     *mirror.Client
+	wasmLoader       WasmLoaderFunc
+	timeout          time.Duration
+	memoryLimitPages uint32
+}
+
+// NewEvaluator creates a new Evaluator. No operator credentials are needed.
+func NewEvaluator(config EvaluatorConfig) (*Evaluator, error) {
+	mirrorClient := config.MirrorClient
+	if mirrorClient == nil {
+		created, err := mirror.NewClient(mirror.Config{
+			Network: config.Network,
+			BaseURL: config.MirrorBaseURL,
+			APIKey:  config.MirrorAPIKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mirrorClient = created
+	}
+
+	wasmLoader := config.WasmLoader
+	if wasmLoader == nil {
+		wasmLoader = mirrorClient.GetHCS1File
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultEvaluationTimeout
+	}
+	memoryLimitPages := config.MemoryLimitPages
+	if memoryLimitPages == 0 {
+		memoryLimitPages = defaultEvaluationMemoryLimitPages
+	}
+	if memoryLimitPages > maxEvaluationMemoryLimitPages {
+		return nil, fmt.Errorf("memory limit must not exceed %d pages", maxEvaluationMemoryLimitPages)
+	}
+
+	return &Evaluator{
+		mirrorClient:     mirrorClient,
+		wasmLoader:       wasmLoader,
+		timeout:          timeout,
+		memoryLimitPages: memoryLimitPages,
+	}, nil
+}
+
+// Evaluate resolves the current metadata topic of a registry with a one-off
+// Evaluator. A zero config reads from the testnet public mirror node.
+func Evaluate(ctx context.Context, registryTopicID string, config EvaluatorConfig) (EvaluationResult, error) {
+	evaluator, err := NewEvaluator(config)
+	if err != nil {
+		return EvaluationResult{}, err
+	}
+	return evaluator.Evaluate(ctx, registryTopicID)
+}
+
+// Evaluate resolves the current metadata topic using the client's mirror node.
+func (c *Client) Evaluate(ctx context.Context, registryTopicID string) (EvaluationResult, error) {
+	evaluator, err := NewEvaluator(EvaluatorConfig{MirrorClient: c.mirrorClient})
+	if err != nil {
+		return EvaluationResult{}, err
+	}
+	return evaluator.Evaluate(ctx, registryTopicID)
+}
+
+// Evaluate reads EVM state for every registered EVM config, runs the latest
+// WASM config's process_state over it, and selects the matching metadata entry.
+//
+// Each result is stored in the state under its EvmStateKey, and also under
+// the bare function name when only one contract registers that function.
+//
+// The WASM output may be a topic ID, which is returned as-is, or a tag, in
+// which case the highest-weight entry carrying that tag wins and ties go to
+// the most recently registered entry.
+//
+// process_state must be exported with the raw ABI "alloc(len i32) i32" and
+// "process_state(state_ptr, state_len, messages_ptr, messages_len i32) i64",
+// returning ptr<<32|len, or by wasm-bindgen as a function taking the state
+// and messages JSON as strings and returning a string.
+func (e *Evaluator) Evaluate(ctx context.Context, registryTopicID string) (EvaluationResult, error) {
+	topicID := strings.TrimSpace(registryTopicID)
+	if !hederaTopicIDPattern.MatchString(topicID) {
+		return EvaluationResult{}, fmt.Errorf("invalid registry topic ID %q", registryTopicID)
+	}
+
+	registry, err := fetchRegistry(ctx, e.mirrorClient, topicID, QueryRegistryOptions{})
+	if err != nil {
+		return EvaluationResult{}, err
+	}
+	view := NewRegistryView(registry)
+	activeWasm, ok := view.ActiveWasmConfig()
+	if !ok {
+		return EvaluationResult{}, fmt.Errorf("registry %s has no WASM config", topicID)
+	}
+	wasmConfig := activeWasm.Config
+
+	messages := make([]Message, 0, len(registry.Entries))
+	for _, entry := range registry.Entries {
+		messages = append(messages, entry.Message)
+	}
+
+	state := map[string]any{}
+	keysByName := map[string][]string{}
+	for _, evmEntry := range view.LatestEvmConfigs() {
+		value, err := e.readEVMState(ctx, evmEntry.Config)
+		if err != nil {
+			return EvaluationResult{}, err
+		}
+		key := EvmStateKey(evmEntry.Config)
+		state[key] = value
+		keysByName[evmEntry.Config.Abi.Name] = append(keysByName[evmEntry.Config.Abi.Name], key)
+	}
+	for name, keys := range keysByName {
+		if len(keys) == 1 {
+			state[name] = state[keys[0]]
+		}
+	}
+	for key := range wasmConfig.InputType.StateData {
+		if _, ok := state[key]; !ok && len(keysByName[key]) > 1 {
+			return EvaluationResult{}, fmt.Errorf(
+				"state data %q is provided by %d contracts; key it by contract as %s",
+				key,
+				len(keysByName[key]),
+				strings.Join(keysByName[key], " or "),
+			)
+		}
+	}
+	if err := coerceStateData(state, wasmConfig.InputType.StateData); err != nil {
+		return EvaluationResult{}, err
+	}
+
+	wasmBytes, err := e.wasmLoader(ctx, wasmConfig.WasmTopicID)
+	if err != nil {
+		return EvaluationResult{}, fmt.Errorf("failed to load WASM module %s: %w", wasmConfig.WasmTopicID, err)
+	}
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return EvaluationResult{}, fmt.Errorf("failed to encode state: %w", err)
+	}
+	messagesJSON, err := json.Marshal(messages)
+	if err != nil {
+		return EvaluationResult{}, fmt.Errorf("failed to encode registry messages: %w", err)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	output, err := runProcessState(runCtx, wasmBytes, e.memoryLimitPages, stateJSON, messagesJSON)
+	if err != nil {
+		return EvaluationResult{}, err
+	}
+
+	result := EvaluationResult{
+		RegistryTopicID: topicID,
+		Output:          output,
+		State:           state,
+	}
+	selected, err := selectMetadataEntry(view, strings.TrimSpace(output))
+	if err != nil {
+		return EvaluationResult{}, err
+	}
+	result.Entry = selected
+	if selected != nil {
+		result.TopicID = selected.TopicID
+	} else {
+		result.TopicID = strings.TrimSpace(output)
+	}
+	return result, nil
+}
+
+func (e *Evaluator) readEVMState(ctx context.Context, config EvmConfigPayload) (any, error) {
+	calldata, err := EncodeFunctionCall(config.Abi)
+	if err != nil {
+		return nil, err
+	}
+	response, err := e.mirrorClient.CallContract(ctx, mirror.ContractCallRequest{
+		Block: "latest",
+		Data:  calldata,
+		To:    strings.TrimSpace(config.ContractAddress),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s on %s: %w", config.Abi.Name, config.ContractAddress, err)
+	}
+	values, err := DecodeFunctionResult(config.Abi, response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s result: %w", config.Abi.Name, err)
+	}
+
+	for index, value := range values {
+		if integer, ok := value.(*big.Int); ok {
+			values[index] = json.Number(integer.String())
+		}
+	}
+	if len(values) == 1 {
+		return values[0], nil
+	}
+	named := make(map[string]any, len(values))
+	for index, value := range values {
+		key := strings.TrimSpace(config.Abi.Outputs[index].Name)
+		if key == "" {
+			key = strconv.Itoa(index)
+		}
+		named[key] = value
+	}
+	return named, nil
+}
+
+func coerceStateData(state map[string]any, types map[string]StateValueType) error {
+	for key, valueType := range types {
+		value, ok := state[key]
+		if !ok {
+			return fmt.Errorf("state data %q is not provided by any EVM config", key)
+		}
+		switch valueType {
+		case StateValueTypeNumber:
+			text := fmt.Sprint(value)
+			if typed, ok := value.(bool); ok {
+				text = "0"
+				if typed {
+					text = "1"
+				}
+			}
+			if _, ok := new(big.Float).SetString(text); !ok {
+				return fmt.Errorf("state data %q is not numeric", key)
+			}
+			state[key] = json.Number(text)
+		case StateValueTypeString:
+			state[key] = fmt.Sprint(value)
+		case StateValueTypeBool:
+			switch typed := value.(type) {
+			case bool:
+			case json.Number:
+				state[key] = typed.String() != "0"
+			default:
+				return fmt.Errorf("state data %q is not boolean", key)
+			}
+		default:
+			return fmt.Errorf("unsupported state data type %q for %q", valueType, key)
+		}
+	}
+	return nil
+}
+
+func selectMetadataEntry(view RegistryView, output string) (*MetadataEntry, error) {
+	if output == "" {
+		return nil, fmt.Errorf("WASM module returned an empty result")
+	}
+	if hederaTopicIDPattern.MatchString(output) {
+		if entry, ok := view.MetadataForTopic(output); ok {
+			return &entry, nil
+		}
+		return nil, nil
+	}
+	entry, ok := view.MetadataForTag(output)
+	if !ok {
+		return nil, fmt.Errorf("no metadata entry is tagged %q", output)
+	}
+	return &entry, nil
+}
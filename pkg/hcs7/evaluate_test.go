@@ -0,0 +1,409 @@
+package hcs7
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFunctionSelector(t *testing.T) {
+	selector, err := FunctionSelector(AbiDefinition{Name: "totalSupply"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hex.EncodeToString(selector) != "18160ddd" {
+		t.Fatalf("unexpected selector: %x", selector)
+	}
+	if _, err := EncodeFunctionCall(AbiDefinition{Name: "balanceOf", Inputs: []AbiIO{{Type: "address"}}}); err == nil {
+		t.Fatal("expected error for function with inputs")
+	}
+}
+
+func TestDecodeFunctionResult(t *testing.T) {
+	word := func(value string) string {
+		return strings.Repeat("0", 64-len(value)) + value
+	}
+	result := "0x" +
+		word("2a") +
+		strings.Repeat("f", 64) +
+		word("1") +
+		word("1111111111111111111111111111111111111111") +
+		word("a0") +
+		word("5") +
+		"68656c6c6f" + strings.Repeat("0", 54)
+
+	values, err := DecodeFunctionResult(AbiDefinition{
+		Name: "snapshot",
+		Outputs: []AbiIO{
+			{Type: "uint64"},
+			{Type: "int256"},
+			{Type: "bool"},
+			{Type: "address"},
+			{Type: "string"},
+		},
+	}, result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values[0].(*big.Int).Int64() != 42 {
+		t.Fatalf("unexpected uint: %v", values[0])
+	}
+	if values[1].(*big.Int).Int64() != -1 {
+		t.Fatalf("unexpected int: %v", values[1])
+	}
+	if values[2] != true {
+		t.Fatalf("unexpected bool: %v", values[2])
+	}
+	if values[3] != "0x1111111111111111111111111111111111111111" {
+		t.Fatalf("unexpected address: %v", values[3])
+	}
+	if values[4] != "hello" {
+		t.Fatalf("unexpected string: %v", values[4])
+	}
+}
+
+func TestDecodeFunctionResultRejectsHostileLengths(t *testing.T) {
+	word := func(value string) string {
+		return strings.Repeat("0", 64-len(value)) + value
+	}
+	abi := AbiDefinition{Name: "label", Outputs: []AbiIO{{Type: "string"}}}
+	for name, result := range map[string]string{
+		"length": word("20") + word("7fffffffffffffe0"),
+		"offset": word("7fffffffffffffff") + word("0"),
+	} {
+		if _, err := DecodeFunctionResult(abi, "0x"+result); err == nil {
+			t.Fatalf("expected an out of range %s to be rejected", name)
+		}
+	}
+}
+
+func TestEvaluateSelectsByTagAndWeight(t *testing.T) {
+	server := newTestRegistryServer(t, "0x"+strings.Repeat("0", 63)+"7")
+	defer server.Close()
+
+	evaluator := newTestEvaluator(t, server.URL, "odd")
+	result, err := evaluator.Evaluate(context.Background(), "0.0.700")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TopicID != "0.0.803" {
+		t.Fatalf("expected highest-weight odd entry, got %s", result.TopicID)
+	}
+	if result.Entry == nil || result.Entry.SequenceNumber != 5 {
+		t.Fatalf("unexpected entry: %+v", result.Entry)
+	}
+	if result.State["minted"] != json.Number("7") {
+		t.Fatalf("unexpected state: %+v", result.State)
+	}
+	if result.State["0x1111111111111111111111111111111111111111:minted"] != json.Number("7") {
+		t.Fatalf("expected state keyed by contract: %+v", result.State)
+	}
+}
+
+func TestEvaluateSelectsByTopicID(t *testing.T) {
+	server := newTestRegistryServer(t, "0x"+strings.Repeat("0", 64))
+	defer server.Close()
+
+	evaluator := newTestEvaluator(t, server.URL, "0.0.802")
+	result, err := evaluator.Evaluate(context.Background(), "0.0.700")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TopicID != "0.0.802" || result.Entry == nil {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	evaluator = newTestEvaluator(t, server.URL, "missing")
+	if _, err := evaluator.Evaluate(context.Background(), "0.0.700"); err == nil {
+		t.Fatal("expected error for unknown tag")
+	}
+}
+
+func TestPackageEvaluate(t *testing.T) {
+	server := newTestRegistryServer(t, "0x"+strings.Repeat("0", 64))
+	defer server.Close()
+
+	wasm := buildTestProcessStateModule("0.0.801")
+	result, err := Evaluate(context.Background(), "0.0.700", EvaluatorConfig{
+		MirrorBaseURL: server.URL,
+		WasmLoader: func(context.Context, string) ([]byte, error) {
+			return wasm, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TopicID != "0.0.801" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestEvaluateRunsWasmBindgenModule(t *testing.T) {
+	server := newTestRegistryServer(t, "0x"+strings.Repeat("0", 64))
+	defer server.Close()
+
+	wasm := buildTestWasmBindgenProcessStateModule("0.0.801")
+	result, err := Evaluate(context.Background(), "0.0.700", EvaluatorConfig{
+		MirrorBaseURL: server.URL,
+		WasmLoader: func(context.Context, string) ([]byte, error) {
+			return wasm, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.TopicID != "0.0.801" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func newTestEvaluator(t *testing.T, mirrorURL string, output string) *Evaluator {
+	t.Helper()
+	wasm := buildTestProcessStateModule(output)
+	evaluator, err := NewEvaluator(EvaluatorConfig{
+		MirrorBaseURL: mirrorURL,
+		WasmLoader: func(_ context.Context, topicID string) ([]byte, error) {
+			if topicID != "0.0.900" {
+				t.Fatalf("unexpected WASM topic %s", topicID)
+			}
+			return wasm, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected evaluator error: %v", err)
+	}
+	return evaluator
+}
+
+func newTestRegistryServer(t *testing.T, callResult string) *httptest.Server {
+	t.Helper()
+	encode := func(payload map[string]any) string {
+		raw, _ := json.Marshal(payload)
+		return base64.StdEncoding.EncodeToString(raw)
+	}
+	metadata := func(topicID string, weight int, tag string) map[string]any {
+		return map[string]any{
+			"p": "hcs-7", "op": "register", "t_id": topicID,
+			"d": map[string]any{"weight": weight, "tags": []string{tag}},
+		}
+	}
+	messages := []map[string]any{
+		{"sequence_number": 1, "message": encode(map[string]any{
+			"p": "hcs-7", "op": "register-config", "t": "evm",
+			"c": map[string]any{
+				"contractAddress": "0x1111111111111111111111111111111111111111",
+				"abi": map[string]any{
+					"name": "minted", "inputs": []any{}, "outputs": []any{map[string]any{"name": "", "type": "uint64"}},
+					"stateMutability": "view", "type": "function",
+				},
+			},
+		})},
+		{"sequence_number": 2, "message": encode(map[string]any{
+			"p": "hcs-7", "op": "register-config", "t": "wasm",
+			"c": map[string]any{
+				"wasmTopicId": "0.0.900",
+				"inputType":   map[string]any{"stateData": map[string]any{"minted": "number"}},
+				"outputType":  map[string]any{"type": "string", "format": "topic-id"},
+			},
+		})},
+		{"sequence_number": 3, "message": encode(metadata("0.0.801", 1, "odd"))},
+		{"sequence_number": 4, "message": encode(metadata("0.0.802", 1, "even"))},
+		{"sequence_number": 5, "message": encode(metadata("0.0.803", 5, "odd"))},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		switch request.URL.Path {
+		case "/api/v1/topics/0.0.700":
+			_ = json.NewEncoder(writer).Encode(map[string]any{"memo": "hcs-7:indexed:86400"})
+		case "/api/v1/topics/0.0.700/messages":
+			_ = json.NewEncoder(writer).Encode(map[string]any{"messages": messages})
+		case "/api/v1/contracts/call":
+			var body map[string]any
+			_ = json.NewDecoder(request.Body).Decode(&body)
+			if request.Method != http.MethodPost || body["data"] != "0x4f02c420" {
+				http.Error(writer, "unexpected call", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(writer).Encode(map[string]any{"result": callResult})
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+}
+
+// buildTestProcessStateModule assembles a module whose process_state always
+// returns output.
+func buildTestProcessStateModule(output string) []byte {
+	const outputOffset = 16
+	const heapStart = 1024
+
+	uleb := func(value int) []byte {
+		encoded := []byte{}
+		for {
+			current := byte(value & 0x7f)
+			value >>= 7
+			if value != 0 {
+				current |= 0x80
+			}
+			encoded = append(encoded, current)
+			if value == 0 {
+				return encoded
+			}
+		}
+	}
+	sleb := func(value int) []byte {
+		encoded := []byte{}
+		for {
+			current := byte(value & 0x7f)
+			value >>= 7
+			done := value == 0 && current&0x40 == 0
+			if !done {
+				current |= 0x80
+			}
+			encoded = append(encoded, current)
+			if done {
+				return encoded
+			}
+		}
+	}
+	vector := func(items ...[]byte) []byte {
+		encoded := uleb(len(items))
+		for _, item := range items {
+			encoded = append(encoded, item...)
+		}
+		return encoded
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(len(content))...), content...)
+	}
+	name := func(value string) []byte {
+		return append(uleb(len(value)), value...)
+	}
+	code := func(body []byte) []byte {
+		entry := append(append([]byte{0x00}, body...), 0x0b)
+		return append(uleb(len(entry)), entry...)
+	}
+
+	allocBody := []byte{0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00}
+	processBody := append([]byte{0x41}, sleb(outputOffset)...)
+	processBody = append(processBody, 0xad, 0x42, 0x20, 0x86, 0x41)
+	processBody = append(processBody, sleb(len(output))...)
+	processBody = append(processBody, 0xad, 0x84)
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01, vector(
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7e},
+	))...)
+	module = append(module, section(0x03, vector([]byte{0x00}, []byte{0x01}))...)
+	module = append(module, section(0x05, vector([]byte{0x00, 0x01}))...)
+	module = append(module, section(0x06, vector(append(append([]byte{0x7f, 0x01, 0x41}, sleb(heapStart)...), 0x0b)))...)
+	module = append(module, section(0x07, vector(
+		append(name("memory"), 0x02, 0x00),
+		append(name("alloc"), 0x00, 0x00),
+		append(name("process_state"), 0x00, 0x01),
+	))...)
+	module = append(module, section(0x0a, vector(code(allocBody), code(processBody)))...)
+	dataSegment := append(append([]byte{0x00, 0x41}, sleb(outputOffset)...), 0x0b)
+	dataSegment = append(dataSegment, name(output)...)
+	module = append(module, section(0x0b, vector(dataSegment))...)
+	return module
+}
+
+// buildTestWasmBindgenProcessStateModule assembles a module shaped like
+// wasm-bindgen output: it imports __wbindgen_throw, allocates through
+// __wbindgen_malloc(size, align) and returns output through a retptr.
+func buildTestWasmBindgenProcessStateModule(output string) []byte {
+	const outputOffset = 16
+	const heapStart = 1024
+	const stackStart = 65536
+
+	uleb := func(value int) []byte {
+		encoded := []byte{}
+		for {
+			current := byte(value & 0x7f)
+			value >>= 7
+			if value != 0 {
+				current |= 0x80
+			}
+			encoded = append(encoded, current)
+			if value == 0 {
+				return encoded
+			}
+		}
+	}
+	sleb := func(value int) []byte {
+		encoded := []byte{}
+		for {
+			current := byte(value & 0x7f)
+			value >>= 7
+			done := value == 0 && current&0x40 == 0
+			if !done {
+				current |= 0x80
+			}
+			encoded = append(encoded, current)
+			if done {
+				return encoded
+			}
+		}
+	}
+	vector := func(items ...[]byte) []byte {
+		encoded := uleb(len(items))
+		for _, item := range items {
+			encoded = append(encoded, item...)
+		}
+		return encoded
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(len(content))...), content...)
+	}
+	name := func(value string) []byte {
+		return append(uleb(len(value)), value...)
+	}
+	code := func(body []byte) []byte {
+		entry := append(append([]byte{0x00}, body...), 0x0b)
+		return append(uleb(len(entry)), entry...)
+	}
+	mutableGlobal := func(value int) []byte {
+		return append(append([]byte{0x7f, 0x01, 0x41}, sleb(value)...), 0x0b)
+	}
+
+	mallocBody := []byte{0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00}
+	stackPointerBody := []byte{0x23, 0x01, 0x20, 0x00, 0x6a, 0x24, 0x01, 0x23, 0x01}
+	processBody := append([]byte{0x20, 0x00, 0x41}, sleb(outputOffset)...)
+	processBody = append(processBody, 0x36, 0x02, 0x00, 0x20, 0x00, 0x41)
+	processBody = append(processBody, sleb(len(output))...)
+	processBody = append(processBody, 0x36, 0x02, 0x04)
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01, vector(
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x00},
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x05, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x00},
+	))...)
+	module = append(module, section(0x02, vector(
+		append(append(name("__wbindgen_placeholder__"), name("__wbindgen_throw")...), 0x00, 0x00),
+	))...)
+	module = append(module, section(0x03, vector([]byte{0x01}, []byte{0x02}, []byte{0x03}))...)
+	module = append(module, section(0x05, vector([]byte{0x00, 0x01}))...)
+	module = append(module, section(0x06, vector(mutableGlobal(heapStart), mutableGlobal(stackStart)))...)
+	module = append(module, section(0x07, vector(
+		append(name("memory"), 0x02, 0x00),
+		append(name("__wbindgen_malloc"), 0x00, 0x01),
+		append(name("__wbindgen_add_to_stack_pointer"), 0x00, 0x02),
+		append(name("process_state"), 0x00, 0x03),
+	))...)
+	module = append(module, section(0x0a, vector(code(mallocBody), code(stackPointerBody), code(processBody)))...)
+	dataSegment := append(append([]byte{0x00, 0x41}, sleb(outputOffset)...), 0x0b)
+	dataSegment = append(dataSegment, name(output)...)
+	module = append(module, section(0x0b, vector(dataSegment))...)
+	return module
+}
@@ -0,0 +1,287 @@
+package hcs7
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EvmConfig returns the message's EVM config when it is a register-config evm message.
+func (m Message) EvmConfig() (EvmConfigPayload, bool) {
+	if m.Op != OperationRegisterConfig || m.Type != ConfigTypeEVM {
+		return EvmConfigPayload{}, false
+	}
+	config, ok := m.Config.(EvmConfigPayload)
+	return config, ok
+}
+
+// EvmStateKey returns the key that identifies an EVM config within a registry:
+// the lower-cased contract address and the function name, joined by ":". Two
+// configs calling the same function on different contracts get distinct keys.
+func EvmStateKey(config EvmConfigPayload) string {
+	return strings.ToLower(strings.TrimSpace(config.ContractAddress)) + ":" + config.Abi.Name
+}
+
+// WasmConfig returns the message's WASM config when it is a register-config wasm message.
+func (m Message) WasmConfig() (WasmConfigPayload, bool) {
+	if m.Op != OperationRegisterConfig || m.Type != ConfigTypeWASM {
+		return WasmConfigPayload{}, false
+	}
+	config, ok := m.Config.(WasmConfigPayload)
+	return config, ok
+}
+
+// ParseMetadataData converts a register message's d object into MetadataData.
+// Keys other than weight and tags are kept in Extra.
+func ParseMetadataData(data map[string]any) (MetadataData, error) {
+	if data == nil {
+		return MetadataData{}, fmt.Errorf("metadata data is required")
+	}
+	if !isNumeric(data["weight"]) {
+		return MetadataData{}, fmt.Errorf("metadata weight must be numeric")
+	}
+	if !isStringSlice(data["tags"]) {
+		return MetadataData{}, fmt.Errorf("metadata tags must be an array of strings")
+	}
+
+	weight, tags := metadataWeightAndTags(data)
+	metadata := MetadataData{
+		Weight: int64(weight),
+		Tags:   tags,
+	}
+	for key, value := range data {
+		if key == "weight" || key == "tags" {
+			continue
+		}
+		if metadata.Extra == nil {
+			metadata.Extra = map[string]any{}
+		}
+		metadata.Extra[key] = value
+	}
+	return metadata, nil
+}
+
+// MarshalJSON flattens Extra alongside weight and tags, matching the d object.
+func (m MetadataData) MarshalJSON() ([]byte, error) {
+	payload := make(map[string]any, len(m.Extra)+2)
+	for key, value := range m.Extra {
+		payload[key] = value
+	}
+	payload["weight"] = m.Weight
+	payload["tags"] = m.Tags
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON reads a d object, keeping unknown keys in Extra.
+func (m *MetadataData) UnmarshalJSON(raw []byte) error {
+	var payload map[string]any
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return err
+	}
+	parsed, err := ParseMetadataData(payload)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// GetRegistryView reads the registry and returns its typed, tag-grouped view.
+func (c *Client) GetRegistryView(ctx context.Context, topicID string, options QueryRegistryOptions) (RegistryView, error) {
+	registry, err := c.GetRegistry(ctx, topicID, options)
+	if err != nil {
+		return RegistryView{}, err
+	}
+	return NewRegistryView(registry), nil
+}
+
+// NewRegistryView builds a typed view of the registry entries.
+//
+// Each WASM config lists, as Inputs, the latest EVM configs for every state
+// key it declares. A state key is either an EvmStateKey or a bare function
+// name; a bare name links every contract exposing that function. Each metadata entry links to the WASM config that was
+// active when it was registered. Tag groups are ordered by descending weight,
+// newest first on ties, which is the order Evaluate selects in.
+func NewRegistryView(registry RegistryTopic) RegistryView {
+	view := RegistryView{
+		TopicID:     registry.TopicID,
+		TTL:         registry.TTL,
+		EvmConfigs:  []EvmConfigEntry{},
+		WasmConfigs: []WasmConfigEntry{},
+		Metadata:    []MetadataEntry{},
+		Tags:        map[string][]MetadataEntry{},
+	}
+
+	latestEVM := map[string]EvmConfigEntry{}
+	latestKeys := []string{}
+	for _, entry := range registry.Entries {
+		if config, ok := entry.Message.EvmConfig(); ok {
+			evmEntry := EvmConfigEntry{
+				SequenceNumber: entry.SequenceNumber,
+				Timestamp:      entry.Timestamp,
+				Memo:           entry.Message.Memo,
+				Config:         config,
+			}
+			view.EvmConfigs = append(view.EvmConfigs, evmEntry)
+			key := EvmStateKey(config)
+			if _, seen := latestEVM[key]; !seen {
+				latestKeys = append(latestKeys, key)
+			}
+			latestEVM[key] = evmEntry
+		}
+	}
+
+	var activeWasm *WasmConfigEntry
+	for _, entry := range registry.Entries {
+		if config, ok := entry.Message.WasmConfig(); ok {
+			wasmEntry := WasmConfigEntry{
+				SequenceNumber: entry.SequenceNumber,
+				Timestamp:      entry.Timestamp,
+				Memo:           entry.Message.Memo,
+				Config:         config,
+			}
+			stateKeys := make([]string, 0, len(config.InputType.StateData))
+			for key := range config.InputType.StateData {
+				stateKeys = append(stateKeys, key)
+			}
+			sort.Strings(stateKeys)
+			for _, key := range stateKeys {
+				wasmEntry.Inputs = append(wasmEntry.Inputs, evmConfigsForStateKey(latestEVM, latestKeys, key)...)
+			}
+			view.WasmConfigs = append(view.WasmConfigs, wasmEntry)
+			activeWasm = &view.WasmConfigs[len(view.WasmConfigs)-1]
+			continue
+		}
+
+		if entry.Message.Op != OperationRegister {
+			continue
+		}
+		metadata := entry.Metadata
+		if metadata == nil {
+			parsed, err := ParseMetadataData(entry.Message.Data)
+			if err != nil {
+				continue
+			}
+			metadata = &parsed
+		}
+		metadataEntry := MetadataEntry{
+			SequenceNumber: entry.SequenceNumber,
+			Timestamp:      entry.Timestamp,
+			Payer:          entry.Payer,
+			TopicID:        entry.Message.TopicID,
+			Memo:           entry.Message.Memo,
+			Metadata:       *metadata,
+		}
+		if activeWasm != nil {
+			linked := *activeWasm
+			metadataEntry.WasmConfig = &linked
+		}
+		view.Metadata = append(view.Metadata, metadataEntry)
+		for _, tag := range metadata.Tags {
+			view.Tags[tag] = append(view.Tags[tag], metadataEntry)
+		}
+	}
+
+	for tag := range view.Tags {
+		group := view.Tags[tag]
+		sort.SliceStable(group, func(left, right int) bool {
+			if group[left].Metadata.Weight != group[right].Metadata.Weight {
+				return group[left].Metadata.Weight > group[right].Metadata.Weight
+			}
+			return group[left].SequenceNumber > group[right].SequenceNumber
+		})
+	}
+	return view
+}
+
+// ActiveWasmConfig returns the most recently registered WASM config.
+func (v RegistryView) ActiveWasmConfig() (WasmConfigEntry, bool) {
+	if len(v.WasmConfigs) == 0 {
+		return WasmConfigEntry{}, false
+	}
+	return v.WasmConfigs[len(v.WasmConfigs)-1], true
+}
+
+// LatestEvmConfigs returns the most recent EVM config for each EvmStateKey,
+// in the order the keys were first registered.
+func (v RegistryView) LatestEvmConfigs() []EvmConfigEntry {
+	latest := map[string]EvmConfigEntry{}
+	keys := []string{}
+	for _, entry := range v.EvmConfigs {
+		key := EvmStateKey(entry.Config)
+		if _, seen := latest[key]; !seen {
+			keys = append(keys, key)
+		}
+		latest[key] = entry
+	}
+	configs := make([]EvmConfigEntry, 0, len(keys))
+	for _, key := range keys {
+		configs = append(configs, latest[key])
+	}
+	return configs
+}
+
+// MetadataForTopic returns the latest metadata entry registered for the topic ID.
+func (v RegistryView) MetadataForTopic(topicID string) (MetadataEntry, bool) {
+	for index := len(v.Metadata) - 1; index >= 0; index-- {
+		if v.Metadata[index].TopicID == topicID {
+			return v.Metadata[index], true
+		}
+	}
+	return MetadataEntry{}, false
+}
+
+// MetadataForTag returns the preferred metadata entry carrying the tag.
+func (v RegistryView) MetadataForTag(tag string) (MetadataEntry, bool) {
+	group := v.Tags[tag]
+	if len(group) == 0 {
+		return MetadataEntry{}, false
+	}
+	return group[0], true
+}
+
+func evmConfigsForStateKey(latest map[string]EvmConfigEntry, keys []string, stateKey string) []EvmConfigEntry {
+	if entry, ok := latest[stateKey]; ok {
+		return []EvmConfigEntry{entry}
+	}
+	matches := []EvmConfigEntry{}
+	for _, key := range keys {
+		if latest[key].Config.Abi.Name == stateKey {
+			matches = append(matches, latest[key])
+		}
+	}
+	return matches
+}
+
+func metadataWeightAndTags(data map[string]any) (float64, []string) {
+	weight := 0.0
+	switch typed := data["weight"].(type) {
+	case float64:
+		weight = typed
+	case int64:
+		weight = float64(typed)
+	case int:
+		weight = float64(typed)
+	case int32:
+		weight = float64(typed)
+	case uint64:
+		weight = float64(typed)
+	case float32:
+		weight = float64(typed)
+	}
+
+	tags := []string{}
+	switch typed := data["tags"].(type) {
+	case []string:
+		tags = typed
+	case []any:
+		for _, item := range typed {
+			if tag, ok := item.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return weight, tags
+}
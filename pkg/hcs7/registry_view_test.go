@@ -0,0 +1,133 @@
+package hcs7
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMetadataDataKeepsExtra(t *testing.T) {
+	metadata, err := ParseMetadataData(map[string]any{
+		"weight": float64(3),
+		"tags":   []any{"odd"},
+		"name":   "Odd",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metadata.Weight != 3 || len(metadata.Tags) != 1 || metadata.Extra["name"] != "Odd" {
+		t.Fatalf("unexpected metadata: %+v", metadata)
+	}
+
+	encoded, _ := json.Marshal(metadata)
+	var decoded MetadataData
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Extra["name"] != "Odd" || decoded.Weight != 3 {
+		t.Fatalf("unexpected round trip: %+v", decoded)
+	}
+
+	if _, err := ParseMetadataData(map[string]any{"weight": "x", "tags": []any{"a"}}); err == nil {
+		t.Fatal("expected error for non-numeric weight")
+	}
+}
+
+func TestNewRegistryViewGroupsAndLinks(t *testing.T) {
+	evm := EvmConfigPayload{
+		ContractAddress: "0x1111111111111111111111111111111111111111",
+		Abi:             AbiDefinition{Name: "minted", Outputs: []AbiIO{{Type: "uint64"}}},
+	}
+	wasm := WasmConfigPayload{
+		WasmTopicID: "0.0.900",
+		InputType:   WasmInputType{StateData: map[string]StateValueType{"minted": StateValueTypeNumber}},
+		OutputType:  WasmOutputType{Type: "string", Format: "topic-id"},
+	}
+	register := func(sequence int64, topicID string, weight int64, tag string) RegistryEntry {
+		return RegistryEntry{
+			SequenceNumber: sequence,
+			Message: Message{
+				P:       "hcs-7",
+				Op:      OperationRegister,
+				TopicID: topicID,
+				Data:    map[string]any{"weight": float64(weight), "tags": []any{tag}},
+			},
+		}
+	}
+
+	view := NewRegistryView(RegistryTopic{
+		TopicID: "0.0.700",
+		Entries: []RegistryEntry{
+			register(1, "0.0.800", 9, "early"),
+			{SequenceNumber: 2, Message: Message{P: "hcs-7", Op: OperationRegisterConfig, Type: ConfigTypeEVM, Config: evm}},
+			{SequenceNumber: 3, Message: Message{P: "hcs-7", Op: OperationRegisterConfig, Type: ConfigTypeWASM, Config: wasm}},
+			register(4, "0.0.801", 1, "odd"),
+			register(5, "0.0.802", 5, "odd"),
+			register(6, "0.0.803", 5, "odd"),
+		},
+	})
+
+	if len(view.EvmConfigs) != 1 || len(view.WasmConfigs) != 1 || len(view.Metadata) != 4 {
+		t.Fatalf("unexpected view: %+v", view)
+	}
+	active, ok := view.ActiveWasmConfig()
+	if !ok || len(active.Inputs) != 1 || active.Inputs[0].Config.Abi.Name != "minted" {
+		t.Fatalf("unexpected active WASM config: %+v", active)
+	}
+
+	odd := view.Tags["odd"]
+	if len(odd) != 3 || odd[0].TopicID != "0.0.803" || odd[1].TopicID != "0.0.802" || odd[2].TopicID != "0.0.801" {
+		t.Fatalf("unexpected odd group order: %+v", odd)
+	}
+	if odd[0].WasmConfig == nil || odd[0].WasmConfig.SequenceNumber != 3 {
+		t.Fatalf("expected odd entries to link to WASM config: %+v", odd[0])
+	}
+	if early, _ := view.MetadataForTag("early"); early.WasmConfig != nil {
+		t.Fatal("expected entry registered before any config to be unlinked")
+	}
+	if entry, ok := view.MetadataForTopic("0.0.801"); !ok || entry.Metadata.Weight != 1 {
+		t.Fatalf("unexpected topic lookup: %+v", entry)
+	}
+}
+
+func TestNewRegistryViewKeysEvmConfigsByContract(t *testing.T) {
+	balanceOf := func(address string) EvmConfigPayload {
+		return EvmConfigPayload{
+			ContractAddress: address,
+			Abi:             AbiDefinition{Name: "balanceOf", Outputs: []AbiIO{{Type: "uint256"}}},
+		}
+	}
+	first := balanceOf("0x1111111111111111111111111111111111111111")
+	second := balanceOf("0x2222222222222222222222222222222222222222")
+	wasm := WasmConfigPayload{
+		WasmTopicID: "0.0.900",
+		InputType: WasmInputType{StateData: map[string]StateValueType{
+			"balanceOf":         StateValueTypeNumber,
+			EvmStateKey(second): StateValueTypeNumber,
+		}},
+	}
+	config := func(sequence int64, configType ConfigType, payload any) RegistryEntry {
+		return RegistryEntry{
+			SequenceNumber: sequence,
+			Message:        Message{P: "hcs-7", Op: OperationRegisterConfig, Type: configType, Config: payload},
+		}
+	}
+
+	view := NewRegistryView(RegistryTopic{
+		TopicID: "0.0.700",
+		Entries: []RegistryEntry{
+			config(1, ConfigTypeEVM, first),
+			config(2, ConfigTypeEVM, second),
+			config(3, ConfigTypeEVM, first),
+			config(4, ConfigTypeWASM, wasm),
+		},
+	})
+
+	latest := view.LatestEvmConfigs()
+	if len(latest) != 2 || latest[0].SequenceNumber != 3 || latest[1].SequenceNumber != 2 {
+		t.Fatalf("unexpected latest EVM configs: %+v", latest)
+	}
+	active, _ := view.ActiveWasmConfig()
+	if len(active.Inputs) != 3 {
+		t.Fatalf("expected both contracts for the bare name and one for the keyed input, got %+v", active.Inputs)
+	}
+}
@@ -1,6 +1,14 @@
 package hcs7
 
-import hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+import (
+	"context"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/mirror"
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+)
 
 type Operation string
 
@@ -82,10 +90,13 @@ type TopicMemo struct {
 type ClientConfig struct {
 	OperatorAccountID  string
 	OperatorPrivateKey string
+	OperatorSigner     shared.Signer
 	Network            string
 	MirrorBaseURL      string
 	MirrorAPIKey       string
 	HederaClient       *hedera.Client
+	// Executor defaults to shared.DefaultExecutor.
+	Executor *shared.Executor
 }
 
 type CreateRegistryOptions struct {
@@ -125,10 +136,11 @@ type RegistryOperationResult struct {
 }
 
 type RegistryEntry struct {
-	SequenceNumber int64   `json:"sequence_number"`
-	Timestamp      string  `json:"timestamp"`
-	Payer          string  `json:"payer"`
-	Message        Message `json:"message"`
+	SequenceNumber int64         `json:"sequence_number"`
+	Timestamp      string        `json:"timestamp"`
+	Payer          string        `json:"payer"`
+	Message        Message       `json:"message"`
+	Metadata       *MetadataData `json:"metadata,omitempty"`
 }
 
 type RegistryTopic struct {
@@ -155,3 +167,58 @@ type CreateRegistryTxParams struct {
 	AdminKey  hedera.Key
 	SubmitKey hedera.Key
 }
+
+// WasmLoaderFunc returns the WASM bytes stored on an HCS-1 topic.
+type WasmLoaderFunc func(ctx context.Context, topicID string) ([]byte, error)
+
+type EvaluatorConfig struct {
+	Network          string
+	MirrorBaseURL    string
+	MirrorAPIKey     string
+	MirrorClient     *mirror.Client
+	WasmLoader       WasmLoaderFunc
+	Timeout          time.Duration
+	MemoryLimitPages uint32
+}
+
+type EvaluationResult struct {
+	RegistryTopicID string         `json:"registry_topic_id"`
+	TopicID         string         `json:"topic_id"`
+	Output          string         `json:"output"`
+	State           map[string]any `json:"state"`
+	Entry           *MetadataEntry `json:"entry,omitempty"`
+}
+
+type EvmConfigEntry struct {
+	SequenceNumber int64            `json:"sequence_number"`
+	Timestamp      string           `json:"timestamp"`
+	Memo           string           `json:"memo,omitempty"`
+	Config         EvmConfigPayload `json:"config"`
+}
+
+type WasmConfigEntry struct {
+	SequenceNumber int64             `json:"sequence_number"`
+	Timestamp      string            `json:"timestamp"`
+	Memo           string            `json:"memo,omitempty"`
+	Config         WasmConfigPayload `json:"config"`
+	Inputs         []EvmConfigEntry  `json:"inputs,omitempty"`
+}
+
+type MetadataEntry struct {
+	SequenceNumber int64            `json:"sequence_number"`
+	Timestamp      string           `json:"timestamp"`
+	Payer          string           `json:"payer"`
+	TopicID        string           `json:"t_id"`
+	Memo           string           `json:"memo,omitempty"`
+	Metadata       MetadataData     `json:"metadata"`
+	WasmConfig     *WasmConfigEntry `json:"wasm_config,omitempty"`
+}
+
+type RegistryView struct {
+	TopicID     string                     `json:"topic_id"`
+	TTL         int64                      `json:"ttl,omitempty"`
+	EvmConfigs  []EvmConfigEntry           `json:"evm_configs"`
+	WasmConfigs []WasmConfigEntry          `json:"wasm_configs"`
+	Metadata    []MetadataEntry            `json:"metadata"`
+	Tags        map[string][]MetadataEntry `json:"tags"`
+}
@@ -0,0 +1,252 @@
+package hcs7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+const (
+	wasmExportMemory       = "memory"
+	wasmExportAlloc        = "alloc"
+	wasmExportProcessState = "process_state"
+
+	wasmBindgenExportMalloc        = "__wbindgen_malloc"
+	wasmBindgenExportStackPointer  = "__wbindgen_add_to_stack_pointer"
+	wasmBindgenExportStart         = "__wbindgen_start"
+	wasmBindgenImportThrow         = "__wbindgen_throw"
+	wasmBindgenImportInitExternref = "__wbindgen_init_externref_table"
+)
+
+// runProcessState executes process_state in a fresh runtime.
+//
+// A plain module must export "memory", "alloc(len i32) i32" and
+// "process_state(state_ptr, state_len, messages_ptr, messages_len i32) i64",
+// where the result packs ptr<<32|len of the UTF-8 output string, and must
+// not import anything.
+//
+// A wasm-bindgen module, recognised by its "__wbindgen_malloc" export, must
+// export process_state(state: &str, messages: &str) -> String. Its arguments
+// are copied in with __wbindgen_malloc and its result is read from the
+// (ptr, len) pair it returns or, when it returns nothing, from the return
+// pointer reserved with __wbindgen_add_to_stack_pointer and passed first. Its
+// __wbindgen_* and __wbg_* glue imports are provided by the host:
+// __wbindgen_throw fails with the thrown message and imports that need a
+// JavaScript value fail when reached.
+func runProcessState(
+	ctx context.Context,
+	wasmBytes []byte,
+	memoryLimitPages uint32,
+	stateJSON []byte,
+	messagesJSON []byte,
+) (string, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimitPages).
+		WithCloseOnContextDone(true))
+	defer runtime.Close(context.Background())
+
+	compiled, err := runtime.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to compile WASM module: %w", err)
+	}
+	bindgen, err := validateProcessStateModule(compiled)
+	if err != nil {
+		return "", err
+	}
+	if bindgen {
+		if err := instantiateWasmBindgenGlue(ctx, runtime, compiled); err != nil {
+			return "", err
+		}
+	}
+
+	instance, err := runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return "", fmt.Errorf("failed to instantiate WASM module: %w", err)
+	}
+
+	processState := instance.ExportedFunction(wasmExportProcessState)
+	retptr := bindgen && len(processState.Definition().ResultTypes()) == 0
+	params := make([]uint64, 0, 5)
+	if bindgen {
+		if start := instance.ExportedFunction(wasmBindgenExportStart); start != nil {
+			if _, err := start.Call(ctx); err != nil {
+				return "", wrapWasmError(ctx, err)
+			}
+		}
+		if retptr {
+			results, err := instance.ExportedFunction(wasmBindgenExportStackPointer).Call(ctx, api.EncodeI32(-16))
+			if err != nil {
+				return "", wrapWasmError(ctx, err)
+			}
+			params = append(params, uint64(uint32(results[0])))
+		}
+	}
+	for _, argument := range [][]byte{stateJSON, messagesJSON} {
+		pointer, err := writeWasmBytes(ctx, instance, bindgen, argument)
+		if err != nil {
+			return "", wrapWasmError(ctx, err)
+		}
+		params = append(params, uint64(pointer), uint64(len(argument)))
+	}
+
+	results, err := processState.Call(ctx, params...)
+	if err != nil {
+		return "", wrapWasmError(ctx, err)
+	}
+
+	var pointer, length uint32
+	switch {
+	case retptr:
+		var okPointer, okLength bool
+		pointer, okPointer = instance.Memory().ReadUint32Le(uint32(params[0]))
+		length, okLength = instance.Memory().ReadUint32Le(uint32(params[0]) + 4)
+		if !okPointer || !okLength {
+			return "", fmt.Errorf("process_state returned out-of-bounds return pointer")
+		}
+	case bindgen:
+		pointer, length = uint32(results[0]), uint32(results[1])
+	default:
+		if len(results) != 1 {
+			return "", fmt.Errorf("process_state returned %d values, expected 1", len(results))
+		}
+		pointer, length = uint32(results[0]>>32), uint32(results[0])
+	}
+	output, ok := instance.Memory().Read(pointer, length)
+	if !ok {
+		return "", fmt.Errorf("process_state returned out-of-bounds result")
+	}
+	return string(output), nil
+}
+
+// validateProcessStateModule checks the imports and exports of compiled and
+// reports whether it follows the wasm-bindgen convention.
+func validateProcessStateModule(compiled wazero.CompiledModule) (bool, error) {
+	imports := compiled.ImportedFunctions()
+	for _, definition := range imports {
+		moduleName, name, _ := definition.Import()
+		if !isWasmBindgenImport(moduleName, name) {
+			return false, fmt.Errorf("WASM module must not import host functions, found %s.%s", moduleName, name)
+		}
+	}
+	if _, ok := compiled.ExportedMemories()[wasmExportMemory]; !ok {
+		return false, fmt.Errorf("WASM module must export memory")
+	}
+
+	exports := compiled.ExportedFunctions()
+	processState, ok := exports[wasmExportProcessState]
+	if !ok {
+		return false, fmt.Errorf("WASM module must export %s", wasmExportProcessState)
+	}
+	malloc, bindgen := exports[wasmBindgenExportMalloc]
+	if !bindgen {
+		if len(imports) > 0 {
+			moduleName, name, _ := imports[0].Import()
+			return false, fmt.Errorf("WASM module imports %s.%s but does not export %s", moduleName, name, wasmBindgenExportMalloc)
+		}
+		if _, ok := exports[wasmExportAlloc]; !ok {
+			return false, fmt.Errorf("WASM module must export %s", wasmExportAlloc)
+		}
+		return false, nil
+	}
+
+	if paramCount := len(malloc.ParamTypes()); paramCount != 1 && paramCount != 2 {
+		return true, fmt.Errorf("WASM export %s must take 1 or 2 parameters", wasmBindgenExportMalloc)
+	}
+	switch {
+	case len(processState.ResultTypes()) == 2 && len(processState.ParamTypes()) == 4:
+	case len(processState.ResultTypes()) == 0 && len(processState.ParamTypes()) == 5:
+		if _, ok := exports[wasmBindgenExportStackPointer]; !ok {
+			return true, fmt.Errorf("WASM module must export %s", wasmBindgenExportStackPointer)
+		}
+	default:
+		return true, fmt.Errorf("WASM export %s must take two strings and return a string", wasmExportProcessState)
+	}
+	return true, nil
+}
+
+func isWasmBindgenImport(moduleName string, name string) bool {
+	return moduleName == "__wbindgen_placeholder__" ||
+		moduleName == "__wbindgen_externref_xform__" ||
+		strings.HasPrefix(name, "__wbindgen_") ||
+		strings.HasPrefix(name, "__wbg_")
+}
+
+// instantiateWasmBindgenGlue instantiates host modules satisfying every glue
+// import of compiled.
+func instantiateWasmBindgenGlue(ctx context.Context, runtime wazero.Runtime, compiled wazero.CompiledModule) error {
+	builders := map[string]wazero.HostModuleBuilder{}
+	for _, definition := range compiled.ImportedFunctions() {
+		moduleName, name, _ := definition.Import()
+		builder, ok := builders[moduleName]
+		if !ok {
+			builder = runtime.NewHostModuleBuilder(moduleName)
+			builders[moduleName] = builder
+		}
+		builder.NewFunctionBuilder().
+			WithGoModuleFunction(wasmBindgenGlue(name), definition.ParamTypes(), definition.ResultTypes()).
+			Export(name)
+	}
+	for moduleName, builder := range builders {
+		if _, err := builder.Instantiate(ctx); err != nil {
+			return fmt.Errorf("failed to provide wasm-bindgen imports from %s: %w", moduleName, err)
+		}
+	}
+	return nil
+}
+
+// wasmBindgenGlue implements a wasm-bindgen import. Only the imports that do
+// not need a JavaScript value have an implementation; the rest fail the call.
+func wasmBindgenGlue(name string) api.GoModuleFunc {
+	switch name {
+	case wasmBindgenImportThrow:
+		return func(_ context.Context, module api.Module, stack []uint64) {
+			if len(stack) < 2 {
+				panic(fmt.Errorf("%s called with %d arguments", name, len(stack)))
+			}
+			message, _ := module.Memory().Read(api.DecodeU32(stack[0]), api.DecodeU32(stack[1]))
+			panic(fmt.Errorf("process_state threw: %s", message))
+		}
+	case wasmBindgenImportInitExternref:
+		return func(context.Context, api.Module, []uint64) {}
+	default:
+		return func(context.Context, api.Module, []uint64) {
+			panic(fmt.Errorf("wasm-bindgen import %s needs a JavaScript host", name))
+		}
+	}
+}
+
+// writeWasmBytes copies value into the instance through alloc or, for
+// wasm-bindgen modules, __wbindgen_malloc with an alignment of 1.
+func writeWasmBytes(ctx context.Context, instance api.Module, bindgen bool, value []byte) (uint32, error) {
+	allocator := instance.ExportedFunction(wasmExportAlloc)
+	allocParams := []uint64{uint64(len(value))}
+	if bindgen {
+		allocator = instance.ExportedFunction(wasmBindgenExportMalloc)
+		if len(allocator.Definition().ParamTypes()) == 2 {
+			allocParams = append(allocParams, 1)
+		}
+	}
+	results, err := allocator.Call(ctx, allocParams...)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) != 1 {
+		return 0, fmt.Errorf("alloc returned %d values, expected 1", len(results))
+	}
+	pointer := uint32(results[0])
+	if !instance.Memory().Write(pointer, value) {
+		return 0, fmt.Errorf("alloc returned out-of-bounds pointer %d", pointer)
+	}
+	return pointer, nil
+}
+
+func wrapWasmError(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("process_state exceeded time limit: %w", context.DeadlineExceeded)
+	}
+	return fmt.Errorf("process_state failed: %w", err)
+}
@@ -45,6 +45,25 @@ func (c *AuthClient) Authenticate(
 	privateKey string,
 	network Network,
 ) (AuthResult, error) {
+	key, err := shared.ParsePrivateKey(privateKey)
+	if err != nil {
+		return AuthResult{}, err
+	}
+	return c.AuthenticateWithSigner(ctx, accountID, shared.NewLocalSigner(key), network)
+}
+
+// AuthenticateWithSigner authenticates like Authenticate, signing the
+// challenge with signer so the account key can stay outside the process.
+func (c *AuthClient) AuthenticateWithSigner(
+	ctx context.Context,
+	accountID string,
+	signer shared.Signer,
+	network Network,
+) (AuthResult, error) {
+	if signer == nil {
+		return AuthResult{}, fmt.Errorf("signer is required")
+	}
+
 	requestSignatureURL := c.baseURL + "/api/auth/request-signature"
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestSignatureURL, nil)
 	if err != nil {
@@ -85,13 +104,11 @@ func (c *AuthClient) Authenticate(
 		return AuthResult{}, err
 	}
 
-	key, err := shared.ParsePrivateKey(privateKey)
+	signatureBytes, err := signer.Sign(ctx, []byte(signingPayload))
 	if err != nil {
-		return AuthResult{}, err
+		return AuthResult{}, fmt.Errorf("failed to sign authentication challenge: %w", err)
 	}
 
-	signatureBytes := key.Sign([]byte(signingPayload))
-
 	authPayload := map[string]any{
 		"authData": map[string]any{
 			"id":        accountID,
@@ -2,12 +2,15 @@ package inscriber
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 func TestNewAuthClient(t *testing.T) {
@@ -104,3 +107,40 @@ func TestAuthenticateFailOnAuth(t *testing.T) {
 		t.Fatal("expected auth post to fail")
 	}
 }
+
+func TestAuthenticateWithSigner(t *testing.T) {
+	pk, _ := hedera.PrivateKeyGenerateEd25519()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth/request-signature", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"message": "test-challenge"}`))
+	})
+	mux.HandleFunc("/api/auth/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AuthData struct {
+				Signature string `json:"signature"`
+			} `json:"authData"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		signature, _ := hex.DecodeString(body.AuthData.Signature)
+		if !pk.PublicKey().VerifySignedMessage([]byte("test-challenge"), signature) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"apiKey": "secret-key", "user": {"sessionToken": "token"}}`))
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client := NewAuthClient(ts.URL)
+	res, err := client.AuthenticateWithSigner(context.Background(), "0.0.1", shared.NewLocalSigner(pk), NetworkTestnet)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.APIKey != "secret-key" {
+		t.Fatal("expected secret-key")
+	}
+
+	if _, err := client.AuthenticateWithSigner(context.Background(), "0.0.1", nil, NetworkTestnet); err == nil {
+		t.Fatal("expected error for missing signer")
+	}
+}
@@ -315,7 +315,9 @@ func (c *Client) InscribeAndExecute(
 	return result, nil
 }
 
-// ExecuteTransaction performs the requested operation.
+// ExecuteTransaction signs the inscription transfer in transactionBytes and
+// submits it. It first sends the signed bytes straight to their node over
+// gRPC; the fallback strategies submit through config.Executor.
 func ExecuteTransaction(
 	ctx context.Context,
 	transactionBytes string,
@@ -330,14 +332,20 @@ func ExecuteTransaction(
 	if err != nil {
 		return "", fmt.Errorf("invalid account ID: %w", err)
 	}
-	privateKey, err := parseOperatorPrivateKey(
-		ctx,
-		network,
-		accountID.String(),
-		strings.TrimSpace(config.PrivateKey),
-	)
-	if err != nil {
-		return "", err
+	signer := config.Signer
+	if signer == nil {
+		privateKey, err := parseOperatorPrivateKey(
+			ctx,
+			network,
+			accountID.String(),
+			strings.TrimSpace(config.PrivateKey),
+		)
+		if err != nil {
+			return "", err
+		}
+		signer = shared.NewLocalSigner(privateKey)
+	} else if strings.TrimSpace(config.PrivateKey) != "" {
+		return "", fmt.Errorf("private key and signer cannot both be set")
 	}
 
 	rawBytes, err := base64.StdEncoding.DecodeString(transactionBytes)
@@ -345,7 +353,7 @@ func ExecuteTransaction(
 		return "", fmt.Errorf("transaction bytes must be base64: %w", err)
 	}
 
-	preSignedBytes, err := appendSerializedTransactionSignature(rawBytes, privateKey)
+	preSignedBytes, err := appendSerializedTransactionSignature(ctx, rawBytes, signer)
 	if err != nil {
 		return "", fmt.Errorf("failed to append operator signature to serialized transaction: %w", err)
 	}
@@ -369,20 +377,13 @@ func ExecuteTransaction(
 	if err != nil {
 		return "", err
 	}
-	passThroughResponse, serializedPassThroughErr := preSignedTransaction.Execute(passThroughClient)
+	passThroughResult, serializedPassThroughErr := config.Executor.Execute(ctx, passThroughClient, preSignedTransaction)
 	if serializedPassThroughErr == nil {
-		receipt, receiptErr := passThroughResponse.GetReceipt(passThroughClient)
-		if receiptErr != nil {
-			return "", fmt.Errorf("failed to get transaction receipt via serialized-signature-pass-through: %w", receiptErr)
-		}
-		if receipt.Status.String() != transactionStatusSuccess {
-			return "", fmt.Errorf(
-				"transaction via serialized-signature-pass-through failed with status %s",
-				receipt.Status.String(),
-			)
-		}
-
-		return passThroughResponse.TransactionID.String(), nil
+		return passThroughResult.TransactionID, nil
+	}
+	var passThroughStatusErr *shared.TransactionStatusError
+	if errors.As(serializedPassThroughErr, &passThroughStatusErr) && !passThroughStatusErr.Precheck {
+		return "", fmt.Errorf("transaction via serialized-signature-pass-through failed: %w", serializedPassThroughErr)
 	}
 
 	type executeAttempt struct {
@@ -420,8 +421,10 @@ func ExecuteTransaction(
 		if clientErr != nil {
 			return "", clientErr
 		}
+		executor := config.Executor
 		if attempt.operatorClient {
-			executionClient.SetOperator(accountID, privateKey)
+			shared.SetOperatorSigner(executionClient, accountID, signer)
+			executor = executor.WithOperatorSigner(signer)
 		}
 
 		transaction, decodeErr := decodeTransferTransaction(rawBytes)
@@ -431,27 +434,21 @@ func ExecuteTransaction(
 		transaction.SetRegenerateTransactionID(false)
 
 		if attempt.manualSign {
-			transaction.Sign(privateKey)
+			if err := shared.SignTransaction(ctx, transaction, signer); err != nil {
+				return "", fmt.Errorf("failed to sign transaction via %s: %w", attempt.label, err)
+			}
 		}
 
-		response, executeErr := transaction.Execute(executionClient)
+		result, executeErr := executor.Execute(ctx, executionClient, transaction)
 		if executeErr != nil {
-			if strings.Contains(strings.ToUpper(executeErr.Error()), "INVALID_SIGNATURE") {
+			if isInvalidSignatureError(executeErr) {
 				invalidSignatureErrors = append(invalidSignatureErrors, fmt.Sprintf("%s=%v", attempt.label, executeErr))
 				continue
 			}
 			return "", fmt.Errorf("failed to execute transaction via %s: %w", attempt.label, executeErr)
 		}
 
-		receipt, receiptErr := response.GetReceipt(executionClient)
-		if receiptErr != nil {
-			return "", fmt.Errorf("failed to get transaction receipt via %s: %w", attempt.label, receiptErr)
-		}
-		if receipt.Status.String() != "SUCCESS" {
-			return "", fmt.Errorf("transaction via %s failed with status %s", attempt.label, receipt.Status.String())
-		}
-
-		return response.TransactionID.String(), nil
+		return result.TransactionID, nil
 	}
 
 	if len(invalidSignatureErrors) > 0 {
@@ -462,10 +459,12 @@ func ExecuteTransaction(
 		)
 
 		rebuiltTransactionID, rebuildErr := executeRebuiltTransferTransaction(
+			ctx,
+			config.Executor,
 			rawBytes,
 			network,
 			accountID,
-			privateKey,
+			signer,
 		)
 		if rebuildErr == nil {
 			return rebuiltTransactionID, nil
@@ -478,6 +477,12 @@ func ExecuteTransaction(
 	return "", fmt.Errorf("no execution strategy succeeded")
 }
 
+// isInvalidSignatureError reports a node rejecting the transaction's
+// signatures, which the next execution strategy may fix.
+func isInvalidSignatureError(err error) bool {
+	return errors.Is(err, shared.ErrInvalidSignature) || strings.Contains(strings.ToUpper(err.Error()), "INVALID_SIGNATURE")
+}
+
 func decodeTransferTransaction(rawBytes []byte) (*hedera.TransferTransaction, error) {
 	transaction, err := hedera.TransactionFromBytes(rawBytes)
 	if err != nil {
@@ -488,8 +493,9 @@ func decodeTransferTransaction(rawBytes []byte) (*hedera.TransferTransaction, er
 }
 
 func appendSerializedTransactionSignature(
+	ctx context.Context,
 	rawBytes []byte,
-	privateKey hedera.PrivateKey,
+	signer shared.Signer,
 ) ([]byte, error) {
 	var transactionList sdk.TransactionList
 	if err := protobuf.Unmarshal(rawBytes, &transactionList); err != nil {
@@ -499,7 +505,7 @@ func appendSerializedTransactionSignature(
 		return nil, fmt.Errorf("serialized transaction list is empty")
 	}
 
-	publicKey := privateKey.PublicKey()
+	publicKey := signer.PublicKey()
 
 	for index := range transactionList.TransactionList {
 		transaction := transactionList.TransactionList[index]
@@ -512,7 +518,11 @@ func appendSerializedTransactionSignature(
 			return nil, fmt.Errorf("failed to decode signed transaction %d: %w", index, err)
 		}
 
-		signaturePair, err := buildSignaturePair(publicKey, privateKey.Sign(signedTransaction.GetBodyBytes()))
+		signature, err := signer.Sign(ctx, signedTransaction.GetBodyBytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign transaction %d: %w", index, err)
+		}
+		signaturePair, err := buildSignaturePair(publicKey, signature)
 		if err != nil {
 			return nil, err
 		}
@@ -972,10 +982,12 @@ func resolveMirrorKeyType(ctx context.Context, network string, accountID string)
 }
 
 func executeRebuiltTransferTransaction(
+	ctx context.Context,
+	executor *shared.Executor,
 	rawBytes []byte,
 	network string,
 	accountID hedera.AccountID,
-	privateKey hedera.PrivateKey,
+	signer shared.Signer,
 ) (string, error) {
 	decodedTransaction, err := hedera.TransactionFromBytes(rawBytes)
 	if err != nil {
@@ -1000,7 +1012,7 @@ func executeRebuiltTransferTransaction(
 	if err != nil {
 		return "", err
 	}
-	executionClient.SetOperator(accountID, privateKey)
+	shared.SetOperatorSigner(executionClient, accountID, signer)
 
 	rebuiltTransaction := hedera.NewTransferTransaction().
 		SetTransactionID(transactionID).
@@ -1058,21 +1070,15 @@ func executeRebuiltTransferTransaction(
 		return "", fmt.Errorf("failed to freeze rebuilt transfer transaction: %w", err)
 	}
 
-	rebuiltTransaction.Sign(privateKey)
-	response, err := rebuiltTransaction.Execute(executionClient)
-	if err != nil {
-		return "", fmt.Errorf("failed to execute rebuilt transfer transaction: %w", err)
+	if err := shared.SignTransaction(ctx, rebuiltTransaction, signer); err != nil {
+		return "", fmt.Errorf("failed to sign rebuilt transfer transaction: %w", err)
 	}
-
-	receipt, err := response.GetReceipt(executionClient)
+	result, err := executor.WithOperatorSigner(signer).Execute(ctx, executionClient, rebuiltTransaction)
 	if err != nil {
-		return "", fmt.Errorf("failed to fetch rebuilt transfer receipt: %w", err)
-	}
-	if receipt.Status.String() != "SUCCESS" {
-		return "", fmt.Errorf("rebuilt transfer transaction failed with status %s", receipt.Status.String())
+		return "", fmt.Errorf("failed to execute rebuilt transfer transaction: %w", err)
 	}
 
-	return response.TransactionID.String(), nil
+	return result.TransactionID, nil
 }
 
 func asTransferTransaction(transaction any) (*hedera.TransferTransaction, error) {
@@ -12,6 +12,8 @@ import (
 	protobufservices "github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
 	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/hashgraph-online/standards-sdk-go/pkg/shared"
 )
 
 func TestBoolToInt(t *testing.T) {
@@ -267,7 +269,7 @@ func TestAppendSerializedTransactionSignaturePreservesExistingSignatures(t *test
 		t.Fatalf("failed to inspect original signature count: %v", err)
 	}
 
-	updatedTransactionBytes, err := appendSerializedTransactionSignature(serializedTransaction, holderKey)
+	updatedTransactionBytes, err := appendSerializedTransactionSignature(context.Background(), serializedTransaction, shared.NewLocalSigner(holderKey))
 	if err != nil {
 		t.Fatalf("failed to append holder signature: %v", err)
 	}
@@ -383,7 +385,7 @@ func TestExecuteRebuiltTransferTransactionFailures(t *testing.T) {
 	// Create and marshal an invalid transfer tx
 	tx := hedera.NewTopicCreateTransaction()
 	txBytes, _ := tx.ToBytes()
-	_, err := executeRebuiltTransferTransaction(txBytes, "testnet", accountID, pk)
+	_, err := executeRebuiltTransferTransaction(context.Background(), nil, txBytes, "testnet", accountID, shared.NewLocalSigner(pk))
 	if err == nil {
 		t.Fatal("expected failure on non-transfer tx rebuild")
 	}
@@ -394,7 +396,7 @@ func TestExecuteRebuiltTransferTransactionFailures(t *testing.T) {
 		SetTransactionID(hedera.TransactionIDGenerate(otherAccountID)). // Wrong payer!
 		Freeze()
 	trBytes, _ := trTx.ToBytes()
-	_, err = executeRebuiltTransferTransaction(trBytes, "testnet", accountID, pk)
+	_, err = executeRebuiltTransferTransaction(context.Background(), nil, trBytes, "testnet", accountID, shared.NewLocalSigner(pk))
 	if err == nil {
 		t.Fatal("expected failure on mismatched payer account")
 	}
@@ -406,7 +408,7 @@ func TestExecuteRebuiltTransferTransactionFailures(t *testing.T) {
 		SetNodeAccountIDs([]hedera.AccountID{otherAccountID}).
 		Freeze()
 	trBytes2, _ := trTx2.ToBytes()
-	_, err = executeRebuiltTransferTransaction(trBytes2, "invalid-network-name", accountID, pk)
+	_, err = executeRebuiltTransferTransaction(context.Background(), nil, trBytes2, "invalid-network-name", accountID, shared.NewLocalSigner(pk))
 	if err == nil {
 		t.Fatal("expected err on bad network")
 	}
@@ -1,5 +1,7 @@
 package inscriber
 
+import "github.com/hashgraph-online/standards-sdk-go/pkg/shared"
+
 type Network string
 
 const (
@@ -143,10 +145,16 @@ type StartInscriptionRequest struct {
 	MetadataObject     map[string]any  `json:"metadataObject,omitempty"`
 }
 
+// HederaClientConfig identifies the account that pays for and signs
+// inscription transactions. Set Signer instead of PrivateKey to keep the key
+// outside the process. Executor submits the transactions; nil uses
+// shared.DefaultExecutor.
 type HederaClientConfig struct {
 	AccountID  string
 	PrivateKey string
+	Signer     shared.Signer
 	Network    Network
+	Executor   *shared.Executor
 }
 
 type InscriptionJob struct {
@@ -1,6 +1,7 @@
 package mirror
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -31,8 +32,10 @@ type Client struct {
 
 type MessageQueryOptions struct {
 	SequenceNumber string
-	Limit          int
-	Order          string
+	// Timestamp filters on consensus timestamp, e.g. "lte:1700000000.000000000".
+	Timestamp string
+	Limit     int
+	Order     string
 }
 
 // NewClient creates a new Client.
@@ -116,6 +119,57 @@ func (c *Client) GetAccount(ctx context.Context, accountID string) (AccountInfo,
 	return accountInfo, nil
 }
 
+// GetAccountAt returns accountID as it was at timestamp, a mirror node
+// consensus timestamp, so its key is the one the account held then. An empty
+// timestamp returns the current account.
+func (c *Client) GetAccountAt(ctx context.Context, accountID string, timestamp string) (AccountInfo, error) {
+	timestamp = strings.TrimSpace(timestamp)
+	if timestamp == "" {
+		return c.GetAccount(ctx, accountID)
+	}
+	var accountInfo AccountInfo
+	normalizedAccountID := strings.TrimSpace(accountID)
+	if normalizedAccountID == "" {
+		return accountInfo, fmt.Errorf("account ID is required")
+	}
+
+	values := url.Values{}
+	values.Set("timestamp", "lte:"+timestamp)
+	values.Set("transactions", "false")
+	path := fmt.Sprintf("/api/v1/accounts/%s?%s", normalizedAccountID, values.Encode())
+	if err := c.getJSON(ctx, path, &accountInfo); err != nil {
+		return accountInfo, err
+	}
+
+	return accountInfo, nil
+}
+
+// GetAccountsByPublicKey returns every account whose key is the given public
+// key, in hex as reported by the mirror node, following pagination links.
+func (c *Client) GetAccountsByPublicKey(ctx context.Context, publicKey string) ([]AccountInfo, error) {
+	normalizedKey := strings.TrimPrefix(strings.TrimSpace(publicKey), "0x")
+	if normalizedKey == "" {
+		return nil, fmt.Errorf("public key is required")
+	}
+
+	values := url.Values{}
+	values.Set("account.publickey", normalizedKey)
+	values.Set("balance", "true")
+	values.Set("order", "asc")
+
+	result := make([]AccountInfo, 0)
+	next := "/api/v1/accounts?" + values.Encode()
+	for next != "" {
+		var page accountsResponse
+		if err := c.getJSON(ctx, next, &page); err != nil {
+			return nil, err
+		}
+		result = append(result, page.Accounts...)
+		next = page.Links.Next
+	}
+	return result, nil
+}
+
 // GetAccountMemo returns the requested value.
 func (c *Client) GetAccountMemo(ctx context.Context, accountID string) (string, error) {
 	accountInfo, err := c.GetAccount(ctx, accountID)
@@ -135,10 +189,49 @@ func (c *Client) GetTopicMessages(
 		return nil, fmt.Errorf("topic ID is required")
 	}
 
+	result := make([]TopicMessage, 0)
+	next := topicMessagesEndpoint(topicID, options)
+
+	for next != "" {
+		var page topicMessagesResponse
+		if err := c.getJSON(ctx, next, &page); err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.Messages...)
+		next = page.Links.Next
+	}
+
+	return result, nil
+}
+
+// GetTopicMessagesPage returns the first page of messages on topicID for
+// options without following pagination links. Callers page on by narrowing
+// options, for example with a Timestamp of "lt:" the last message returned.
+func (c *Client) GetTopicMessagesPage(
+	ctx context.Context,
+	topicID string,
+	options MessageQueryOptions,
+) ([]TopicMessage, error) {
+	if strings.TrimSpace(topicID) == "" {
+		return nil, fmt.Errorf("topic ID is required")
+	}
+
+	var page topicMessagesResponse
+	if err := c.getJSON(ctx, topicMessagesEndpoint(topicID, options), &page); err != nil {
+		return nil, err
+	}
+	return page.Messages, nil
+}
+
+func topicMessagesEndpoint(topicID string, options MessageQueryOptions) string {
 	values := url.Values{}
 	if options.SequenceNumber != "" {
 		values.Set("sequencenumber", options.SequenceNumber)
 	}
+	if options.Timestamp != "" {
+		values.Set("timestamp", options.Timestamp)
+	}
 	if options.Limit > 0 {
 		values.Set("limit", fmt.Sprintf("%d", options.Limit))
 	}
@@ -150,21 +243,7 @@ func (c *Client) GetTopicMessages(
 	if encoded := values.Encode(); encoded != "" {
 		endpoint = fmt.Sprintf("%s?%s", endpoint, encoded)
 	}
-
-	result := make([]TopicMessage, 0)
-	next := endpoint
-
-	for next != "" {
-		var page topicMessagesResponse
-		if err := c.getJSON(ctx, next, &page); err != nil {
-			return nil, err
-		}
-
-		result = append(result, page.Messages...)
-		next = page.Links.Next
-	}
-
-	return result, nil
+	return endpoint
 }
 
 // GetTopicMessageBySequence returns the requested value.
@@ -192,6 +271,37 @@ func (c *Client) GetTopicMessageBySequence(
 	return &messages[0], nil
 }
 
+// GetTopicMessageAt returns the last message on topicID with a consensus
+// timestamp at or before timestamp, or the latest message when timestamp is
+// empty. It reads a single page and returns nil when there is no message.
+func (c *Client) GetTopicMessageAt(
+	ctx context.Context,
+	topicID string,
+	timestamp string,
+) (*TopicMessage, error) {
+	if strings.TrimSpace(topicID) == "" {
+		return nil, fmt.Errorf("topic ID is required")
+	}
+
+	values := url.Values{}
+	if strings.TrimSpace(timestamp) != "" {
+		values.Set("timestamp", "lte:"+strings.TrimSpace(timestamp))
+	}
+	values.Set("limit", "1")
+	values.Set("order", "desc")
+
+	var page topicMessagesResponse
+	endpoint := fmt.Sprintf("/api/v1/topics/%s/messages?%s", topicID, values.Encode())
+	if err := c.getJSON(ctx, endpoint, &page); err != nil {
+		return nil, err
+	}
+	if len(page.Messages) == 0 {
+		return nil, nil
+	}
+
+	return &page.Messages[0], nil
+}
+
 // DecodeMessageData performs the requested operation.
 func DecodeMessageData(message TopicMessage) ([]byte, error) {
 	if strings.TrimSpace(message.Message) == "" {
@@ -214,6 +324,23 @@ func DecodeMessageJSON[T any](message TopicMessage, target *T) error {
 	return nil
 }
 
+// CallContract executes a read-only contract call through /contracts/call.
+func (c *Client) CallContract(ctx context.Context, request ContractCallRequest) (ContractCallResult, error) {
+	var result ContractCallResult
+	if strings.TrimSpace(request.To) == "" {
+		return result, fmt.Errorf("contract address is required")
+	}
+	if strings.TrimSpace(request.Block) == "" {
+		request.Block = "latest"
+	}
+
+	if err := c.postJSON(ctx, "/api/v1/contracts/call", request, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
 // GetTransaction returns the requested value.
 func (c *Client) GetTransaction(ctx context.Context, transactionID string) (*Transaction, error) {
 	normalized := strings.TrimSpace(transactionID)
@@ -234,14 +361,51 @@ func (c *Client) GetTransaction(ctx context.Context, transactionID string) (*Tra
 	return &response.Transactions[0], nil
 }
 
+// GetSchedule returns the scheduled transaction with the given ID.
+func (c *Client) GetSchedule(ctx context.Context, scheduleID string) (Schedule, error) {
+	var schedule Schedule
+	normalized := strings.TrimSpace(scheduleID)
+	if normalized == "" {
+		return schedule, fmt.Errorf("schedule ID is required")
+	}
+
+	path := fmt.Sprintf("/api/v1/schedules/%s", normalized)
+	if err := c.getJSON(ctx, path, &schedule); err != nil {
+		return schedule, err
+	}
+
+	return schedule, nil
+}
+
 func (c *Client) getJSON(ctx context.Context, pathOrURL string, target any) error {
+	return c.doJSON(ctx, http.MethodGet, pathOrURL, nil, target)
+}
+
+func (c *Client) postJSON(ctx context.Context, pathOrURL string, payload any, target any) error {
+	return c.doJSON(ctx, http.MethodPost, pathOrURL, payload, target)
+}
+
+func (c *Client) doJSON(ctx context.Context, method string, pathOrURL string, payload any, target any) error {
 	requestURL := c.resolveURL(pathOrURL)
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	var requestBody io.Reader
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		requestBody = bytes.NewReader(encoded)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, requestBody)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
 	if c.apiKey != "" {
 		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 	}
@@ -345,6 +345,92 @@ func TestGetTopicMessageBySequenceNotFound(t *testing.T) {
 	}
 }
 
+func TestGetTopicMessageAt(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		response := topicMessagesResponse{Messages: []TopicMessage{{SequenceNumber: 3, RunningHash: "abc"}}}
+		response.Links.Next = "/api/v1/topics/0.0.1/messages?limit=1&order=desc&timestamp=lt:1.0"
+		if r.URL.Query().Get("timestamp") == "lte:0.000000001" {
+			response = topicMessagesResponse{Messages: []TopicMessage{}}
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{Network: "testnet", BaseURL: server.URL})
+	msg, err := client.GetTopicMessageAt(context.Background(), "0.0.1", "1700000000.123456789")
+	if err != nil || msg == nil || msg.RunningHash != "abc" {
+		t.Fatalf("unexpected result %+v, %v", msg, err)
+	}
+	if len(queries) != 1 || queries[0] != "limit=1&order=desc&timestamp=lte%3A1700000000.123456789" {
+		t.Fatalf("expected a single bounded query, got %v", queries)
+	}
+	if _, err := client.GetTopicMessageAt(context.Background(), "0.0.1", ""); err != nil || queries[1] != "limit=1&order=desc" {
+		t.Fatalf("unexpected latest query %v, %v", queries, err)
+	}
+	msg, err = client.GetTopicMessageAt(context.Background(), "0.0.1", "0.000000001")
+	if err != nil || msg != nil {
+		t.Fatalf("expected no message, got %+v, %v", msg, err)
+	}
+	if _, err := client.GetTopicMessageAt(context.Background(), " ", ""); err == nil {
+		t.Fatal("expected error for empty topic ID")
+	}
+}
+
+func TestGetTopicMessagesPage(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		response := topicMessagesResponse{Messages: []TopicMessage{{SequenceNumber: 7}, {SequenceNumber: 6}}}
+		response.Links.Next = "/api/v1/topics/0.0.1/messages?limit=2&order=desc&timestamp=lt:6.0"
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{Network: "testnet", BaseURL: server.URL})
+	messages, err := client.GetTopicMessagesPage(context.Background(), "0.0.1", MessageQueryOptions{
+		Timestamp: "lt:8.0",
+		Limit:     2,
+		Order:     "desc",
+	})
+	if err != nil || len(messages) != 2 || messages[1].SequenceNumber != 6 {
+		t.Fatalf("unexpected page %+v, %v", messages, err)
+	}
+	if len(queries) != 1 || queries[0] != "limit=2&order=desc&timestamp=lt%3A8.0" {
+		t.Fatalf("expected a single page query, got %v", queries)
+	}
+	if _, err := client.GetTopicMessagesPage(context.Background(), "", MessageQueryOptions{}); err == nil {
+		t.Fatal("expected error for empty topic ID")
+	}
+}
+
+func TestGetAccountAt(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(AccountInfo{Account: "0.0.12345"})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{Network: "testnet", BaseURL: server.URL})
+	if _, err := client.GetAccountAt(context.Background(), "0.0.12345", "1700000000.000000001"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetAccountAt(context.Background(), "0.0.12345", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(queries) != 2 || queries[0] != "timestamp=lte%3A1700000000.000000001&transactions=false" || queries[1] != "" {
+		t.Fatalf("unexpected account queries %v", queries)
+	}
+	if _, err := client.GetAccountAt(context.Background(), " ", "1.0"); err == nil {
+		t.Fatal("expected error for empty account ID")
+	}
+}
+
 func TestDecodeMessageDataEmpty(t *testing.T) {
 	_, err := DecodeMessageData(TopicMessage{Message: ""})
 	if err == nil {
@@ -545,3 +631,121 @@ func TestGetJSONWithCustomHeaders(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestCallContractSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/contracts/call" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body ContractCallRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Block != "latest" || body.Data != "0x18160ddd" {
+			t.Fatalf("unexpected body: %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ContractCallResult{Result: "0x01"})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{
+		Network: "testnet",
+		BaseURL: server.URL,
+	})
+	result, err := client.CallContract(context.Background(), ContractCallRequest{
+		To:   "0x1111111111111111111111111111111111111111",
+		Data: "0x18160ddd",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Result != "0x01" {
+		t.Fatalf("unexpected result: %s", result.Result)
+	}
+
+	if _, err := client.CallContract(context.Background(), ContractCallRequest{}); err == nil {
+		t.Fatal("expected error for missing contract address")
+	}
+}
+
+func TestDecodeHCS1File(t *testing.T) {
+	// "hello" brotli-compressed, split across two out-of-order chunks.
+	encoded := "data:text/plain;base64,CwKAaGVsbG8D"
+	chunk := func(order int, content string) TopicMessage {
+		raw, _ := json.Marshal(map[string]any{"o": order, "c": content})
+		return TopicMessage{Message: base64.StdEncoding.EncodeToString(raw)}
+	}
+
+	content, err := DecodeHCS1File("0.0.1", []TopicMessage{
+		chunk(1, encoded[20:]),
+		chunk(0, encoded[:20]),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "hello" {
+		t.Fatalf("unexpected content: %q", content)
+	}
+
+	if _, err := DecodeHCS1File("0.0.1", []TopicMessage{chunk(1, encoded)}); err == nil {
+		t.Fatal("expected error for missing chunk")
+	}
+}
+
+func TestGetAccountsByPublicKeyFollowsPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/accounts" || r.URL.Query().Get("account.publickey") != "02abc" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("account.id") == "" {
+			json.NewEncoder(w).Encode(map[string]any{
+				"accounts": []any{map[string]any{"account": "0.0.1", "balance": map[string]any{"balance": 100}}},
+				"links":    map[string]any{"next": "/api/v1/accounts?account.publickey=02abc&account.id=gt:0.0.1"},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"accounts": []any{map[string]any{"account": "0.0.2", "memo": "petal"}},
+			"links":    map[string]any{"next": nil},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{Network: "testnet", BaseURL: server.URL})
+	accounts, err := client.GetAccountsByPublicKey(context.Background(), "0x02abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(accounts) != 2 || accounts[0].Balance.Balance != 100 || accounts[1].Memo != "petal" {
+		t.Fatalf("unexpected accounts: %+v", accounts)
+	}
+	if _, err := client.GetAccountsByPublicKey(context.Background(), " "); err == nil {
+		t.Fatal("expected error for empty public key")
+	}
+}
+
+func TestGetScheduleSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/schedules/0.0.777" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"schedule_id":"0.0.777","executed_timestamp":null,"transaction_body":"CgA=","signatures":[{"public_key_prefix":"AQI=","type":"ED25519"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Config{
+		Network: "testnet",
+		BaseURL: server.URL,
+	})
+	schedule, err := client.GetSchedule(context.Background(), "0.0.777")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schedule.ScheduleID != "0.0.777" || schedule.ExecutedTimestamp != "" || len(schedule.Signatures) != 1 {
+		t.Fatalf("unexpected schedule: %+v", schedule)
+	}
+	if _, err := client.GetSchedule(context.Background(), " "); err == nil {
+		t.Fatal("expected error for empty schedule ID")
+	}
+}
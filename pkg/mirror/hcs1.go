@@ -0,0 +1,85 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+type hcs1Chunk struct {
+	Order   int    `json:"o"`
+	Content string `json:"c"`
+}
+
+// GetHCS1File fetches and reassembles the HCS-1 file stored on the topic.
+func (c *Client) GetHCS1File(ctx context.Context, topicID string) ([]byte, error) {
+	trimmedTopicID := strings.TrimSpace(topicID)
+	if trimmedTopicID == "" {
+		return nil, fmt.Errorf("topic ID is required")
+	}
+
+	items, err := c.GetTopicMessages(ctx, trimmedTopicID, MessageQueryOptions{
+		Order: "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return DecodeHCS1File(trimmedTopicID, items)
+}
+
+// DecodeHCS1File reassembles an HCS-1 file from the topic messages that carry it.
+func DecodeHCS1File(topicID string, items []TopicMessage) ([]byte, error) {
+	chunks := make([]hcs1Chunk, 0, len(items))
+	seen := map[int]bool{}
+	for _, item := range items {
+		var chunk hcs1Chunk
+		if err := DecodeMessageJSON(item, &chunk); err != nil {
+			continue
+		}
+		if chunk.Content == "" || seen[chunk.Order] {
+			continue
+		}
+		seen[chunk.Order] = true
+		chunks = append(chunks, chunk)
+	}
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("no HCS-1 chunks found on topic %s", topicID)
+	}
+
+	sort.Slice(chunks, func(left, right int) bool {
+		return chunks[left].Order < chunks[right].Order
+	})
+
+	var builder strings.Builder
+	for index, chunk := range chunks {
+		if chunk.Order != index {
+			return nil, fmt.Errorf("HCS-1 file on topic %s is missing chunk %d", topicID, index)
+		}
+		builder.WriteString(chunk.Content)
+	}
+
+	content := builder.String()
+	if strings.HasPrefix(content, "data:") {
+		separator := strings.Index(content, ",")
+		if separator < 0 {
+			return nil, fmt.Errorf("invalid HCS-1 data URL on topic %s", topicID)
+		}
+		content = content[separator+1:]
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode HCS-1 payload on topic %s: %w", topicID, err)
+	}
+	decompressed, err := io.ReadAll(brotli.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress HCS-1 payload on topic %s: %w", topicID, err)
+	}
+	return decompressed, nil
+}
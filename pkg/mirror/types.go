@@ -14,9 +14,25 @@ type TopicInfo struct {
 }
 
 type AccountInfo struct {
-	Account string         `json:"account"`
-	Key     map[string]any `json:"key"`
-	Memo    string         `json:"memo"`
+	Account          string          `json:"account"`
+	Key              map[string]any  `json:"key"`
+	Memo             string          `json:"memo"`
+	Balance          *AccountBalance `json:"balance,omitempty"`
+	EVMAddress       string          `json:"evm_address"`
+	Deleted          bool            `json:"deleted"`
+	CreatedTimestamp string          `json:"created_timestamp"`
+}
+
+type AccountBalance struct {
+	Balance   int64  `json:"balance"`
+	Timestamp string `json:"timestamp"`
+}
+
+type accountsResponse struct {
+	Links struct {
+		Next string `json:"next"`
+	} `json:"links"`
+	Accounts []AccountInfo `json:"accounts"`
 }
 
 type TopicMessage struct {
@@ -68,3 +84,44 @@ type transactionsResponse struct {
 		Next string `json:"next"`
 	} `json:"links"`
 }
+
+// Schedule is a scheduled transaction as reported by the mirror node.
+// TransactionBody is the base64-encoded SchedulableTransactionBody.
+type Schedule struct {
+	AdminKey           map[string]any      `json:"admin_key"`
+	ConsensusTimestamp string              `json:"consensus_timestamp"`
+	CreatorAccountID   string              `json:"creator_account_id"`
+	Deleted            bool                `json:"deleted"`
+	ExecutedTimestamp  string              `json:"executed_timestamp"`
+	ExpirationTime     string              `json:"expiration_time"`
+	Memo               string              `json:"memo"`
+	PayerAccountID     string              `json:"payer_account_id"`
+	ScheduleID         string              `json:"schedule_id"`
+	Signatures         []ScheduleSignature `json:"signatures"`
+	TransactionBody    string              `json:"transaction_body"`
+	WaitForExpiry      bool                `json:"wait_for_expiry"`
+}
+
+// ScheduleSignature is one signature collected by a schedule. The public key
+// prefix and signature are base64 encoded.
+type ScheduleSignature struct {
+	ConsensusTimestamp string `json:"consensus_timestamp"`
+	PublicKeyPrefix    string `json:"public_key_prefix"`
+	Signature          string `json:"signature"`
+	Type               string `json:"type"`
+}
+
+type ContractCallRequest struct {
+	Block    string `json:"block,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Estimate bool   `json:"estimate"`
+	From     string `json:"from,omitempty"`
+	Gas      int64  `json:"gas,omitempty"`
+	GasPrice int64  `json:"gasPrice,omitempty"`
+	To       string `json:"to"`
+	Value    int64  `json:"value,omitempty"`
+}
+
+type ContractCallResult struct {
+	Result string `json:"result"`
+}
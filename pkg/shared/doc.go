@@ -2,6 +2,49 @@
 // Standards SDK for Go. It includes network normalization, operator environment
 // variable loading, Hedera client construction, and key parsing helpers.
 //
+// # Signers
+//
+// Operator keys can be supplied as a [Signer] instead of a raw private key.
+// [LocalSigner] wraps an in-process key, [RemoteSigner] delegates to an HTTP
+// signing service (for example a KMS proxy), and [PKCS11Signer] signs through
+// an HSM session. Every ClientConfig in the SDK accepts an OperatorSigner.
+// Executors sign with it before submitting, so a failing signer is reported
+// as an error rather than as a rejected transaction. All three also implement
+// [DigestSigner] for secp256k1 keys, which signs a SHA-256 digest directly
+// for protocols outside Hedera's Keccak-256 signing rules.
+//
+// # Offline Signing
+//
+// [TxEnvelope] freezes a transaction for a node set, exports its bytes,
+// collects signatures from any number of parties (in process, detached via
+// [TxEnvelope.SigningRequests] and [TxEnvelope.AddSignature], or merged from
+// copies) and submits it later. The HCS-10, HCS-16 and HCS-20 clients accept
+// ExecutionModeEnvelope to return envelopes in the Envelope field of their
+// results instead of executing; methods whose results have no such field
+// reject envelope mode and have envelope-returning Build*Envelope
+// counterparts. Pair it with an [OfflineSigner] for cold-wallet operators.
+//
+// # Executing Transactions
+//
+// Every SDK client submits transactions through an [Executor], set with the
+// Executor field of its ClientConfig or taken from [DefaultExecutor]. The
+// executor resubmits precheck BUSY rejections with exponential backoff
+// while the transaction is still inside its valid duration, applies
+// per-operation max fees and a max fee guard, optionally fetches the
+// transaction record and reports attempts to [ExecutorHooks]. Use
+// [SetDefaultExecutor] to apply one policy, such as a fee guard, to every
+// protocol at once. The inscriber is the one exception: it first sends the
+// service's pre-signed transfer straight to its node over gRPC and only uses
+// the executor from its HederaClientConfig for the fallback strategies.
+//
+// A single attempt is made by [ExecuteTransaction]. The context deadline, or
+// the client's request timeout without one, bounds the SDK's submission
+// retries and each gRPC call, and the receipt is polled with short queries
+// that stop once the context is done. Precheck and receipt failures are
+// reported as [TransactionStatusError], which matches sentinels such as
+// [ErrInvalidSignature], [ErrInsufficientPayerBalance] and [ErrNetworkBusy]
+// with errors.Is.
+//
 // This package is typically used internally by other SDK packages but is
 // also available for direct use when building custom integrations with the
 // Hedera public ledger.
@@ -0,0 +1,520 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiero-ledger/hiero-sdk-go/v2/proto/sdk"
+	protobufservices "github.com/hiero-ledger/hiero-sdk-go/v2/proto/services"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ExecutionMode controls whether clients execute transactions or hand them
+// back as envelopes for offline signing.
+type ExecutionMode string
+
+const (
+	// ExecutionModeExecute signs and submits transactions immediately. It is
+	// the default when no mode is configured.
+	ExecutionModeExecute ExecutionMode = "execute"
+	// ExecutionModeEnvelope freezes transactions and returns them as a
+	// TxEnvelope without submitting them.
+	ExecutionModeEnvelope ExecutionMode = "envelope"
+)
+
+// NormalizeExecutionMode validates mode and maps the empty value to
+// ExecutionModeExecute.
+func NormalizeExecutionMode(mode ExecutionMode) (ExecutionMode, error) {
+	switch ExecutionMode(strings.ToLower(strings.TrimSpace(string(mode)))) {
+	case "", ExecutionModeExecute:
+		return ExecutionModeExecute, nil
+	case ExecutionModeEnvelope:
+		return ExecutionModeEnvelope, nil
+	default:
+		return "", fmt.Errorf("unsupported execution mode %q", mode)
+	}
+}
+
+// FreezeOptions pins the values a transaction is frozen with.
+type FreezeOptions struct {
+	// Client supplies the network, the default node set and the operator
+	// used as payer. It can be nil when NodeAccountIDs and either
+	// TransactionID or PayerAccountID are set.
+	Client *hedera.Client
+	// NodeAccountIDs restricts the nodes the transaction can be submitted to.
+	NodeAccountIDs []hedera.AccountID
+	// TransactionID is used as-is when its account ID is set.
+	TransactionID hedera.TransactionID
+	// PayerAccountID generates a fresh transaction ID when TransactionID is
+	// unset.
+	PayerAccountID string
+	// ValidDuration overrides the transaction valid duration.
+	ValidDuration time.Duration
+}
+
+// TxSigningRequest is one body that a signer must sign. Frozen transactions
+// have one body per node, and chunked topic messages one per chunk and node.
+type TxSigningRequest struct {
+	TransactionID hedera.TransactionID
+	NodeAccountID hedera.AccountID
+	BodyBytes     []byte
+}
+
+// TxSubmitResult is the outcome of submitting an envelope.
+type TxSubmitResult struct {
+	TransactionID string
+	Receipt       hedera.TransactionReceipt
+}
+
+// TxEnvelope carries a transaction through freezing, export, offline
+// signing by any number of parties and later submission.
+type TxEnvelope struct {
+	transaction hedera.TransactionInterface
+	frozen      []byte
+}
+
+// NewTxEnvelope wraps an unfrozen or frozen transaction.
+func NewTxEnvelope(transaction hedera.TransactionInterface) (*TxEnvelope, error) {
+	if transaction == nil {
+		return nil, fmt.Errorf("transaction is required")
+	}
+	envelope := &TxEnvelope{transaction: transaction}
+	if freezable, ok := transaction.(interface{ IsFrozen() bool }); ok && freezable.IsFrozen() {
+		frozen, err := hedera.TransactionToBytes(transaction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize frozen transaction: %w", err)
+		}
+		envelope.frozen = frozen
+	}
+	return envelope, nil
+}
+
+// FreezeTxEnvelope wraps transaction and freezes it in one step.
+func FreezeTxEnvelope(
+	transaction hedera.TransactionInterface,
+	options FreezeOptions,
+) (*TxEnvelope, error) {
+	envelope, err := NewTxEnvelope(transaction)
+	if err != nil {
+		return nil, err
+	}
+	if err := envelope.Freeze(options); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// TxEnvelopeFromBytes restores an envelope exported with ExportBytes.
+func TxEnvelopeFromBytes(data []byte) (*TxEnvelope, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("envelope bytes are required")
+	}
+	transaction, err := hedera.TransactionFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode envelope transaction: %w", err)
+	}
+	envelope := &TxEnvelope{
+		transaction: transaction,
+		frozen:      append([]byte(nil), data...),
+	}
+	if _, err := envelope.SigningRequests(); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// IsFrozen reports whether the envelope holds a frozen transaction.
+func (envelope *TxEnvelope) IsFrozen() bool {
+	return len(envelope.frozen) > 0
+}
+
+// Freeze fixes the transaction ID and node set. Freezing an already frozen
+// envelope is a no-op.
+func (envelope *TxEnvelope) Freeze(options FreezeOptions) error {
+	if envelope.IsFrozen() {
+		return nil
+	}
+
+	transaction := envelope.transaction
+	var err error
+	if len(options.NodeAccountIDs) > 0 {
+		transaction, err = hedera.TransactionSetNodeAccountIDs(transaction, options.NodeAccountIDs)
+		if err != nil {
+			return fmt.Errorf("failed to set node account IDs: %w", err)
+		}
+	}
+	if options.ValidDuration > 0 {
+		transaction, err = hedera.TransactionSetTransactionValidDuration(transaction, options.ValidDuration)
+		if err != nil {
+			return fmt.Errorf("failed to set transaction valid duration: %w", err)
+		}
+	}
+
+	transactionID := options.TransactionID
+	if transactionID.AccountID == nil && strings.TrimSpace(options.PayerAccountID) != "" {
+		payerAccountID, parseErr := hedera.AccountIDFromString(strings.TrimSpace(options.PayerAccountID))
+		if parseErr != nil {
+			return fmt.Errorf("invalid payer account ID: %w", parseErr)
+		}
+		transactionID = hedera.TransactionIDGenerate(payerAccountID)
+	}
+	if transactionID.AccountID != nil {
+		transaction, err = hedera.TransactionSetTransactionID(transaction, transactionID)
+		if err != nil {
+			return fmt.Errorf("failed to set transaction ID: %w", err)
+		}
+	}
+
+	transaction, err = hedera.TransactionFreezeWith(transaction, options.Client)
+	if err != nil {
+		return fmt.Errorf("failed to freeze transaction: %w", err)
+	}
+	if freezable, ok := transaction.(interface{ IsFrozen() bool }); ok && !freezable.IsFrozen() {
+		return fmt.Errorf("transaction did not freeze; the client network has no nodes, set NodeAccountIDs")
+	}
+	frozen, err := hedera.TransactionToBytes(transaction)
+	if err != nil {
+		return fmt.Errorf("failed to serialize frozen transaction: %w", err)
+	}
+
+	envelope.transaction = transaction
+	envelope.frozen = frozen
+	return nil
+}
+
+// Transaction returns the wrapped transaction. After signatures are added it
+// reflects the exported bytes rather than the value passed to NewTxEnvelope.
+func (envelope *TxEnvelope) Transaction() hedera.TransactionInterface {
+	return envelope.transaction
+}
+
+// TransactionID returns the ID of the first transaction body.
+func (envelope *TxEnvelope) TransactionID() (hedera.TransactionID, error) {
+	requests, err := envelope.SigningRequests()
+	if err != nil {
+		return hedera.TransactionID{}, err
+	}
+	return requests[0].TransactionID, nil
+}
+
+// ExportBytes returns the frozen transaction with every collected signature,
+// in the SDK's TransactionList encoding.
+func (envelope *TxEnvelope) ExportBytes() ([]byte, error) {
+	if !envelope.IsFrozen() {
+		return nil, fmt.Errorf("transaction envelope is not frozen")
+	}
+	return append([]byte(nil), envelope.frozen...), nil
+}
+
+// SigningRequests lists the bodies a signer has to sign, in export order.
+func (envelope *TxEnvelope) SigningRequests() ([]TxSigningRequest, error) {
+	transactionList, err := envelope.decodeList()
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]TxSigningRequest, 0, len(transactionList.TransactionList))
+	for index, transaction := range transactionList.TransactionList {
+		signedTransaction, err := decodeSignedTransaction(index, transaction)
+		if err != nil {
+			return nil, err
+		}
+		request, err := signingRequestFromBody(index, signedTransaction.GetBodyBytes())
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// Sign signs every body with signer.
+func (envelope *TxEnvelope) Sign(ctx context.Context, signer Signer) error {
+	if signer == nil {
+		return fmt.Errorf("signer is required")
+	}
+	return envelope.updateSignedTransactions(func(index int, signedTransaction *protobufservices.SignedTransaction) error {
+		signature, err := signer.Sign(ctx, signedTransaction.GetBodyBytes())
+		if err != nil {
+			return fmt.Errorf("failed to sign transaction body %d: %w", index, err)
+		}
+		return appendSignaturePair(signedTransaction, signer.PublicKey(), signature)
+	})
+}
+
+// AddSignature attaches a detached signature for the body identified by
+// transactionID and nodeAccountID. The signature is not verified here; the
+// network rejects bad signatures on submission.
+func (envelope *TxEnvelope) AddSignature(
+	publicKey hedera.PublicKey,
+	signature []byte,
+	transactionID hedera.TransactionID,
+	nodeAccountID hedera.AccountID,
+) error {
+	if len(signature) == 0 {
+		return fmt.Errorf("signature is required")
+	}
+
+	matched := false
+	err := envelope.updateSignedTransactions(func(index int, signedTransaction *protobufservices.SignedTransaction) error {
+		request, err := signingRequestFromBody(index, signedTransaction.GetBodyBytes())
+		if err != nil {
+			return err
+		}
+		if request.TransactionID.String() != transactionID.String() ||
+			request.NodeAccountID.String() != nodeAccountID.String() {
+			return nil
+		}
+		matched = true
+		return appendSignaturePair(signedTransaction, publicKey, signature)
+	})
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return fmt.Errorf(
+			"envelope has no body for transaction %s on node %s",
+			transactionID.String(),
+			nodeAccountID.String(),
+		)
+	}
+	return nil
+}
+
+// MergeSignatures copies the signatures from another export of the same
+// transaction, so parties can sign copies in parallel.
+func (envelope *TxEnvelope) MergeSignatures(data []byte) error {
+	other, err := TxEnvelopeFromBytes(data)
+	if err != nil {
+		return err
+	}
+	otherList, err := other.decodeList()
+	if err != nil {
+		return err
+	}
+
+	return envelope.updateSignedTransactions(func(index int, signedTransaction *protobufservices.SignedTransaction) error {
+		if index >= len(otherList.TransactionList) {
+			return fmt.Errorf("merged envelope is missing transaction body %d", index)
+		}
+		otherSigned, err := decodeSignedTransaction(index, otherList.TransactionList[index])
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(otherSigned.GetBodyBytes(), signedTransaction.GetBodyBytes()) {
+			return fmt.Errorf("merged envelope body %d does not match", index)
+		}
+		if signedTransaction.SigMap == nil {
+			signedTransaction.SigMap = &protobufservices.SignatureMap{}
+		}
+		for _, pair := range otherSigned.GetSigMap().GetSigPair() {
+			if !signaturePairPresent(signedTransaction.SigMap.SigPair, pair) {
+				signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, pair)
+			}
+		}
+		return nil
+	})
+}
+
+// SignedBy reports whether every body carries a signature from publicKey.
+func (envelope *TxEnvelope) SignedBy(publicKey hedera.PublicKey) (bool, error) {
+	transactionList, err := envelope.decodeList()
+	if err != nil {
+		return false, err
+	}
+	prefix := publicKey.BytesRaw()
+	for index, transaction := range transactionList.TransactionList {
+		signedTransaction, err := decodeSignedTransaction(index, transaction)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, pair := range signedTransaction.GetSigMap().GetSigPair() {
+			if bytes.Equal(pair.GetPubKeyPrefix(), prefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Submit executes the envelope with client through executor and waits for a
+// successful receipt. A nil executor uses [DefaultExecutor]. client only
+// needs a network; if its operator is the payer and has not signed yet, the
+// SDK adds the operator signature.
+func (envelope *TxEnvelope) Submit(
+	ctx context.Context,
+	client *hedera.Client,
+	executor *Executor,
+) (TxSubmitResult, error) {
+	if client == nil {
+		return TxSubmitResult{}, fmt.Errorf("hedera client is required")
+	}
+	if err := ctx.Err(); err != nil {
+		return TxSubmitResult{}, err
+	}
+	frozen, err := envelope.ExportBytes()
+	if err != nil {
+		return TxSubmitResult{}, err
+	}
+	transaction, err := hedera.TransactionFromBytes(frozen)
+	if err != nil {
+		return TxSubmitResult{}, fmt.Errorf("failed to decode envelope transaction: %w", err)
+	}
+
+	executed, err := executor.Execute(ctx, client, transaction)
+	if err != nil {
+		return TxSubmitResult{}, fmt.Errorf("failed to submit envelope transaction: %w", err)
+	}
+	return TxSubmitResult{
+		TransactionID: executed.TransactionID,
+		Receipt:       executed.Receipt,
+	}, nil
+}
+
+func (envelope *TxEnvelope) decodeList() (*sdk.TransactionList, error) {
+	if !envelope.IsFrozen() {
+		return nil, fmt.Errorf("transaction envelope is not frozen")
+	}
+	var transactionList sdk.TransactionList
+	if err := protobuf.Unmarshal(envelope.frozen, &transactionList); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction list: %w", err)
+	}
+	if len(transactionList.TransactionList) == 0 {
+		return nil, fmt.Errorf("transaction list is empty")
+	}
+	return &transactionList, nil
+}
+
+func (envelope *TxEnvelope) updateSignedTransactions(
+	update func(index int, signedTransaction *protobufservices.SignedTransaction) error,
+) error {
+	transactionList, err := envelope.decodeList()
+	if err != nil {
+		return err
+	}
+
+	for index, transaction := range transactionList.TransactionList {
+		signedTransaction, err := decodeSignedTransaction(index, transaction)
+		if err != nil {
+			return err
+		}
+		if err := update(index, signedTransaction); err != nil {
+			return err
+		}
+		signedTransactionBytes, err := protobuf.Marshal(signedTransaction)
+		if err != nil {
+			return fmt.Errorf("failed to encode signed transaction %d: %w", index, err)
+		}
+		transactionList.TransactionList[index].SignedTransactionBytes = signedTransactionBytes
+	}
+
+	updated, err := protobuf.Marshal(transactionList)
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction list: %w", err)
+	}
+	transaction, err := hedera.TransactionFromBytes(updated)
+	if err != nil {
+		return fmt.Errorf("failed to decode signed transaction: %w", err)
+	}
+
+	envelope.transaction = transaction
+	envelope.frozen = updated
+	return nil
+}
+
+func decodeSignedTransaction(
+	index int,
+	transaction *protobufservices.Transaction,
+) (*protobufservices.SignedTransaction, error) {
+	if transaction == nil || len(transaction.GetSignedTransactionBytes()) == 0 {
+		return nil, fmt.Errorf("transaction %d does not contain SignedTransactionBytes", index)
+	}
+	var signedTransaction protobufservices.SignedTransaction
+	if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signedTransaction); err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction %d: %w", index, err)
+	}
+	return &signedTransaction, nil
+}
+
+func signingRequestFromBody(index int, bodyBytes []byte) (TxSigningRequest, error) {
+	var body protobufservices.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return TxSigningRequest{}, fmt.Errorf("failed to decode transaction body %d: %w", index, err)
+	}
+	if body.GetTransactionID() == nil || body.GetNodeAccountID() == nil {
+		return TxSigningRequest{}, fmt.Errorf("transaction body %d is missing its transaction or node account ID", index)
+	}
+
+	transactionIDBytes, err := protobuf.Marshal(body.GetTransactionID())
+	if err != nil {
+		return TxSigningRequest{}, fmt.Errorf("failed to encode transaction ID %d: %w", index, err)
+	}
+	transactionID, err := hedera.TransactionIDFromBytes(transactionIDBytes)
+	if err != nil {
+		return TxSigningRequest{}, fmt.Errorf("failed to decode transaction ID %d: %w", index, err)
+	}
+	nodeAccountIDBytes, err := protobuf.Marshal(body.GetNodeAccountID())
+	if err != nil {
+		return TxSigningRequest{}, fmt.Errorf("failed to encode node account ID %d: %w", index, err)
+	}
+	nodeAccountID, err := hedera.AccountIDFromBytes(nodeAccountIDBytes)
+	if err != nil {
+		return TxSigningRequest{}, fmt.Errorf("failed to decode node account ID %d: %w", index, err)
+	}
+
+	return TxSigningRequest{
+		TransactionID: transactionID,
+		NodeAccountID: nodeAccountID,
+		BodyBytes:     bodyBytes,
+	}, nil
+}
+
+func appendSignaturePair(
+	signedTransaction *protobufservices.SignedTransaction,
+	publicKey hedera.PublicKey,
+	signature []byte,
+) error {
+	keyType, err := KeyType(publicKey)
+	if err != nil {
+		return err
+	}
+
+	pair := &protobufservices.SignaturePair{PubKeyPrefix: publicKey.BytesRaw()}
+	if keyType == KeyTypeEd25519 {
+		pair.Signature = &protobufservices.SignaturePair_Ed25519{Ed25519: signature}
+	} else {
+		pair.Signature = &protobufservices.SignaturePair_ECDSASecp256K1{ECDSASecp256K1: signature}
+	}
+
+	if signedTransaction.SigMap == nil {
+		signedTransaction.SigMap = &protobufservices.SignatureMap{}
+	}
+	for _, existing := range signedTransaction.SigMap.SigPair {
+		if bytes.Equal(existing.GetPubKeyPrefix(), pair.PubKeyPrefix) {
+			return nil
+		}
+	}
+	signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, pair)
+	return nil
+}
+
+func signaturePairPresent(
+	existingPairs []*protobufservices.SignaturePair,
+	candidate *protobufservices.SignaturePair,
+) bool {
+	for _, existing := range existingPairs {
+		if bytes.Equal(existing.GetPubKeyPrefix(), candidate.GetPubKeyPrefix()) {
+			return true
+		}
+	}
+	return false
+}
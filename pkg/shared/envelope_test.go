@@ -0,0 +1,214 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+func newTestEnvelope(t *testing.T) *TxEnvelope {
+	t.Helper()
+
+	topicID, err := hedera.TopicIDFromString("0.0.5005")
+	if err != nil {
+		t.Fatalf("failed to parse topic ID: %v", err)
+	}
+	transaction := hedera.NewTopicMessageSubmitTransaction().
+		SetTopicID(topicID).
+		SetMessage([]byte(`{"p":"hcs-16"}`))
+
+	envelope, err := FreezeTxEnvelope(transaction, FreezeOptions{
+		NodeAccountIDs: []hedera.AccountID{{Account: 3}, {Account: 4}},
+		PayerAccountID: "0.0.1234",
+	})
+	if err != nil {
+		t.Fatalf("failed to freeze envelope: %v", err)
+	}
+	return envelope
+}
+
+func TestTxEnvelopeFreezeAndExport(t *testing.T) {
+	envelope := newTestEnvelope(t)
+	if !envelope.IsFrozen() {
+		t.Fatalf("expected envelope to be frozen")
+	}
+
+	requests, err := envelope.SigningRequests()
+	if err != nil {
+		t.Fatalf("SigningRequests failed: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected one signing request per node, got %d", len(requests))
+	}
+	if requests[0].NodeAccountID.String() != "0.0.3" || requests[1].NodeAccountID.String() != "0.0.4" {
+		t.Fatalf("unexpected node set: %s, %s", requests[0].NodeAccountID, requests[1].NodeAccountID)
+	}
+	if requests[0].TransactionID.AccountID.String() != "0.0.1234" {
+		t.Fatalf("unexpected payer %s", requests[0].TransactionID.AccountID)
+	}
+
+	exported, err := envelope.ExportBytes()
+	if err != nil {
+		t.Fatalf("ExportBytes failed: %v", err)
+	}
+	restored, err := TxEnvelopeFromBytes(exported)
+	if err != nil {
+		t.Fatalf("TxEnvelopeFromBytes failed: %v", err)
+	}
+	restoredID, err := restored.TransactionID()
+	if err != nil {
+		t.Fatalf("TransactionID failed: %v", err)
+	}
+	if restoredID.String() != requests[0].TransactionID.String() {
+		t.Fatalf("expected transaction ID %s, got %s", requests[0].TransactionID, restoredID)
+	}
+
+	if _, err := NewTxEnvelope(nil); err == nil {
+		t.Fatalf("expected error for nil transaction")
+	}
+	unfrozen, err := NewTxEnvelope(hedera.NewTopicMessageSubmitTransaction())
+	if err != nil {
+		t.Fatalf("NewTxEnvelope failed: %v", err)
+	}
+	if _, err := unfrozen.ExportBytes(); err == nil {
+		t.Fatalf("expected export of unfrozen envelope to fail")
+	}
+	if _, err := TxEnvelopeFromBytes([]byte("not a transaction")); err == nil {
+		t.Fatalf("expected error for invalid bytes")
+	}
+}
+
+func TestTxEnvelopeMultiPartySigning(t *testing.T) {
+	coordinator := newTestEnvelope(t)
+	exported, err := coordinator.ExportBytes()
+	if err != nil {
+		t.Fatalf("ExportBytes failed: %v", err)
+	}
+
+	firstKey, _ := hedera.PrivateKeyGenerateEd25519()
+	secondKey, _ := hedera.PrivateKeyGenerateEd25519()
+
+	firstParty, err := TxEnvelopeFromBytes(exported)
+	if err != nil {
+		t.Fatalf("TxEnvelopeFromBytes failed: %v", err)
+	}
+	if err := firstParty.Sign(context.Background(), NewLocalSigner(firstKey)); err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	firstSigned, _ := firstParty.ExportBytes()
+
+	requests, err := coordinator.SigningRequests()
+	if err != nil {
+		t.Fatalf("SigningRequests failed: %v", err)
+	}
+	for _, request := range requests {
+		signature := secondKey.Sign(request.BodyBytes)
+		if err := coordinator.AddSignature(
+			secondKey.PublicKey(),
+			signature,
+			request.TransactionID,
+			request.NodeAccountID,
+		); err != nil {
+			t.Fatalf("AddSignature failed: %v", err)
+		}
+	}
+	if err := coordinator.MergeSignatures(firstSigned); err != nil {
+		t.Fatalf("MergeSignatures failed: %v", err)
+	}
+
+	for _, key := range []hedera.PrivateKey{firstKey, secondKey} {
+		signed, err := coordinator.SignedBy(key.PublicKey())
+		if err != nil {
+			t.Fatalf("SignedBy failed: %v", err)
+		}
+		if !signed {
+			t.Fatalf("expected envelope to carry a signature from %s", key.PublicKey())
+		}
+	}
+	outsider, _ := hedera.PrivateKeyGenerateEd25519()
+	if signed, _ := coordinator.SignedBy(outsider.PublicKey()); signed {
+		t.Fatalf("expected no signature from an unrelated key")
+	}
+
+	signatures, err := hedera.TransactionGetSignatures(coordinator.Transaction())
+	if err != nil {
+		t.Fatalf("TransactionGetSignatures failed: %v", err)
+	}
+	for nodeAccountID, nodeSignatures := range signatures {
+		if len(nodeSignatures) != 2 {
+			t.Fatalf("expected two signatures for node %s, got %d", nodeAccountID, len(nodeSignatures))
+		}
+		for publicKey, signature := range nodeSignatures {
+			if signature == nil || publicKey == nil {
+				t.Fatalf("unexpected empty signature pair for node %s", nodeAccountID)
+			}
+		}
+	}
+
+	// Re-adding a key that already signed is a no-op.
+	if err := coordinator.Sign(context.Background(), NewLocalSigner(firstKey)); err != nil {
+		t.Fatalf("repeat Sign failed: %v", err)
+	}
+	signatures, _ = hedera.TransactionGetSignatures(coordinator.Transaction())
+	for _, nodeSignatures := range signatures {
+		if len(nodeSignatures) != 2 {
+			t.Fatalf("expected duplicate signature to be skipped, got %d", len(nodeSignatures))
+		}
+	}
+}
+
+func TestTxEnvelopeSigningErrors(t *testing.T) {
+	envelope := newTestEnvelope(t)
+	key, _ := hedera.PrivateKeyGenerateEd25519()
+	requests, _ := envelope.SigningRequests()
+
+	otherNode := hedera.AccountID{Account: 99}
+	if err := envelope.AddSignature(key.PublicKey(), []byte{1}, requests[0].TransactionID, otherNode); err == nil {
+		t.Fatalf("expected error for unknown node")
+	}
+	if err := envelope.AddSignature(key.PublicKey(), nil, requests[0].TransactionID, requests[0].NodeAccountID); err == nil {
+		t.Fatalf("expected error for empty signature")
+	}
+	if err := envelope.Sign(context.Background(), nil); err == nil {
+		t.Fatalf("expected error for nil signer")
+	}
+	offline := NewOfflineSigner(key.PublicKey())
+	if err := envelope.Sign(context.Background(), offline); !errors.Is(err, ErrOfflineSigner) {
+		t.Fatalf("expected ErrOfflineSigner, got %v", err)
+	}
+
+	other := newTestEnvelope(t)
+	otherBytes, _ := other.ExportBytes()
+	if err := envelope.MergeSignatures(otherBytes); err == nil {
+		t.Fatalf("expected merge of a different transaction to fail")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	if _, err := envelope.Submit(ctx, client, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context cancellation, got %v", err)
+	}
+	if _, err := envelope.Submit(context.Background(), nil, nil); err == nil {
+		t.Fatalf("expected error for nil client")
+	}
+}
+
+func TestNormalizeExecutionMode(t *testing.T) {
+	mode, err := NormalizeExecutionMode("")
+	if err != nil || mode != ExecutionModeExecute {
+		t.Fatalf("expected default execute mode, got %q (%v)", mode, err)
+	}
+	mode, err = NormalizeExecutionMode(" Envelope ")
+	if err != nil || mode != ExecutionModeEnvelope {
+		t.Fatalf("expected envelope mode, got %q (%v)", mode, err)
+	}
+	if _, err := NormalizeExecutionMode("later"); err == nil {
+		t.Fatalf("expected error for unknown mode")
+	}
+}
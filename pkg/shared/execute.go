@@ -0,0 +1,308 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+// receiptPollTimeout bounds each receipt query, so ExecuteTransaction checks
+// the context between polls instead of leaving one long query running.
+const receiptPollTimeout = 5 * time.Second
+
+var (
+	// ErrInvalidSignature reports a transaction rejected for missing or
+	// invalid signatures.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrInsufficientPayerBalance reports a payer that cannot cover the fee
+	// or the transferred amount.
+	ErrInsufficientPayerBalance = errors.New("insufficient payer balance")
+	// ErrInsufficientTxFee reports a max transaction fee below the network
+	// fee.
+	ErrInsufficientTxFee = errors.New("insufficient transaction fee")
+	// ErrNetworkBusy reports a node or network that throttled or could not
+	// accept the transaction. A precheck rejection never reached consensus,
+	// so the same transaction may be resubmitted until its valid duration
+	// ends.
+	ErrNetworkBusy = errors.New("network busy")
+	// ErrTransactionExpired reports a transaction submitted outside its
+	// valid duration.
+	ErrTransactionExpired = errors.New("transaction expired")
+	// ErrDuplicateTransaction reports a transaction ID that was already
+	// submitted.
+	ErrDuplicateTransaction = errors.New("duplicate transaction")
+)
+
+// TransactionStatusError reports a transaction that failed precheck or
+// reached consensus with a non-success status. errors.Is matches it against
+// the Err* sentinels above and errors.As reaches the underlying SDK error.
+type TransactionStatusError struct {
+	TransactionID string
+	Status        hedera.Status
+	// Precheck is true when the node rejected the transaction before
+	// submitting it to consensus.
+	Precheck bool
+	Receipt  hedera.TransactionReceipt
+	cause    error
+}
+
+func (e *TransactionStatusError) Error() string {
+	stage := "receipt"
+	if e.Precheck {
+		stage = "precheck"
+	}
+	if e.TransactionID == "" {
+		return fmt.Sprintf("transaction failed %s with status %s", stage, e.Status.String())
+	}
+	return fmt.Sprintf("transaction %s failed %s with status %s", e.TransactionID, stage, e.Status.String())
+}
+
+// Is reports whether target is the sentinel for the error's status.
+func (e *TransactionStatusError) Is(target error) bool {
+	sentinel := statusSentinel(e.Status)
+	return sentinel != nil && sentinel == target
+}
+
+func (e *TransactionStatusError) Unwrap() error {
+	return e.cause
+}
+
+func statusSentinel(status hedera.Status) error {
+	switch status {
+	case hedera.StatusInvalidSignature,
+		hedera.StatusInvalidPayerSignature,
+		hedera.StatusInvalidSignatureTypeMismatchingKey:
+		return ErrInvalidSignature
+	case hedera.StatusInsufficientPayerBalance, hedera.StatusInsufficientAccountBalance:
+		return ErrInsufficientPayerBalance
+	case hedera.StatusInsufficientTxFee:
+		return ErrInsufficientTxFee
+	case hedera.StatusBusy,
+		hedera.StatusPlatformNotActive,
+		hedera.StatusPlatformTransactionNotCreated,
+		hedera.StatusThrottledAtConsensus:
+		return ErrNetworkBusy
+	case hedera.StatusTransactionExpired:
+		return ErrTransactionExpired
+	case hedera.StatusDuplicateTransaction:
+		return ErrDuplicateTransaction
+	default:
+		return nil
+	}
+}
+
+// ExecuteTransaction submits transaction with client and waits for a
+// successful receipt. The SDK calls run synchronously: the submission's
+// retries are bounded by ctx's deadline, or by the client's request timeout
+// when ctx has none, and each gRPC attempt by the same deadline. The receipt
+// is polled with short queries and ctx is checked between them, so a
+// cancelled ctx stops the wait once the current SDK call returns. Precheck
+// and receipt failures are returned as *TransactionStatusError.
+func ExecuteTransaction(
+	ctx context.Context,
+	client *hedera.Client,
+	transaction hedera.TransactionInterface,
+) (TxSubmitResult, error) {
+	if client == nil {
+		return TxSubmitResult{}, fmt.Errorf("hedera client is required")
+	}
+	if transaction == nil {
+		return TxSubmitResult{}, fmt.Errorf("transaction is required")
+	}
+	if err := ctx.Err(); err != nil {
+		return TxSubmitResult{}, err
+	}
+	deadline := executionDeadline(ctx, client)
+
+	remaining, err := remainingTimeout(deadline)
+	if err != nil {
+		return TxSubmitResult{}, fmt.Errorf("stopped waiting for transaction submission: %w", err)
+	}
+	boundTransaction(transaction, remaining, min(remaining, client.GetGrpcDeadline()))
+	response, err := hedera.TransactionExecute(transaction, client)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return TxSubmitResult{}, fmt.Errorf("stopped waiting for transaction submission: %w", errors.Join(ctxErr, err))
+		}
+		return TxSubmitResult{}, mapStatusError(err, "", true)
+	}
+	transactionID := response.TransactionID.String()
+
+	receipt, err := pollReceipt(ctx, client, response, deadline)
+	if err == nil {
+		err = receipt.ValidateStatus(true)
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return TxSubmitResult{}, fmt.Errorf("stopped waiting for receipt of transaction %s: %w", transactionID, err)
+		}
+		err = mapStatusError(err, transactionID, false)
+		var statusErr *TransactionStatusError
+		if errors.As(err, &statusErr) {
+			statusErr.Receipt = receipt
+		}
+		return TxSubmitResult{}, err
+	}
+
+	return TxSubmitResult{
+		TransactionID: transactionID,
+		Receipt:       receipt,
+	}, nil
+}
+
+// pollReceipt queries the receipt in rounds of at most receiptPollTimeout
+// until it reaches consensus, ctx is done or deadline passes.
+func pollReceipt(
+	ctx context.Context,
+	client *hedera.Client,
+	response hedera.TransactionResponse,
+	deadline time.Time,
+) (hedera.TransactionReceipt, error) {
+	for {
+		remaining, err := remainingTimeout(deadline)
+		if err != nil {
+			return hedera.TransactionReceipt{}, err
+		}
+		timeout := min(remaining, receiptPollTimeout)
+		query := response.GetReceiptQueryWithClient(client).
+			SetIncludeChildren(response.IncludeChildReceipts).
+			SetGrpcDeadline(&timeout)
+		query.SetRequestTimeout(&timeout)
+
+		receipt, err := query.Execute(client)
+		if !receiptPending(receipt, err) {
+			return receipt, err
+		}
+		if err := ctx.Err(); err != nil {
+			return hedera.TransactionReceipt{}, err
+		}
+	}
+}
+
+// receiptPending reports whether a receipt query ended before the
+// transaction reached consensus. The SDK returns an empty receipt, whose
+// status is OK, when a query runs out of time.
+func receiptPending(receipt hedera.TransactionReceipt, err error) bool {
+	if err == nil {
+		return receipt.Status == hedera.StatusOk
+	}
+	var precheck hedera.ErrHederaPreCheckStatus
+	if !errors.As(err, &precheck) {
+		return false
+	}
+	switch precheck.Status {
+	case hedera.StatusBusy,
+		hedera.StatusUnknown,
+		hedera.StatusReceiptNotFound,
+		hedera.StatusPlatformNotActive,
+		hedera.StatusPlatformTransactionNotCreated:
+		return true
+	default:
+		return false
+	}
+}
+
+// executionDeadline returns ctx's deadline, or the client's request timeout
+// from now when ctx has none.
+func executionDeadline(ctx context.Context, client *hedera.Client) time.Time {
+	if deadline, ok := ctx.Deadline(); ok {
+		return deadline
+	}
+	return time.Now().Add(client.GetRequestTimeout())
+}
+
+// remainingTimeout returns the time left before deadline.
+func remainingTimeout(deadline time.Time) (time.Duration, error) {
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return 0, context.DeadlineExceeded
+	}
+	return remaining, nil
+}
+
+// deadlineSetter is the SDK's per-transaction timeout API. Every
+// transaction type T implements it through its embedded hedera.Transaction[T];
+// E is the SDK's unexported executable type, which type inference fills in.
+type deadlineSetter[T any, E any] interface {
+	SetRequestTimeout(*time.Duration) E
+	SetGrpcDeadline(*time.Duration) T
+}
+
+// transactionBounds lists the transaction types whose timeouts
+// boundTransaction can set. Other types keep the client's timeouts.
+var transactionBounds = []func(hedera.TransactionInterface, time.Duration, time.Duration) bool{
+	boundAs[*hedera.AccountCreateTransaction],
+	boundAs[*hedera.AccountUpdateTransaction],
+	boundAs[*hedera.FileAppendTransaction],
+	boundAs[*hedera.FileCreateTransaction],
+	boundAs[*hedera.ScheduleCreateTransaction],
+	boundAs[*hedera.ScheduleDeleteTransaction],
+	boundAs[*hedera.ScheduleSignTransaction],
+	boundAs[*hedera.TokenAssociateTransaction],
+	boundAs[*hedera.TokenCreateTransaction],
+	boundAs[*hedera.TokenMintTransaction],
+	boundAs[*hedera.TopicCreateTransaction],
+	boundAs[*hedera.TopicDeleteTransaction],
+	boundAs[*hedera.TopicMessageSubmitTransaction],
+	boundAs[*hedera.TopicUpdateTransaction],
+	boundAs[*hedera.TransferTransaction],
+}
+
+// boundTransaction caps the SDK's retry loop for transaction at
+// requestTimeout and each attempt at grpcDeadline.
+func boundTransaction(transaction hedera.TransactionInterface, requestTimeout time.Duration, grpcDeadline time.Duration) {
+	for _, bound := range transactionBounds {
+		if bound(transaction, requestTimeout, grpcDeadline) {
+			return
+		}
+	}
+}
+
+// boundAs sets the timeouts of transaction when it is a T, or the V that T
+// points to as returned by hedera.TransactionFromBytes.
+func boundAs[T interface {
+	*V
+	deadlineSetter[T, E]
+}, V any, E any](transaction hedera.TransactionInterface, requestTimeout time.Duration, grpcDeadline time.Duration) bool {
+	var typed T
+	switch value := transaction.(type) {
+	case T:
+		typed = value
+	case V:
+		typed = &value
+	default:
+		return false
+	}
+	typed.SetRequestTimeout(&requestTimeout)
+	typed.SetGrpcDeadline(&grpcDeadline)
+	return true
+}
+
+// mapStatusError converts SDK status errors into *TransactionStatusError.
+// submitting distinguishes node precheck failures from receipt failures.
+func mapStatusError(err error, transactionID string, submitting bool) error {
+	var precheck hedera.ErrHederaPreCheckStatus
+	if errors.As(err, &precheck) {
+		if transactionID == "" && precheck.TxID.AccountID != nil {
+			transactionID = precheck.TxID.String()
+		}
+		return &TransactionStatusError{
+			TransactionID: transactionID,
+			Status:        precheck.Status,
+			Precheck:      submitting,
+			cause:         err,
+		}
+	}
+	var receiptStatus hedera.ErrHederaReceiptStatus
+	if errors.As(err, &receiptStatus) {
+		return &TransactionStatusError{
+			TransactionID: transactionID,
+			Status:        receiptStatus.Status,
+			cause:         err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+func TestExecuteTransactionValidation(t *testing.T) {
+	transaction := hedera.NewTopicMessageSubmitTransaction()
+	if _, err := ExecuteTransaction(context.Background(), nil, transaction); err == nil {
+		t.Fatalf("expected error for nil client")
+	}
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	if _, err := ExecuteTransaction(context.Background(), client, nil); err == nil {
+		t.Fatalf("expected error for nil transaction")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ExecuteTransaction(ctx, client, transaction); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context cancellation, got %v", err)
+	}
+}
+
+func TestBoundTransaction(t *testing.T) {
+	transaction := hedera.NewTopicMessageSubmitTransaction()
+	boundTransaction(transaction, 3*time.Second, time.Second)
+	if timeout := transaction.GetRequestTimeout(); timeout == nil || *timeout != 3*time.Second {
+		t.Fatalf("expected a 3s request timeout, got %v", timeout)
+	}
+	if deadline := transaction.GetGrpcDeadline(); deadline == nil || *deadline != time.Second {
+		t.Fatalf("expected a 1s gRPC deadline, got %v", deadline)
+	}
+
+	frozen, err := hedera.NewTransferTransaction().
+		SetTransactionID(hedera.TransactionIDGenerate(hedera.AccountID{Account: 1234})).
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+		Freeze()
+	if err != nil {
+		t.Fatalf("failed to freeze transfer: %v", err)
+	}
+	raw, _ := frozen.ToBytes()
+	restored, err := hedera.TransactionFromBytes(raw)
+	if err != nil {
+		t.Fatalf("TransactionFromBytes failed: %v", err)
+	}
+	boundTransaction(restored, 2*time.Second, time.Second)
+	if timeout := restored.GetRequestTimeout(); timeout == nil || *timeout != 2*time.Second {
+		t.Fatalf("expected a decoded transfer to take a 2s request timeout, got %v", timeout)
+	}
+
+	if _, err := remainingTimeout(time.Now().Add(-time.Second)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if deadline, _ := ctx.Deadline(); !executionDeadline(ctx, client).Equal(deadline) {
+		t.Fatalf("expected the context deadline to bound execution")
+	}
+	if time.Until(executionDeadline(context.Background(), client)) <= 0 {
+		t.Fatalf("expected the request timeout to bound execution")
+	}
+}
+
+func TestReceiptPending(t *testing.T) {
+	if !receiptPending(hedera.TransactionReceipt{}, nil) {
+		t.Fatalf("expected an empty receipt to be pending")
+	}
+	if receiptPending(hedera.TransactionReceipt{Status: hedera.StatusSuccess}, nil) {
+		t.Fatalf("expected a successful receipt to be final")
+	}
+	busy := hedera.ErrHederaPreCheckStatus{Status: hedera.StatusReceiptNotFound}
+	if !receiptPending(hedera.TransactionReceipt{Status: busy.Status}, busy) {
+		t.Fatalf("expected a missing receipt to be pending")
+	}
+	invalid := hedera.ErrHederaPreCheckStatus{Status: hedera.StatusInvalidTransactionID}
+	if receiptPending(hedera.TransactionReceipt{Status: invalid.Status}, invalid) {
+		t.Fatalf("expected an invalid transaction ID to be final")
+	}
+	if receiptPending(hedera.TransactionReceipt{}, errors.New("connection refused")) {
+		t.Fatalf("expected other errors to be final")
+	}
+}
+
+func TestMapStatusError(t *testing.T) {
+	accountID := hedera.AccountID{Account: 1234}
+	transactionID := hedera.TransactionIDGenerate(accountID)
+
+	err := mapStatusError(
+		fmt.Errorf("wrapped: %w", hedera.ErrHederaPreCheckStatus{TxID: transactionID, Status: hedera.StatusInsufficientPayerBalance}),
+		"",
+		true,
+	)
+	var statusErr *TransactionStatusError
+	if !errors.As(err, &statusErr) || !statusErr.Precheck || statusErr.TransactionID != transactionID.String() {
+		t.Fatalf("unexpected precheck mapping %#v", err)
+	}
+	if !errors.Is(err, ErrInsufficientPayerBalance) || errors.Is(err, ErrNetworkBusy) {
+		t.Fatalf("expected insufficient payer balance, got %v", err)
+	}
+	var precheck hedera.ErrHederaPreCheckStatus
+	if !errors.As(err, &precheck) {
+		t.Fatalf("expected the SDK error to remain reachable")
+	}
+
+	err = mapStatusError(hedera.ErrHederaReceiptStatus{Status: hedera.StatusInvalidSignature}, "0.0.1234@1.2", false)
+	if !errors.Is(err, ErrInvalidSignature) || !strings.Contains(err.Error(), "0.0.1234@1.2 failed receipt") {
+		t.Fatalf("unexpected receipt mapping %v", err)
+	}
+	err = mapStatusError(hedera.ErrHederaPreCheckStatus{Status: hedera.StatusBusy}, "", true)
+	if !errors.Is(err, ErrNetworkBusy) || err.Error() != "transaction failed precheck with status BUSY" {
+		t.Fatalf("unexpected busy mapping %v", err)
+	}
+	err = mapStatusError(hedera.ErrHederaReceiptStatus{Status: hedera.StatusInvalidTopicID}, "", false)
+	if errors.Is(err, ErrInvalidSignature) || !errors.As(err, &statusErr) || statusErr.Status != hedera.StatusInvalidTopicID {
+		t.Fatalf("unexpected unmapped status %v", err)
+	}
+
+	plain := errors.New("connection refused")
+	if mapStatusError(plain, "", true) != plain {
+		t.Fatalf("expected non-status errors to pass through")
+	}
+}
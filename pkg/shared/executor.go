@@ -0,0 +1,442 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+const (
+	defaultExecutorMaxAttempts = 3
+	defaultExecutorMinBackoff  = 250 * time.Millisecond
+	defaultExecutorMaxBackoff  = 8 * time.Second
+	// retryExpiryMargin is the time a resubmission needs before the
+	// transaction's valid duration ends to reach a node.
+	retryExpiryMargin = 5 * time.Second
+)
+
+// ErrMaxFeeExceeded reports a frozen transaction whose max fee is above the
+// executor's MaxFeeGuard.
+var ErrMaxFeeExceeded = errors.New("max transaction fee exceeds guard")
+
+// ExecutorConfig configures an Executor. Zero values use the defaults noted
+// on each field.
+type ExecutorConfig struct {
+	// MaxAttempts bounds submissions of one transaction, including the
+	// first. Defaults to 3.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential delay between
+	// attempts. They default to 250ms and 8s. Retries resubmit the same
+	// transaction and ID, so delays are shortened to land inside its valid
+	// duration and retrying stops once too little of it is left.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Retryable reports whether a failed attempt may be resubmitted.
+	// Defaults to IsRetryableError.
+	Retryable func(error) bool
+	// FetchRecord fetches the transaction record after every successful
+	// receipt.
+	FetchRecord bool
+	// MaxTransactionFees sets the max fee of unfrozen transactions by
+	// TransactionKind (for example "TopicCreate"), replacing the SDK's
+	// per-type default.
+	MaxTransactionFees map[string]hedera.Hbar
+	// MaxFeeGuard caps the max fee of every transaction. Unfrozen
+	// transactions offering more, or no max fee at all, are lowered to it;
+	// frozen ones offering more are rejected with ErrMaxFeeExceeded because
+	// changing them would invalidate their signatures.
+	MaxFeeGuard hedera.Hbar
+	Hooks       ExecutorHooks
+}
+
+// ExecutorHooks receive execution events, for example to record metrics.
+// Hooks run synchronously on the executing goroutine.
+type ExecutorHooks struct {
+	// OnRetry is called before waiting delay to resubmit a failed attempt.
+	OnRetry func(event ExecutionEvent, delay time.Duration)
+	// OnComplete is called once per Execute call with the final outcome.
+	OnComplete func(event ExecutionEvent)
+}
+
+// ExecutionEvent describes an execution attempt or its final outcome.
+type ExecutionEvent struct {
+	Kind          string
+	TransactionID string
+	Attempts      int
+	Duration      time.Duration
+	Status        hedera.Status
+	MaxFee        hedera.Hbar
+	ChargedFee    hedera.Hbar
+	Err           error
+}
+
+// ExecutionResult is the outcome of a successful Executor.Execute call.
+type ExecutionResult struct {
+	TransactionID string
+	Receipt       hedera.TransactionReceipt
+	Attempts      int
+	// Record, ChargedFee and ConsensusTimestamp are set when a record was
+	// requested. RecordErr is set instead when fetching it failed; the
+	// transaction itself still succeeded.
+	Record             *hedera.TransactionRecord
+	ChargedFee         hedera.Hbar
+	ConsensusTimestamp time.Time
+	RecordErr          error
+}
+
+// TopicID returns the topic created by the transaction.
+func (result ExecutionResult) TopicID() (string, error) {
+	if result.Receipt.TopicID == nil {
+		return "", fmt.Errorf("receipt for transaction %s did not include a topic ID", result.TransactionID)
+	}
+	return result.Receipt.TopicID.String(), nil
+}
+
+// SequenceNumber returns the topic sequence number assigned to a submitted
+// message.
+func (result ExecutionResult) SequenceNumber() int64 {
+	return int64(result.Receipt.TopicSequenceNumber) //nolint:gosec // sequence numbers fit in int64
+}
+
+// Executor submits transactions with retries, fee policy, optional record
+// retrieval and metrics hooks. Every SDK client executes through one; a nil
+// *Executor uses DefaultExecutor.
+type Executor struct {
+	config ExecutorConfig
+	// operatorSigner signs for the client operator before submission; see
+	// WithOperatorSigner.
+	operatorSigner Signer
+	// inheritDefault makes the executor use DefaultExecutor's config as of
+	// each call, for executors derived from a nil *Executor.
+	inheritDefault bool
+}
+
+var defaultExecutor atomic.Pointer[Executor]
+
+// NewExecutor returns an Executor for config.
+func NewExecutor(config ExecutorConfig) (*Executor, error) {
+	if config.MaxAttempts < 0 {
+		return nil, fmt.Errorf("max attempts must not be negative")
+	}
+	if config.MinBackoff < 0 || config.MaxBackoff < 0 {
+		return nil, fmt.Errorf("backoff must not be negative")
+	}
+	if config.MaxFeeGuard.AsTinybar() < 0 {
+		return nil, fmt.Errorf("max fee guard must not be negative")
+	}
+	for kind, fee := range config.MaxTransactionFees {
+		if fee.AsTinybar() <= 0 {
+			return nil, fmt.Errorf("max transaction fee for %s must be positive", kind)
+		}
+		if config.MaxFeeGuard.AsTinybar() > 0 && fee.AsTinybar() > config.MaxFeeGuard.AsTinybar() {
+			return nil, fmt.Errorf("max transaction fee for %s is above the max fee guard", kind)
+		}
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = defaultExecutorMaxAttempts
+	}
+	if config.MinBackoff == 0 {
+		config.MinBackoff = defaultExecutorMinBackoff
+	}
+	if config.MaxBackoff == 0 {
+		config.MaxBackoff = defaultExecutorMaxBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = config.MinBackoff
+	}
+	if config.Retryable == nil {
+		config.Retryable = IsRetryableError
+	}
+	fees := make(map[string]hedera.Hbar, len(config.MaxTransactionFees))
+	for kind, fee := range config.MaxTransactionFees {
+		fees[kind] = fee
+	}
+	config.MaxTransactionFees = fees
+	return &Executor{config: config}, nil
+}
+
+// DefaultExecutor returns the executor used by clients that were not given
+// one.
+func DefaultExecutor() *Executor {
+	if executor := defaultExecutor.Load(); executor != nil {
+		return executor
+	}
+	executor, _ := NewExecutor(ExecutorConfig{})
+	defaultExecutor.CompareAndSwap(nil, executor)
+	return defaultExecutor.Load()
+}
+
+// SetDefaultExecutor replaces the executor used by clients that were not
+// given one, for example to apply a max fee guard across all protocols. A
+// nil executor restores the built-in default.
+func SetDefaultExecutor(executor *Executor) {
+	defaultExecutor.Store(executor)
+}
+
+// WithOperatorSigner returns a copy of executor that signs transactions
+// through signer before submitting them whenever signer holds the client's
+// operator key, so signer errors are returned instead of surfacing as
+// INVALID_SIGNATURE. A nil executor derives from DefaultExecutor and a nil
+// signer returns executor unchanged.
+func (executor *Executor) WithOperatorSigner(signer Signer) *Executor {
+	if signer == nil {
+		return executor
+	}
+	if executor == nil {
+		return &Executor{operatorSigner: signer, inheritDefault: true}
+	}
+	derived := *executor
+	derived.operatorSigner = signer
+	return &derived
+}
+
+// resolve returns the executor whose config applies to a call.
+func (executor *Executor) resolve() *Executor {
+	if executor == nil {
+		return DefaultExecutor()
+	}
+	if executor.inheritDefault {
+		base := DefaultExecutor()
+		return &Executor{config: base.config, operatorSigner: executor.operatorSigner}
+	}
+	return executor
+}
+
+// IsRetryableError reports whether err is a precheck rejection caused by a
+// busy or throttled network. Such transactions never reached consensus, so
+// resubmitting them before they expire cannot execute them twice.
+func IsRetryableError(err error) bool {
+	var statusErr *TransactionStatusError
+	return errors.As(err, &statusErr) && statusErr.Precheck && errors.Is(err, ErrNetworkBusy)
+}
+
+// TransactionKind names the transaction type without its package and
+// "Transaction" suffix, for example "TopicMessageSubmit".
+func TransactionKind(transaction hedera.TransactionInterface) string {
+	name := fmt.Sprintf("%T", transaction)
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	return strings.TrimSuffix(name, "Transaction")
+}
+
+// Execute submits transaction with client and waits for a successful
+// receipt, resubmitting retryable failures with backoff.
+func (executor *Executor) Execute(
+	ctx context.Context,
+	client *hedera.Client,
+	transaction hedera.TransactionInterface,
+) (ExecutionResult, error) {
+	executor = executor.resolve()
+	return executor.execute(ctx, client, transaction, executor.config.FetchRecord)
+}
+
+// ExecuteWithRecord is Execute that also fetches the transaction record.
+func (executor *Executor) ExecuteWithRecord(
+	ctx context.Context,
+	client *hedera.Client,
+	transaction hedera.TransactionInterface,
+) (ExecutionResult, error) {
+	return executor.resolve().execute(ctx, client, transaction, true)
+}
+
+func (executor *Executor) execute(
+	ctx context.Context,
+	client *hedera.Client,
+	transaction hedera.TransactionInterface,
+	fetchRecord bool,
+) (ExecutionResult, error) {
+	if client == nil {
+		return ExecutionResult{}, fmt.Errorf("hedera client is required")
+	}
+	if transaction == nil {
+		return ExecutionResult{}, fmt.Errorf("transaction is required")
+	}
+
+	started := time.Now()
+	event := ExecutionEvent{Kind: TransactionKind(transaction)}
+	maxFee, err := executor.applyFeePolicy(client, transaction, event.Kind)
+	event.MaxFee = maxFee
+	if err != nil {
+		return ExecutionResult{}, executor.complete(event, started, err)
+	}
+	signErr, err := signWithOperator(ctx, client, executor.operatorSigner, transaction)
+	if err != nil {
+		return ExecutionResult{}, executor.complete(event, started, err)
+	}
+
+	for {
+		event.Attempts++
+		submitted, err := ExecuteTransaction(ctx, client, transaction)
+		if err == nil {
+			result := ExecutionResult{
+				TransactionID: submitted.TransactionID,
+				Receipt:       submitted.Receipt,
+				Attempts:      event.Attempts,
+			}
+			event.TransactionID = submitted.TransactionID
+			event.Status = submitted.Receipt.Status
+			if fetchRecord {
+				executor.fetchRecord(ctx, client, &result)
+				event.ChargedFee = result.ChargedFee
+			}
+			executor.complete(event, started, nil)
+			return result, nil
+		}
+
+		var statusErr *TransactionStatusError
+		if errors.As(err, &statusErr) {
+			event.TransactionID = statusErr.TransactionID
+			event.Status = statusErr.Status
+		}
+		if signerErr := signErr(); signerErr != nil {
+			return ExecutionResult{}, executor.complete(
+				event,
+				started,
+				errors.Join(fmt.Errorf("operator signer failed: %w", signerErr), err),
+			)
+		}
+		if event.Attempts >= executor.config.MaxAttempts || !executor.config.Retryable(err) {
+			return ExecutionResult{}, executor.complete(event, started, err)
+		}
+
+		delay, ok := retryDelay(transaction, executor.backoff(event.Attempts))
+		if !ok {
+			return ExecutionResult{}, executor.complete(
+				event,
+				started,
+				fmt.Errorf("stopped retrying after %d attempts: transaction expires before it could be resubmitted: %w", event.Attempts, err),
+			)
+		}
+		if executor.config.Hooks.OnRetry != nil {
+			retry := event
+			retry.Duration = time.Since(started)
+			retry.Err = err
+			executor.config.Hooks.OnRetry(retry, delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ExecutionResult{}, executor.complete(
+				event,
+				started,
+				fmt.Errorf("stopped retrying after %d attempts: %w", event.Attempts, errors.Join(ctx.Err(), err)),
+			)
+		case <-timer.C:
+		}
+	}
+}
+
+// applyFeePolicy applies the configured max fees to unfrozen transactions
+// and enforces the guard. It returns the max fee the transaction will offer,
+// or zero when the SDK default applies.
+func (executor *Executor) applyFeePolicy(
+	client *hedera.Client,
+	transaction hedera.TransactionInterface,
+	kind string,
+) (hedera.Hbar, error) {
+	fee, err := hedera.TransactionGetMaxTransactionFee(transaction)
+	if err != nil {
+		return hedera.Hbar{}, fmt.Errorf("failed to read max transaction fee: %w", err)
+	}
+	guard := executor.config.MaxFeeGuard.AsTinybar()
+	frozen, ok := transaction.(interface{ IsFrozen() bool })
+	if ok && frozen.IsFrozen() {
+		if guard > 0 && fee.AsTinybar() > guard {
+			return fee, fmt.Errorf(
+				"%w: frozen %s transaction offers %s, above %s",
+				ErrMaxFeeExceeded,
+				kind,
+				fee.String(),
+				executor.config.MaxFeeGuard.String(),
+			)
+		}
+		return fee, nil
+	}
+
+	if configured, ok := executor.config.MaxTransactionFees[kind]; ok {
+		fee = configured
+	} else if fee.AsTinybar() == 0 && client.GetDefaultMaxTransactionFee().AsTinybar() > 0 {
+		fee = client.GetDefaultMaxTransactionFee()
+	}
+	if guard > 0 && (fee.AsTinybar() == 0 || fee.AsTinybar() > guard) {
+		fee = executor.config.MaxFeeGuard
+	}
+	if fee.AsTinybar() > 0 {
+		if _, err := hedera.TransactionSetMaxTransactionFee(transaction, fee); err != nil {
+			return hedera.Hbar{}, fmt.Errorf("failed to set max transaction fee: %w", err)
+		}
+	}
+	return fee, nil
+}
+
+func (executor *Executor) backoff(attempt int) time.Duration {
+	delay := executor.config.MinBackoff
+	for index := 1; index < attempt && delay < executor.config.MaxBackoff; index++ {
+		delay *= 2
+	}
+	return min(delay, executor.config.MaxBackoff)
+}
+
+// retryDelay shortens delay so a resubmission of transaction starts at least
+// retryExpiryMargin before its valid duration ends. It returns false when no
+// such time is left. Transactions without a valid start keep delay.
+func retryDelay(transaction hedera.TransactionInterface, delay time.Duration) (time.Duration, bool) {
+	transactionID, err := hedera.TransactionGetTransactionID(transaction)
+	if err != nil || transactionID.ValidStart == nil {
+		return delay, true
+	}
+	validDuration, err := hedera.TransactionGetTransactionValidDuration(transaction)
+	if err != nil || validDuration <= 0 {
+		return delay, true
+	}
+	remaining := time.Until(transactionID.ValidStart.Add(validDuration)) - retryExpiryMargin
+	if remaining <= 0 {
+		return 0, false
+	}
+	return min(delay, remaining), true
+}
+
+func (executor *Executor) fetchRecord(ctx context.Context, client *hedera.Client, result *ExecutionResult) {
+	transactionID := result.Receipt.TransactionID
+	if transactionID == nil {
+		parsed, err := hedera.TransactionIdFromString(result.TransactionID)
+		if err != nil {
+			result.RecordErr = fmt.Errorf("invalid transaction ID %s: %w", result.TransactionID, err)
+			return
+		}
+		transactionID = &parsed
+	}
+	query := hedera.NewTransactionRecordQuery().SetTransactionID(*transactionID)
+	timeout, err := remainingTimeout(executionDeadline(ctx, client))
+	if err != nil {
+		result.RecordErr = fmt.Errorf("failed to get record of transaction %s: %w", result.TransactionID, err)
+		return
+	}
+	grpcDeadline := min(timeout, client.GetGrpcDeadline())
+	query.SetGrpcDeadline(&grpcDeadline)
+	query.SetRequestTimeout(&timeout)
+	record, err := query.Execute(client)
+	if err != nil {
+		result.RecordErr = fmt.Errorf("failed to get record of transaction %s: %w", result.TransactionID, err)
+		return
+	}
+	result.Record = &record
+	result.ChargedFee = record.TransactionFee
+	result.ConsensusTimestamp = record.ConsensusTimestamp
+}
+
+func (executor *Executor) complete(event ExecutionEvent, started time.Time, err error) error {
+	if executor.config.Hooks.OnComplete != nil {
+		event.Duration = time.Since(started)
+		event.Err = err
+		executor.config.Hooks.OnComplete(event)
+	}
+	return err
+}
@@ -0,0 +1,198 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+func TestNewExecutorDefaultsAndValidation(t *testing.T) {
+	executor, err := NewExecutor(ExecutorConfig{})
+	if err != nil {
+		t.Fatalf("NewExecutor failed: %v", err)
+	}
+	if executor.config.MaxAttempts != 3 || executor.config.MinBackoff != 250*time.Millisecond {
+		t.Fatalf("unexpected defaults %+v", executor.config)
+	}
+	if got := []time.Duration{executor.backoff(1), executor.backoff(2), executor.backoff(7)}; got[0] != 250*time.Millisecond ||
+		got[1] != 500*time.Millisecond || got[2] != 8*time.Second {
+		t.Fatalf("unexpected backoff %v", got)
+	}
+
+	if _, err := NewExecutor(ExecutorConfig{MaxAttempts: -1}); err == nil {
+		t.Fatalf("expected error for negative attempts")
+	}
+	if _, err := NewExecutor(ExecutorConfig{
+		MaxFeeGuard:        hedera.NewHbar(1),
+		MaxTransactionFees: map[string]hedera.Hbar{"TopicCreate": hedera.NewHbar(5)},
+	}); err == nil {
+		t.Fatalf("expected error for per-operation fee above the guard")
+	}
+	if _, err := NewExecutor(ExecutorConfig{MaxTransactionFees: map[string]hedera.Hbar{"TopicCreate": {}}}); err == nil {
+		t.Fatalf("expected error for zero per-operation fee")
+	}
+}
+
+func TestDefaultExecutor(t *testing.T) {
+	custom, _ := NewExecutor(ExecutorConfig{MaxAttempts: 1})
+	SetDefaultExecutor(custom)
+	if DefaultExecutor() != custom {
+		t.Fatalf("expected the configured default executor")
+	}
+	SetDefaultExecutor(nil)
+	if executor := DefaultExecutor(); executor == nil || executor == custom || executor.config.MaxAttempts != 3 {
+		t.Fatalf("expected the built-in default executor")
+	}
+}
+
+func TestTransactionKind(t *testing.T) {
+	if kind := TransactionKind(hedera.NewTopicMessageSubmitTransaction()); kind != "TopicMessageSubmit" {
+		t.Fatalf("unexpected kind %s", kind)
+	}
+	if kind := TransactionKind(hedera.NewScheduleSignTransaction()); kind != "ScheduleSign" {
+		t.Fatalf("unexpected kind %s", kind)
+	}
+}
+
+func TestExecutorFeePolicy(t *testing.T) {
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	executor, err := NewExecutor(ExecutorConfig{
+		MaxFeeGuard:        hedera.NewHbar(3),
+		MaxTransactionFees: map[string]hedera.Hbar{"TopicMessageSubmit": hedera.NewHbar(1)},
+	})
+	if err != nil {
+		t.Fatalf("NewExecutor failed: %v", err)
+	}
+
+	message := hedera.NewTopicMessageSubmitTransaction()
+	fee, err := executor.applyFeePolicy(client, message, TransactionKind(message))
+	if err != nil || fee.AsTinybar() != hedera.NewHbar(1).AsTinybar() || message.GetMaxTransactionFee().AsTinybar() != fee.AsTinybar() {
+		t.Fatalf("expected the per-operation fee, got %s, %v", fee.String(), err)
+	}
+
+	create := hedera.NewTopicCreateTransaction()
+	if fee, err := executor.applyFeePolicy(client, create, TransactionKind(create)); err != nil || fee.AsTinybar() != hedera.NewHbar(3).AsTinybar() {
+		t.Fatalf("expected the SDK default to be capped at the guard, got %s, %v", fee.String(), err)
+	}
+
+	expensive, err := hedera.NewTopicCreateTransaction().
+		SetMaxTransactionFee(hedera.NewHbar(10)).
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+		SetTransactionID(hedera.TransactionIDGenerate(hedera.AccountID{Account: 1234})).
+		Freeze()
+	if err != nil {
+		t.Fatalf("failed to freeze transaction: %v", err)
+	}
+	if _, err := executor.applyFeePolicy(client, expensive, TransactionKind(expensive)); !errors.Is(err, ErrMaxFeeExceeded) {
+		t.Fatalf("expected max fee guard error, got %v", err)
+	}
+
+	var events []ExecutionEvent
+	guarded, _ := NewExecutor(ExecutorConfig{
+		MaxFeeGuard: hedera.NewHbar(3),
+		Hooks:       ExecutorHooks{OnComplete: func(event ExecutionEvent) { events = append(events, event) }},
+	})
+	if _, err := guarded.Execute(context.Background(), client, expensive); !errors.Is(err, ErrMaxFeeExceeded) {
+		t.Fatalf("expected Execute to refuse the transaction, got %v", err)
+	}
+	if len(events) != 1 || events[0].Attempts != 0 || events[0].Kind != "TopicCreate" || !errors.Is(events[0].Err, ErrMaxFeeExceeded) {
+		t.Fatalf("unexpected completion events %+v", events)
+	}
+}
+
+func TestExecutorRetryHooks(t *testing.T) {
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	var retries []time.Duration
+	var completed []ExecutionEvent
+	executor, err := NewExecutor(ExecutorConfig{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		Retryable:   func(error) bool { return true },
+		Hooks: ExecutorHooks{
+			OnRetry:    func(_ ExecutionEvent, delay time.Duration) { retries = append(retries, delay) },
+			OnComplete: func(event ExecutionEvent) { completed = append(completed, event) },
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewExecutor failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = executor.Execute(ctx, client, hedera.NewTopicMessageSubmitTransaction())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+	if len(retries) != 1 || len(completed) != 1 || completed[0].Attempts != 1 {
+		t.Fatalf("unexpected hook calls: retries %v, completed %+v", retries, completed)
+	}
+
+	var nilExecutor *Executor
+	if _, err := nilExecutor.Execute(context.Background(), nil, hedera.NewTopicMessageSubmitTransaction()); err == nil {
+		t.Fatalf("expected nil executor to fall back to the default and validate the client")
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	busy := mapStatusError(hedera.ErrHederaPreCheckStatus{Status: hedera.StatusBusy}, "", true)
+	if !IsRetryableError(busy) {
+		t.Fatalf("expected precheck BUSY to be retryable")
+	}
+	throttled := mapStatusError(hedera.ErrHederaReceiptStatus{Status: hedera.StatusThrottledAtConsensus}, "", false)
+	if IsRetryableError(throttled) {
+		t.Fatalf("expected consensus throttling not to be retryable with the same transaction ID")
+	}
+	if IsRetryableError(mapStatusError(hedera.ErrHederaPreCheckStatus{Status: hedera.StatusInvalidSignature}, "", true)) {
+		t.Fatalf("expected invalid signature not to be retryable")
+	}
+}
+
+func TestExecutionResultAccessors(t *testing.T) {
+	topicID := hedera.TopicID{Topic: 42}
+	result := ExecutionResult{
+		TransactionID: "0.0.1@1.2",
+		Receipt:       hedera.TransactionReceipt{TopicID: &topicID, TopicSequenceNumber: 7},
+	}
+	if got, err := result.TopicID(); err != nil || got != "0.0.42" {
+		t.Fatalf("unexpected topic ID %s, %v", got, err)
+	}
+	if result.SequenceNumber() != 7 {
+		t.Fatalf("unexpected sequence number %d", result.SequenceNumber())
+	}
+	if _, err := (ExecutionResult{}).TopicID(); err == nil {
+		t.Fatalf("expected error for missing topic ID")
+	}
+}
+
+func TestRetryDelayStaysInsideValidDuration(t *testing.T) {
+	if delay, ok := retryDelay(hedera.NewTopicMessageSubmitTransaction(), time.Second); !ok || delay != time.Second {
+		t.Fatalf("expected a transaction without an ID to keep its delay, got %v, %v", delay, ok)
+	}
+
+	accountID := hedera.AccountID{Account: 1234}
+	transaction := hedera.NewTopicMessageSubmitTransaction().
+		SetTransactionValidDuration(30 * time.Second).
+		SetTransactionID(hedera.NewTransactionIDWithValidStart(accountID, time.Now()))
+	if delay, ok := retryDelay(transaction, 8*time.Second); !ok || delay != 8*time.Second {
+		t.Fatalf("expected a fresh transaction to keep its delay, got %v, %v", delay, ok)
+	}
+	if delay, ok := retryDelay(transaction, time.Minute); !ok || delay > 25*time.Second {
+		t.Fatalf("expected the delay to be capped before expiry, got %v, %v", delay, ok)
+	}
+
+	expiring := hedera.NewTopicMessageSubmitTransaction().
+		SetTransactionValidDuration(30 * time.Second).
+		SetTransactionID(hedera.NewTransactionIDWithValidStart(accountID, time.Now().Add(-28*time.Second)))
+	if _, ok := retryDelay(expiring, time.Millisecond); ok {
+		t.Fatalf("expected no retry for a transaction about to expire")
+	}
+}
@@ -24,6 +24,9 @@ type ResolvedHederaOperator struct {
 	PrivateKey    hedera.PrivateKey
 	PrivateKeyRaw string
 	HasPrivateKey bool
+	// Signer signs for the operator. It wraps PrivateKey when one was given,
+	// holds the caller's signer otherwise, and is nil when neither was.
+	Signer Signer
 }
 
 var dotenvLoadOnce sync.Once
@@ -211,15 +214,32 @@ func ResolveHederaClientAndOperator(
 	injectedClient *hedera.Client,
 	operatorAccountID string,
 	operatorPrivateKey string,
+) (*hedera.Client, ResolvedHederaOperator, error) {
+	return ResolveHederaClientAndOperatorWithSigner(network, injectedClient, operatorAccountID, operatorPrivateKey, nil)
+}
+
+// ResolveHederaClientAndOperatorWithSigner is ResolveHederaClientAndOperator
+// with an optional operator Signer, used in place of a private key so the key
+// can stay in a KMS or HSM. At most one of operatorPrivateKey and
+// operatorSigner may be set.
+func ResolveHederaClientAndOperatorWithSigner(
+	network string,
+	injectedClient *hedera.Client,
+	operatorAccountID string,
+	operatorPrivateKey string,
+	operatorSigner Signer,
 ) (*hedera.Client, ResolvedHederaOperator, error) {
 	trimmedOperatorID := strings.TrimSpace(operatorAccountID)
 	trimmedOperatorKey := strings.TrimSpace(operatorPrivateKey)
+	if trimmedOperatorKey != "" && operatorSigner != nil {
+		return nil, ResolvedHederaOperator{}, fmt.Errorf("operator private key and operator signer cannot both be set")
+	}
 
 	if injectedClient == nil {
 		if trimmedOperatorID == "" {
 			return nil, ResolvedHederaOperator{}, fmt.Errorf("operator account ID is required")
 		}
-		if trimmedOperatorKey == "" {
+		if trimmedOperatorKey == "" && operatorSigner == nil {
 			return nil, ResolvedHederaOperator{}, fmt.Errorf("operator private key is required")
 		}
 
@@ -227,12 +247,25 @@ func ResolveHederaClientAndOperator(
 		if err != nil {
 			return nil, ResolvedHederaOperator{}, fmt.Errorf("invalid operator account ID: %w", err)
 		}
-		parsedOperatorKey, err := ParsePrivateKey(trimmedOperatorKey)
+
+		createdClient, err := NewHederaClient(network)
 		if err != nil {
 			return nil, ResolvedHederaOperator{}, err
 		}
 
-		createdClient, err := NewHederaClient(network)
+		if operatorSigner != nil {
+			if _, err := KeyType(operatorSigner.PublicKey()); err != nil {
+				return nil, ResolvedHederaOperator{}, fmt.Errorf("invalid operator signer: %w", err)
+			}
+			SetOperatorSigner(createdClient, parsedOperatorID, operatorSigner)
+			return createdClient, ResolvedHederaOperator{
+				AccountID: parsedOperatorID,
+				PublicKey: operatorSigner.PublicKey(),
+				Signer:    operatorSigner,
+			}, nil
+		}
+
+		parsedOperatorKey, err := ParsePrivateKey(trimmedOperatorKey)
 		if err != nil {
 			return nil, ResolvedHederaOperator{}, err
 		}
@@ -244,6 +277,7 @@ func ResolveHederaClientAndOperator(
 			PrivateKey:    parsedOperatorKey,
 			PrivateKeyRaw: trimmedOperatorKey,
 			HasPrivateKey: true,
+			Signer:        NewLocalSigner(parsedOperatorKey),
 		}, nil
 	}
 
@@ -288,6 +322,15 @@ func ResolveHederaClientAndOperator(
 		resolved.PrivateKey = parsedOperatorKey
 		resolved.PrivateKeyRaw = trimmedOperatorKey
 		resolved.HasPrivateKey = true
+		resolved.Signer = NewLocalSigner(parsedOperatorKey)
+	}
+	if operatorSigner != nil {
+		if operatorSigner.PublicKey().String() != resolvedPublicKey.String() {
+			return nil, ResolvedHederaOperator{}, fmt.Errorf(
+				"provided operator signer does not match the injected Hedera client operator",
+			)
+		}
+		resolved.Signer = operatorSigner
 	}
 
 	return injectedClient, resolved, nil
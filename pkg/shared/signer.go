@@ -0,0 +1,523 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	KeyTypeEd25519        = "ED25519"
+	KeyTypeECDSASecp256k1 = "ECDSA_SECP256K1"
+
+	ed25519PublicKeySize        = 32
+	compressedSecp256k1KeySize  = 33
+	transactionSignatureSize    = 64
+	digestSize                  = 32
+	defaultRemoteSignerTimeout  = 30 * time.Second
+	maxRemoteSignerResponseSize = 64 * 1024
+)
+
+// Signer signs transactions and challenges for a Hedera key. Implementations
+// may keep the private key outside the process. Sign receives the bytes to
+// sign and must return the signature the network expects for PublicKey: a
+// 64-byte Ed25519 signature, or for secp256k1 keys the 64-byte r||s signature
+// over the Keccak-256 digest of message.
+type Signer interface {
+	PublicKey() hedera.PublicKey
+	Sign(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// DigestSigner is implemented by Signers whose secp256k1 key can sign a
+// precomputed 32-byte digest, for protocols such as ES256K JWS that hash with
+// SHA-256 rather than Keccak-256. SignDigest returns the 64-byte low-S r||s
+// signature over digest as given.
+type DigestSigner interface {
+	Signer
+	SignDigest(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+// KeyType returns KeyTypeEd25519 or KeyTypeECDSASecp256k1 for publicKey.
+func KeyType(publicKey hedera.PublicKey) (string, error) {
+	switch len(publicKey.BytesRaw()) {
+	case ed25519PublicKeySize:
+		return KeyTypeEd25519, nil
+	case compressedSecp256k1KeySize:
+		return KeyTypeECDSASecp256k1, nil
+	}
+	return "", fmt.Errorf("unsupported public key type")
+}
+
+// LocalSigner signs with a private key held in process memory.
+type LocalSigner struct {
+	privateKey hedera.PrivateKey
+}
+
+// NewLocalSigner creates a Signer for an in-memory private key.
+func NewLocalSigner(privateKey hedera.PrivateKey) *LocalSigner {
+	return &LocalSigner{privateKey: privateKey}
+}
+
+// NewLocalSignerFromString parses raw with ParsePrivateKey and wraps it in a LocalSigner.
+func NewLocalSignerFromString(raw string) (*LocalSigner, error) {
+	privateKey, err := ParsePrivateKey(raw)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalSigner(privateKey), nil
+}
+
+// PublicKey returns the public key of the wrapped private key.
+func (signer *LocalSigner) PublicKey() hedera.PublicKey {
+	return signer.privateKey.PublicKey()
+}
+
+// PrivateKey returns the wrapped private key.
+func (signer *LocalSigner) PrivateKey() hedera.PrivateKey {
+	return signer.privateKey
+}
+
+// Sign signs message with the wrapped private key.
+func (signer *LocalSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return signer.privateKey.Sign(message), nil
+}
+
+// SignDigest signs a 32-byte digest with the wrapped secp256k1 private key.
+func (signer *LocalSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := checkDigest(signer.PublicKey(), digest); err != nil {
+		return nil, err
+	}
+	key, _ := btcec.PrivKeyFromBytes(signer.privateKey.BytesRaw())
+	return btcecdsa.SignCompact(key, digest, true)[1:], nil
+}
+
+// checkDigest rejects digest signing for non-secp256k1 keys and digests of
+// the wrong size.
+func checkDigest(publicKey hedera.PublicKey, digest []byte) error {
+	if keyType, _ := KeyType(publicKey); keyType != KeyTypeECDSASecp256k1 {
+		return fmt.Errorf("digest signing requires a secp256k1 key")
+	}
+	if len(digest) != digestSize {
+		return fmt.Errorf("digest must be %d bytes, got %d", digestSize, len(digest))
+	}
+	return nil
+}
+
+// ErrOfflineSigner is returned by OfflineSigner.Sign.
+var ErrOfflineSigner = errors.New("offline signer cannot sign; sign the exported transaction envelope instead")
+
+// OfflineSigner holds only a public key. It lets a client be configured for a
+// cold-wallet operator in envelope mode, where signatures are added later to
+// the exported TxEnvelope.
+type OfflineSigner struct {
+	publicKey hedera.PublicKey
+}
+
+// NewOfflineSigner creates a Signer that can never sign.
+func NewOfflineSigner(publicKey hedera.PublicKey) *OfflineSigner {
+	return &OfflineSigner{publicKey: publicKey}
+}
+
+// PublicKey returns the configured public key.
+func (signer *OfflineSigner) PublicKey() hedera.PublicKey {
+	return signer.publicKey
+}
+
+// Sign always returns ErrOfflineSigner.
+func (signer *OfflineSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	return nil, ErrOfflineSigner
+}
+
+// RemoteSignerConfig configures a RemoteSigner.
+type RemoteSignerConfig struct {
+	// URL receives a POST for every signature.
+	URL string
+	// PublicKey is the key the remote service signs for.
+	PublicKey hedera.PublicKey
+	// KeyID is passed to the service to select the key, when it holds several.
+	KeyID      string
+	Headers    map[string]string
+	HTTPClient *http.Client
+}
+
+// RemoteSigner signs by calling a signing service over HTTP. Each request is
+// a JSON POST of {"publicKey", "keyType", "keyId", "message"}, with the
+// public key in hex and the message in base64, and the service answers with
+// {"signature"} in base64. The service applies the Hedera signing rules
+// described on Signer. SignDigest sends {"digest"} in place of "message",
+// which the service signs as-is with its secp256k1 key.
+type RemoteSigner struct {
+	url        string
+	publicKey  hedera.PublicKey
+	keyType    string
+	keyID      string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+type remoteSignRequest struct {
+	PublicKey string `json:"publicKey"`
+	KeyType   string `json:"keyType"`
+	KeyID     string `json:"keyId,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Digest    string `json:"digest,omitempty"`
+}
+
+type remoteSignResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error,omitempty"`
+}
+
+// NewRemoteSigner creates a RemoteSigner.
+func NewRemoteSigner(config RemoteSignerConfig) (*RemoteSigner, error) {
+	url := strings.TrimSpace(config.URL)
+	if url == "" {
+		return nil, fmt.Errorf("remote signer URL is required")
+	}
+	keyType, err := KeyType(config.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer public key is required: %w", err)
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultRemoteSignerTimeout}
+	}
+	headers := make(map[string]string, len(config.Headers))
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+
+	return &RemoteSigner{
+		url:        url,
+		publicKey:  config.PublicKey,
+		keyType:    keyType,
+		keyID:      strings.TrimSpace(config.KeyID),
+		headers:    headers,
+		httpClient: httpClient,
+	}, nil
+}
+
+// PublicKey returns the configured public key.
+func (signer *RemoteSigner) PublicKey() hedera.PublicKey {
+	return signer.publicKey
+}
+
+// Sign asks the remote service to sign message.
+func (signer *RemoteSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	return signer.request(ctx, remoteSignRequest{
+		Message: base64.StdEncoding.EncodeToString(message),
+	})
+}
+
+// SignDigest asks the remote service to sign a 32-byte digest with its
+// secp256k1 key.
+func (signer *RemoteSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	if err := checkDigest(signer.publicKey, digest); err != nil {
+		return nil, err
+	}
+	return signer.request(ctx, remoteSignRequest{
+		Digest: base64.StdEncoding.EncodeToString(digest),
+	})
+}
+
+func (signer *RemoteSigner) request(ctx context.Context, payload remoteSignRequest) ([]byte, error) {
+	payload.PublicKey = hex.EncodeToString(signer.publicKey.BytesRaw())
+	payload.KeyType = signer.keyType
+	payload.KeyID = signer.keyID
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, signer.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range signer.headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := signer.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("remote signing request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxRemoteSignerResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	var decoded remoteSignResponse
+	decodeErr := json.Unmarshal(responseBody, &decoded)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message := strings.TrimSpace(decoded.Error)
+		if message == "" {
+			message = strings.TrimSpace(string(responseBody))
+		}
+		return nil, fmt.Errorf("remote signer returned status %d: %s", response.StatusCode, message)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("failed to decode remote signer response: %w", decodeErr)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(decoded.Signature))
+	if err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid signature encoding: %w", err)
+	}
+	if len(signature) != transactionSignatureSize {
+		return nil, fmt.Errorf("remote signer returned a %d-byte signature, expected %d", len(signature), transactionSignatureSize)
+	}
+	return signature, nil
+}
+
+// PKCS11Mechanism is a PKCS#11 signing mechanism (CKM_*) value.
+type PKCS11Mechanism uint
+
+const (
+	// PKCS11MechanismECDSA is CKM_ECDSA, which signs a precomputed digest.
+	PKCS11MechanismECDSA PKCS11Mechanism = 0x00001041
+	// PKCS11MechanismEdDSA is CKM_EDDSA, which signs the message itself.
+	PKCS11MechanismEdDSA PKCS11Mechanism = 0x00001057
+)
+
+// PKCS11Session is the part of a PKCS#11 session PKCS11Signer needs. Wrap the
+// C_SignInit and C_Sign calls of a PKCS#11 binding to implement it.
+type PKCS11Session interface {
+	Sign(ctx context.Context, keyHandle uint, mechanism PKCS11Mechanism, data []byte) ([]byte, error)
+}
+
+// PKCS11Signer signs with a key stored in an HSM through a PKCS11Session.
+// For secp256k1 keys it hashes messages with Keccak-256 before calling the
+// token, and accepts raw r||s or DER signatures back.
+type PKCS11Signer struct {
+	session   PKCS11Session
+	keyHandle uint
+	publicKey hedera.PublicKey
+	mechanism PKCS11Mechanism
+}
+
+// NewPKCS11Signer creates a PKCS11Signer for the private key object keyHandle,
+// whose public key is publicKey.
+func NewPKCS11Signer(session PKCS11Session, keyHandle uint, publicKey hedera.PublicKey) (*PKCS11Signer, error) {
+	if session == nil {
+		return nil, fmt.Errorf("PKCS#11 session is required")
+	}
+	keyType, err := KeyType(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 public key is required: %w", err)
+	}
+	mechanism := PKCS11MechanismEdDSA
+	if keyType == KeyTypeECDSASecp256k1 {
+		mechanism = PKCS11MechanismECDSA
+	}
+	return &PKCS11Signer{
+		session:   session,
+		keyHandle: keyHandle,
+		publicKey: publicKey,
+		mechanism: mechanism,
+	}, nil
+}
+
+// PublicKey returns the public key of the token key.
+func (signer *PKCS11Signer) PublicKey() hedera.PublicKey {
+	return signer.publicKey
+}
+
+// Sign signs message on the token.
+func (signer *PKCS11Signer) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	data := message
+	if signer.mechanism == PKCS11MechanismECDSA {
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(message)
+		data = hash.Sum(nil)
+	}
+
+	signature, err := signer.session.Sign(ctx, signer.keyHandle, signer.mechanism, data)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 signing failed: %w", err)
+	}
+	if signer.mechanism == PKCS11MechanismEdDSA {
+		if len(signature) != transactionSignatureSize {
+			return nil, fmt.Errorf("PKCS#11 token returned a %d-byte signature, expected %d", len(signature), transactionSignatureSize)
+		}
+		return signature, nil
+	}
+	return normalizeECDSASignature(signature)
+}
+
+// SignDigest signs a 32-byte digest on the token with CKM_ECDSA.
+func (signer *PKCS11Signer) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	if err := checkDigest(signer.publicKey, digest); err != nil {
+		return nil, err
+	}
+	signature, err := signer.session.Sign(ctx, signer.keyHandle, PKCS11MechanismECDSA, digest)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 signing failed: %w", err)
+	}
+	return normalizeECDSASignature(signature)
+}
+
+// normalizeECDSASignature converts a raw or DER secp256k1 signature into the
+// 64-byte low-S r||s form Hedera expects.
+func normalizeECDSASignature(signature []byte) ([]byte, error) {
+	var r, s btcec.ModNScalar
+	switch {
+	case len(signature) == transactionSignatureSize:
+		if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:]) {
+			return nil, fmt.Errorf("ECDSA signature is out of range")
+		}
+	case len(signature) > 0 && signature[0] == 0x30:
+		parsed, err := btcecdsa.ParseDERSignature(signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DER ECDSA signature: %w", err)
+		}
+		r, s = parsed.R(), parsed.S()
+	default:
+		return nil, fmt.Errorf("unexpected %d-byte ECDSA signature", len(signature))
+	}
+	if r.IsZero() || s.IsZero() {
+		return nil, fmt.Errorf("ECDSA signature is out of range")
+	}
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+
+	normalized := make([]byte, transactionSignatureSize)
+	r.PutBytesUnchecked(normalized[:32])
+	s.PutBytesUnchecked(normalized[32:])
+	return normalized, nil
+}
+
+// TransactionSigner adapts signer to the Hedera SDK signing callback, for use
+// with hedera.Client.SetOperatorWith and Transaction.SignWith. The callback
+// has no context and no way to return errors, so a failed signature is left
+// empty and recorded instead: call the returned function after executing to
+// get the first signer error, which explains an INVALID_SIGNATURE status.
+// Prefer SignTransaction, or an Executor carrying the signer, so the error is
+// returned before anything is submitted.
+func TransactionSigner(signer Signer) (hedera.TransactionSigner, func() error) {
+	var (
+		mutex    sync.Mutex
+		firstErr error
+	)
+	callback := func(message []byte) []byte {
+		signature, err := signer.Sign(context.Background(), message)
+		if err != nil {
+			mutex.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mutex.Unlock()
+			return nil
+		}
+		return signature
+	}
+	return callback, func() error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return firstErr
+	}
+}
+
+// SetOperatorSigner makes signer the operator of client. The SDK callback it
+// installs cannot report signer errors; execute through an Executor returned
+// by WithOperatorSigner(signer) so they are returned to the caller.
+func SetOperatorSigner(client *hedera.Client, accountID hedera.AccountID, signer Signer) {
+	callback, _ := TransactionSigner(signer)
+	client.SetOperatorWith(accountID, signer.PublicKey(), callback)
+}
+
+// signWithOperator freezes transaction with client and signs it through
+// signer when signer is client's operator. Signatures are made up front with
+// ctx; the SDK callback then hands them out as it builds each node's request
+// and only asks signer again for bodies it regenerated. The returned function
+// reports a failure of those later signatures.
+func signWithOperator(
+	ctx context.Context,
+	client *hedera.Client,
+	signer Signer,
+	transaction hedera.TransactionInterface,
+) (func() error, error) {
+	noErr := func() error { return nil }
+	if signer == nil || client.GetOperatorPublicKey().String() != signer.PublicKey().String() {
+		return noErr, nil
+	}
+	signable, ok := transaction.(interface {
+		IsFrozen() bool
+		GetSignableNodeBodyBytesList() ([]hedera.SignableNodeTransactionBodyBytes, error)
+	})
+	if !ok {
+		return noErr, nil
+	}
+	if !signable.IsFrozen() {
+		if _, err := hedera.TransactionFreezeWith(transaction, client); err != nil {
+			return noErr, fmt.Errorf("failed to freeze transaction: %w", err)
+		}
+	}
+	bodies, err := signable.GetSignableNodeBodyBytesList()
+	if err != nil {
+		return noErr, fmt.Errorf("failed to read transaction body bytes: %w", err)
+	}
+	signatures := make(map[string][]byte, len(bodies))
+	for _, body := range bodies {
+		signature, err := signer.Sign(ctx, body.Body)
+		if err != nil {
+			return noErr, fmt.Errorf("operator signer failed: %w", err)
+		}
+		signatures[string(body.Body)] = signature
+	}
+	fallback, signErr := TransactionSigner(signer)
+	_, err = hedera.TransactionSignWth(transaction, signer.PublicKey(), func(message []byte) []byte {
+		if signature, ok := signatures[string(message)]; ok {
+			return signature
+		}
+		return fallback(message)
+	})
+	return signErr, err
+}
+
+// SignTransaction adds signer's signature to every node body of a frozen
+// transaction. Unlike Transaction.SignWith, which defers signing until the
+// transaction is executed, it signs immediately and returns signer errors.
+func SignTransaction[T interface {
+	GetSignableNodeBodyBytesList() ([]hedera.SignableNodeTransactionBodyBytes, error)
+	AddSignatureV2(hedera.PublicKey, []byte, hedera.TransactionID, hedera.AccountID) (T, error)
+}](ctx context.Context, transaction T, signer Signer) error {
+	bodies, err := transaction.GetSignableNodeBodyBytesList()
+	if err != nil {
+		return fmt.Errorf("failed to read transaction body bytes: %w", err)
+	}
+	publicKey := signer.PublicKey()
+	for _, body := range bodies {
+		signature, err := signer.Sign(ctx, body.Body)
+		if err != nil {
+			return fmt.Errorf("signer failed: %w", err)
+		}
+		if _, err := transaction.AddSignatureV2(publicKey, signature, body.TransactionID, body.NodeID); err != nil {
+			return fmt.Errorf("failed to add signature: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package shared
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	hedera "github.com/hiero-ledger/hiero-sdk-go/v2/sdk"
+)
+
+func TestLocalSigner(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	signer, err := NewLocalSignerFromString(privateKey.String())
+	if err != nil {
+		t.Fatalf("NewLocalSignerFromString failed: %v", err)
+	}
+	signature, err := signer.Sign(context.Background(), []byte("message"))
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if !signer.PublicKey().VerifySignedMessage([]byte("message"), signature) {
+		t.Fatal("expected signature to verify")
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body remoteSignRequest
+		_ = json.NewDecoder(request.Body).Decode(&body)
+		if request.Header.Get("Authorization") != "Bearer token" {
+			writer.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(writer).Encode(map[string]string{"error": "unauthorized"})
+			return
+		}
+		if body.PublicKey != hex.EncodeToString(privateKey.PublicKey().BytesRaw()) ||
+			body.KeyType != KeyTypeECDSASecp256k1 || body.KeyID != "operator" {
+			t.Errorf("unexpected request: %+v", body)
+		}
+		message, _ := base64.StdEncoding.DecodeString(body.Message)
+		_ = json.NewEncoder(writer).Encode(map[string]string{
+			"signature": base64.StdEncoding.EncodeToString(privateKey.Sign(message)),
+		})
+	}))
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(RemoteSignerConfig{
+		URL:       server.URL,
+		PublicKey: privateKey.PublicKey(),
+		KeyID:     "operator",
+		Headers:   map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner failed: %v", err)
+	}
+	signature, err := signer.Sign(context.Background(), []byte("message"))
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if !privateKey.PublicKey().VerifySignedMessage([]byte("message"), signature) {
+		t.Fatal("expected remote signature to verify")
+	}
+
+	unauthorized, _ := NewRemoteSigner(RemoteSignerConfig{URL: server.URL, PublicKey: privateKey.PublicKey()})
+	if _, err := unauthorized.Sign(context.Background(), []byte("message")); err == nil {
+		t.Fatal("expected error for rejected request")
+	}
+	if _, err := NewRemoteSigner(RemoteSignerConfig{PublicKey: privateKey.PublicKey()}); err == nil {
+		t.Fatal("expected error for missing URL")
+	}
+}
+
+type fakePKCS11Session struct {
+	privateKey *btcec.PrivateKey
+	mechanism  PKCS11Mechanism
+}
+
+func (session *fakePKCS11Session) Sign(ctx context.Context, keyHandle uint, mechanism PKCS11Mechanism, data []byte) ([]byte, error) {
+	session.mechanism = mechanism
+	return btcecdsa.Sign(session.privateKey, data).Serialize(), nil
+}
+
+func TestPKCS11SignerECDSA(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	btcecKey, _ := btcec.PrivKeyFromBytes(privateKey.BytesRaw())
+	session := &fakePKCS11Session{privateKey: btcecKey}
+
+	signer, err := NewPKCS11Signer(session, 7, privateKey.PublicKey())
+	if err != nil {
+		t.Fatalf("NewPKCS11Signer failed: %v", err)
+	}
+	signature, err := signer.Sign(context.Background(), []byte("transaction body"))
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if session.mechanism != PKCS11MechanismECDSA || len(signature) != 64 {
+		t.Fatalf("unexpected mechanism %x or signature length %d", session.mechanism, len(signature))
+	}
+	if !privateKey.PublicKey().VerifySignedMessage([]byte("transaction body"), signature) {
+		t.Fatal("expected PKCS#11 signature to verify as a Hedera ECDSA signature")
+	}
+}
+
+func TestDigestSigners(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	btcecKey, _ := btcec.PrivKeyFromBytes(privateKey.BytesRaw())
+	digest := sha256.Sum256([]byte("challenge"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body remoteSignRequest
+		_ = json.NewDecoder(request.Body).Decode(&body)
+		if body.Message != "" {
+			t.Errorf("expected a digest request, got %+v", body)
+		}
+		data, _ := base64.StdEncoding.DecodeString(body.Digest)
+		_ = json.NewEncoder(writer).Encode(map[string]string{
+			"signature": base64.StdEncoding.EncodeToString(btcecdsa.SignCompact(btcecKey, data, true)[1:]),
+		})
+	}))
+	defer server.Close()
+	remote, err := NewRemoteSigner(RemoteSignerConfig{URL: server.URL, PublicKey: privateKey.PublicKey()})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner failed: %v", err)
+	}
+	token, err := NewPKCS11Signer(&fakePKCS11Session{privateKey: btcecKey}, 7, privateKey.PublicKey())
+	if err != nil {
+		t.Fatalf("NewPKCS11Signer failed: %v", err)
+	}
+
+	for name, signer := range map[string]DigestSigner{
+		"local":  NewLocalSigner(privateKey),
+		"remote": remote,
+		"pkcs11": token,
+	} {
+		signature, err := signer.SignDigest(context.Background(), digest[:])
+		if err != nil {
+			t.Fatalf("%s: SignDigest failed: %v", name, err)
+		}
+		var r, s btcec.ModNScalar
+		r.SetByteSlice(signature[:32])
+		s.SetByteSlice(signature[32:])
+		if len(signature) != 64 || !btcecdsa.NewSignature(&r, &s).Verify(digest[:], btcecKey.PubKey()) {
+			t.Fatalf("%s: expected the digest signature to verify", name)
+		}
+		if _, err := signer.SignDigest(context.Background(), digest[:16]); err == nil {
+			t.Fatalf("%s: expected a short digest to be rejected", name)
+		}
+	}
+
+	edKey, _ := hedera.PrivateKeyGenerateEd25519()
+	if _, err := NewLocalSigner(edKey).SignDigest(context.Background(), digest[:]); err == nil {
+		t.Fatal("expected Ed25519 digest signing to be rejected")
+	}
+}
+
+func TestNormalizeECDSASignatureLowS(t *testing.T) {
+	var r, s btcec.ModNScalar
+	r.SetInt(1)
+	s.SetInt(1)
+	s.Negate()
+	raw := make([]byte, 64)
+	r.PutBytesUnchecked(raw[:32])
+	s.PutBytesUnchecked(raw[32:])
+
+	normalized, err := normalizeECDSASignature(raw)
+	if err != nil {
+		t.Fatalf("normalizeECDSASignature failed: %v", err)
+	}
+	if normalized[63] != 1 {
+		t.Fatalf("expected high S to be negated, got %x", normalized[32:])
+	}
+	if _, err := normalizeECDSASignature([]byte{1, 2, 3}); err == nil {
+		t.Fatal("expected error for malformed signature")
+	}
+}
+
+func TestResolveHederaClientAndOperatorWithSigner(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	signer := NewLocalSigner(privateKey)
+
+	client, operator, err := ResolveHederaClientAndOperatorWithSigner(NetworkTestnet, nil, "0.0.1234", "", signer)
+	if err != nil {
+		t.Fatalf("ResolveHederaClientAndOperatorWithSigner failed: %v", err)
+	}
+	if client.GetOperatorPublicKey().String() != privateKey.PublicKey().String() {
+		t.Fatal("expected client operator to use the signer key")
+	}
+	if operator.HasPrivateKey || operator.PrivateKeyRaw != "" || operator.Signer != signer {
+		t.Fatalf("unexpected resolved operator: %+v", operator)
+	}
+
+	if _, _, err := ResolveHederaClientAndOperatorWithSigner(NetworkTestnet, nil, "0.0.1234", privateKey.String(), signer); err == nil {
+		t.Fatal("expected error when both a private key and signer are set")
+	}
+
+	otherKey, _ := hedera.PrivateKeyGenerateEcdsa()
+	if _, _, err := ResolveHederaClientAndOperatorWithSigner(NetworkTestnet, client, "", "", NewLocalSigner(otherKey)); err == nil {
+		t.Fatal("expected error for signer that does not match the injected client")
+	}
+}
+
+type failingSigner struct {
+	publicKey hedera.PublicKey
+	err       error
+}
+
+func (signer failingSigner) PublicKey() hedera.PublicKey {
+	return signer.publicKey
+}
+
+func (signer failingSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	return nil, signer.err
+}
+
+func TestSignTransaction(t *testing.T) {
+	// GetSignatures only reports Ed25519 signature pairs, so sign with an Ed25519 key.
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	newFrozenTx := func() *hedera.TopicMessageSubmitTransaction {
+		transaction := hedera.NewTopicMessageSubmitTransaction().
+			SetTopicID(hedera.TopicID{Topic: 5}).
+			SetMessage([]byte("hello")).
+			SetNodeAccountIDs([]hedera.AccountID{{Account: 3}}).
+			SetTransactionID(hedera.TransactionIDGenerate(hedera.AccountID{Account: 2}))
+		if _, err := transaction.Freeze(); err != nil {
+			t.Fatalf("Freeze failed: %v", err)
+		}
+		return transaction
+	}
+
+	transaction := newFrozenTx()
+	if err := SignTransaction(context.Background(), transaction, NewLocalSigner(privateKey)); err != nil {
+		t.Fatalf("SignTransaction failed: %v", err)
+	}
+	signatures, err := transaction.GetSignatures()
+	if err != nil {
+		t.Fatalf("GetSignatures failed: %v", err)
+	}
+	signed := false
+	for publicKey := range signatures[hedera.AccountID{Account: 3}] {
+		signed = signed || publicKey.StringRaw() == privateKey.PublicKey().StringRaw()
+	}
+	if !signed {
+		t.Fatalf("expected signature from signer, got %v", signatures)
+	}
+
+	failing := failingSigner{publicKey: privateKey.PublicKey(), err: errors.New("hsm offline")}
+	if err := SignTransaction(context.Background(), newFrozenTx(), failing); !errors.Is(err, failing.err) {
+		t.Fatalf("expected signer error, got %v", err)
+	}
+}
+
+func TestExecutorSignsWithOperatorSigner(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	operatorID := hedera.AccountID{Account: 1234}
+	newTx := func() *hedera.TopicMessageSubmitTransaction {
+		return hedera.NewTopicMessageSubmitTransaction().
+			SetTopicID(hedera.TopicID{Topic: 5}).
+			SetMessage([]byte("hello")).
+			SetNodeAccountIDs([]hedera.AccountID{{Account: 3}})
+	}
+
+	client, err := NewHederaClient(NetworkTestnet)
+	if err != nil {
+		t.Fatalf("NewHederaClient failed: %v", err)
+	}
+	failing := failingSigner{publicKey: privateKey.PublicKey(), err: errors.New("hsm offline")}
+	SetOperatorSigner(client, operatorID, failing)
+	if _, err := DefaultExecutor().WithOperatorSigner(failing).Execute(context.Background(), client, newTx()); !errors.Is(err, failing.err) {
+		t.Fatalf("expected the operator signer error, got %v", err)
+	}
+
+	signer := NewLocalSigner(privateKey)
+	SetOperatorSigner(client, operatorID, signer)
+	transaction := newTx()
+	signErr, err := signWithOperator(context.Background(), client, signer, transaction)
+	if err != nil {
+		t.Fatalf("signWithOperator failed: %v", err)
+	}
+	// Signers run as the transaction is built, so read the serialized copy.
+	encoded, err := transaction.ToBytes()
+	if err != nil {
+		t.Fatalf("ToBytes failed: %v", err)
+	}
+	decoded, err := hedera.TransactionFromBytes(encoded)
+	if err != nil {
+		t.Fatalf("TransactionFromBytes failed: %v", err)
+	}
+	signatures, err := hedera.TransactionGetSignatures(decoded)
+	if err != nil {
+		t.Fatalf("GetSignatures failed: %v", err)
+	}
+	if len(signatures[hedera.AccountID{Account: 3}]) != 1 {
+		t.Fatalf("expected one operator signature, got %v", signatures)
+	}
+
+	if err := signErr(); err != nil {
+		t.Fatalf("unexpected deferred signer error: %v", err)
+	}
+
+	otherKey, _ := hedera.PrivateKeyGenerateEd25519()
+	client.SetOperator(operatorID, otherKey)
+	if _, err := signWithOperator(context.Background(), client, failing, newTx()); err != nil {
+		t.Fatalf("expected a signer that is not the operator to be skipped, got %v", err)
+	}
+}
+
+func TestTransactionSignerRecordsErrors(t *testing.T) {
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	failing := failingSigner{publicKey: privateKey.PublicKey(), err: errors.New("hsm offline")}
+	callback, signErr := TransactionSigner(failing)
+	if signature := callback([]byte("body")); signature != nil {
+		t.Fatalf("expected no signature, got %x", signature)
+	}
+	if err := signErr(); !errors.Is(err, failing.err) {
+		t.Fatalf("expected the recorded signer error, got %v", err)
+	}
+}
+
+func TestWithOperatorSignerInheritsDefaultExecutor(t *testing.T) {
+	custom, err := NewExecutor(ExecutorConfig{MaxAttempts: 7})
+	if err != nil {
+		t.Fatalf("NewExecutor failed: %v", err)
+	}
+	SetDefaultExecutor(custom)
+	defer SetDefaultExecutor(nil)
+
+	privateKey, _ := hedera.PrivateKeyGenerateEd25519()
+	var executor *Executor
+	derived := executor.WithOperatorSigner(NewLocalSigner(privateKey)).resolve()
+	if derived.config.MaxAttempts != 7 || derived.operatorSigner == nil {
+		t.Fatalf("expected default config with the signer, got %+v", derived)
+	}
+	if executor.WithOperatorSigner(nil) != nil {
+		t.Fatal("expected a nil signer to leave the executor unchanged")
+	}
+}